@@ -0,0 +1,79 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// nvml-snapshot captures the devices of the local node into a fixture file
+// that can be loaded by server.NewFromFile to recreate the node as a mock.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+func main() {
+	output := flag.String("output", "", "the file to write the snapshot to (default stdout)")
+	libraryPath := flag.String("library-path", "", "the path to the NVML library to load")
+	flag.Parse()
+
+	if err := run(*output, *libraryPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run captures a snapshot of the local node and writes it to output. Errors
+// are returned rather than exiting so that NVML is always shut down.
+func run(output string, libraryPath string) error {
+	var opts []nvml.LibraryOption
+	if libraryPath != "" {
+		opts = append(opts, nvml.WithLibraryPath(libraryPath))
+	}
+	lib := nvml.New(opts...)
+
+	ret := lib.Init()
+	if ret != nvml.SUCCESS {
+		return fmt.Errorf("failed to init NVML: %v", ret)
+	}
+	defer func() {
+		_ = lib.Shutdown()
+	}()
+
+	fixture, err := server.Capture(lib)
+	if err != nil {
+		return fmt.Errorf("failed to capture snapshot: %w", err)
+	}
+
+	data, err := yaml.Marshal(fixture)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+	} else {
+		err = os.WriteFile(output, data, 0644)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
@@ -17,17 +17,21 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -43,7 +47,7 @@ var GeneratableInterfaces = []GeneratableInterfacePoperties{
 	{
 		Type:                      "library",
 		Interface:                 "Interface",
-		Exclude:                   []string{"LookupSymbol"},
+		Exclude:                   []string{"LookupSymbol", "LibraryCandidates", "LibraryInfo"},
 		PackageMethodsAliasedFrom: "libnvml",
 	},
 	{
@@ -83,6 +87,9 @@ var GeneratableInterfaces = []GeneratableInterfacePoperties{
 func main() {
 	sourceDir := flag.String("sourceDir", "", "Path to the source directory for all go files")
 	output := flag.String("output", "", "Path to the output file (default: stdout)")
+	middlewareOutput := flag.String("middlewareOutput", "", "Path to the output file for the middleware wrappers (default: not generated)")
+	versionsOutput := flag.String("versionsOutput", "", "Path to the output file for the versioned symbol table (default: not generated)")
+	errorsOutput := flag.String("errorsOutput", "", "Path to the output file for the error-returning wrappers of the nvmlerr package (default: not generated)")
 	flag.Parse()
 
 	// Check if required flags are provided
@@ -98,7 +105,7 @@ func main() {
 	}
 	defer closer()
 
-	header, err := generateHeader()
+	header, err := generateHeader("nvml")
 	if err != nil {
 		fmt.Printf("Error: %v", err)
 		return
@@ -140,6 +147,411 @@ func main() {
 			fmt.Fprint(writer, "\n")
 		}
 	}
+
+	if *middlewareOutput != "" {
+		if err := writeMiddleware(*sourceDir, *middlewareOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *versionsOutput != "" {
+		if err := writeVersions(*sourceDir, *versionsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *errorsOutput != "" {
+		if err := writeErrors(*sourceDir, *errorsOutput); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+}
+
+func writeMiddleware(sourceDir string, outputFile string, header string) error {
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+
+	for i, p := range GeneratableInterfaces {
+		output, err := generateMiddleware(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(writer, output)
+
+		if i < (len(GeneratableInterfaces) - 1) {
+			fmt.Fprint(writer, "\n")
+		}
+	}
+
+	structs, err := extractHandleStructs(sourceDir)
+	if err != nil {
+		return err
+	}
+	for _, s := range structs {
+		if !isReturned(sourceDir, s.Name) {
+			continue
+		}
+		fmt.Fprint(writer, "\n")
+		fmt.Fprint(writer, generateMiddlewareStruct(s))
+	}
+	return nil
+}
+
+// isReturned returns whether any method returns a value of the specified type.
+func isReturned(sourceDir string, typ string) bool {
+	for _, input := range GeneratableInterfaces {
+		methods, err := extractMethodsFromPackage(sourceDir, input)
+		if err != nil {
+			return false
+		}
+		for _, method := range methods {
+			if method.Type.Results == nil {
+				continue
+			}
+			for _, field := range method.Type.Results.List {
+				if formatFieldList(field) == typ {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// handleStruct is a struct type of the package with fields holding handles
+type handleStruct struct {
+	Name string
+	// Fields maps the names of the handle fields to their handle type.
+	Fields [][2]string
+}
+
+// extractHandleStructs returns the struct types declared in the package that
+// have fields holding handles (e.g. the Device of a GpuInstanceInfo), sorted
+// by name. Packages in subdirectories are ignored.
+func extractHandleStructs(sourceDir string) ([]handleStruct, error) {
+	gofiles, err := getGoFiles(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var structs []handleStruct
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok || !isPublic(spec.Name.Name) {
+				return false
+			}
+			s := handleStruct{Name: spec.Name.Name}
+			for _, field := range st.Fields.List {
+				ident, ok := field.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if name, _ := handleType(ident.Name); name == "" {
+					continue
+				}
+				for _, fieldName := range field.Names {
+					s.Fields = append(s.Fields, [2]string{fieldName.Name, ident.Name})
+				}
+			}
+			if len(s.Fields) > 0 {
+				structs = append(structs, s)
+			}
+			return false
+		})
+	}
+
+	sort.Slice(structs, func(i, j int) bool {
+		return structs[i].Name < structs[j].Name
+	})
+
+	return structs, nil
+}
+
+// handleStructNames returns the names of the struct types of the package that
+// have fields holding handles.
+func handleStructNames(sourceDir string) map[string]bool {
+	structs, err := extractHandleStructs(sourceDir)
+	if err != nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, s := range structs {
+		names[s.Name] = true
+	}
+	return names
+}
+
+// generateMiddlewareStruct generates the function wrapping the handles held
+// by a struct returned through a middleware wrapper.
+func generateMiddlewareStruct(s handleStruct) string {
+	var output strings.Builder
+	fmt.Fprintf(&output, "// wrap%s wraps the handles held by v with m.\n", s.Name)
+	fmt.Fprintf(&output, "func wrap%s(v %s, m Middleware) %s {\n", s.Name, s.Name, s.Name)
+	for _, field := range s.Fields {
+		fmt.Fprintf(&output, "\tv.%s = Wrap%s(v.%s, m)\n", field[0], field[1], field[0])
+	}
+	output.WriteString("\treturn v\n")
+	output.WriteString("}\n")
+	return output.String()
+}
+
+func writeVersions(sourceDir string, outputFile string, header string) error {
+	functions, err := extractVersionedFunctions(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateVersions(functions))
+	return nil
+}
+
+// versionedFunction is a function declared in nvml.h with versioned symbols
+// whose calls are dispatched to the version selected when loading the library.
+type versionedFunction struct {
+	// Symbol is the unversioned symbol of the function (e.g. nvmlInit).
+	Symbol string
+	// Dispatch is the variable through which the function is called. Each
+	// version is implemented by a Go function named after it with a _vN suffix.
+	Dispatch string
+	// Versions are the versions after v1 declared in nvml.h, in ascending order.
+	Versions []int
+}
+
+// unversionedFunctions are the functions of nvml.h with versioned symbols that
+// are not dispatched by the Go bindings. Each of their versions is exposed as a
+// separate method (e.g. DeviceGetMemoryInfo and DeviceGetMemoryInfo_v2), or
+// only the unversioned symbol is bound.
+var unversionedFunctions = []string{
+	"nvmlDeviceGetAccountingStats",
+	"nvmlDeviceGetFanControlPolicy",
+	"nvmlDeviceGetFanSpeed",
+	"nvmlDeviceGetMemoryInfo",
+	"nvmlDeviceGetRemappedRows",
+	"nvmlDeviceGetRetiredPages",
+	"nvmlDeviceGetVgpuSchedulerLog",
+	"nvmlDeviceGetVgpuSchedulerState",
+	"nvmlDeviceRemoveGpu",
+	"nvmlDeviceSetDefaultFanSpeed",
+	"nvmlDeviceSetFanSpeed",
+	"nvmlDeviceSetPowerManagementLimit",
+	"nvmlDeviceSetVgpuSchedulerState",
+	"nvmlGpuInstanceGetVgpuSchedulerLog",
+	"nvmlGpuInstanceGetVgpuSchedulerState",
+	"nvmlGpuInstanceSetVgpuSchedulerState",
+	"nvmlSystemGetCudaDriverVersion",
+}
+
+var versionedSymbolPattern = regexp.MustCompile(`DECLDIR\s+(nvml\w+)_v(\d+)\s*\(`)
+
+// extractVersionedFunctions returns the functions of nvml.h with versioned
+// symbols that are dispatched by the Go bindings. A function is dispatched if
+// a Go function implementing its v1 exists, either as an adapter named after
+// the function without its nvml prefix (e.g. deviceGetComputeRunningProcesses_v1)
+// or as the binding generated by c-for-go (e.g. nvmlInit_v1). All versions of
+// a dispatched function must then be implemented with the same signature.
+// Functions that are not dispatched must be listed in unversionedFunctions, so
+// that new versioned functions of nvml.h are not silently left out.
+func extractVersionedFunctions(sourceDir string) ([]versionedFunction, error) {
+	header, err := os.ReadFile(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string][]int)
+	for _, match := range versionedSymbolPattern.FindAllStringSubmatch(string(header), -1) {
+		version, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, err
+		}
+		if version < 2 || slices.Contains(versions[match[1]], version) {
+			continue
+		}
+		versions[match[1]] = append(versions[match[1]], version)
+	}
+
+	funcs, err := extractFunctionsFromPackage(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []versionedFunction
+	var errs []error
+	for symbol, v := range versions {
+		adapter := strings.TrimPrefix(symbol, "nvml")
+		adapter = strings.ToLower(adapter[:1]) + adapter[1:]
+
+		var dispatch string
+		switch {
+		case slices.Contains(unversionedFunctions, symbol):
+			continue
+		case funcs[adapter+"_v1"] != nil:
+			dispatch = adapter
+		case funcs[symbol+"_v1"] != nil:
+			dispatch = symbol
+		default:
+			errs = append(errs, fmt.Errorf("%s has versioned symbols but neither %s_v1 nor %s_v1 is implemented; implement it or add it to unversionedFunctions", symbol, adapter, symbol))
+			continue
+		}
+
+		sort.Ints(v)
+		signature := formatFuncType(funcs[dispatch+"_v1"])
+		for _, version := range v {
+			impl := fmt.Sprintf("%s_v%d", dispatch, version)
+			if funcs[impl] == nil {
+				return nil, fmt.Errorf("%s_v%d is declared in nvml.h but %s is not implemented", symbol, version, impl)
+			}
+			if formatFuncType(funcs[impl]) != signature {
+				return nil, fmt.Errorf("%s has a different signature than %s_v1; add %s_v1 and %s_v%d adapters with a common signature", impl, dispatch, adapter, adapter, version)
+			}
+		}
+
+		functions = append(functions, versionedFunction{
+			Symbol:   symbol,
+			Dispatch: dispatch,
+			Versions: v,
+		})
+	}
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Error() < errs[j].Error()
+		})
+		return nil, errors.Join(errs...)
+	}
+
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Symbol < functions[j].Symbol
+	})
+
+	return functions, nil
+}
+
+// extractFunctionsFromPackage returns the functions (not methods) declared in
+// the package, by name. Packages in subdirectories are ignored.
+func extractFunctionsFromPackage(sourceDir string) (map[string]*ast.FuncType, error) {
+	gofiles, err := getGoFiles(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := make(map[string]*ast.FuncType)
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range node.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+			funcs[funcDecl.Name.Name] = funcDecl.Type
+		}
+	}
+
+	return funcs, nil
+}
+
+// formatFuncType formats the parameter and result types of a function,
+// ignoring their names.
+func formatFuncType(typ *ast.FuncType) string {
+	format := func(list *ast.FieldList) string {
+		if list == nil {
+			return ""
+		}
+		var formatted []string
+		for _, field := range list.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				formatted = append(formatted, types.ExprString(field.Type))
+			}
+		}
+		return strings.Join(formatted, ", ")
+	}
+	return fmt.Sprintf("func(%s) (%s)", format(typ.Params), format(typ.Results))
+}
+
+// generateVersions generates the dispatch variables of the versioned
+// functions along with the table used to select their versions.
+func generateVersions(functions []versionedFunction) string {
+	var output strings.Builder
+
+	output.WriteString("// The variables below dispatch the functions with versioned symbols in nvml.h.\n")
+	output.WriteString("// They default to v1 and are updated when the library is loaded.\n")
+	output.WriteString("var (\n")
+	for _, f := range functions {
+		fmt.Fprintf(&output, "\t%s = %s_v1\n", f.Dispatch, f.Dispatch)
+	}
+	output.WriteString(")\n\n")
+
+	output.WriteString("// versionedFunctions lists the versions of the functions with versioned\n")
+	output.WriteString("// symbols in nvml.h from the newest to v1.\n")
+	output.WriteString("var versionedFunctions = []versionedFunction{\n")
+	for _, f := range functions {
+		output.WriteString("\t{\n")
+		fmt.Fprintf(&output, "\t\tsymbol: %q,\n", f.Symbol)
+		output.WriteString("\t\tversions: []versionedSymbol{\n")
+		for i := len(f.Versions) - 1; i >= 0; i-- {
+			fmt.Fprintf(&output, "\t\t\t{\"%s_v%d\", func() { %s = %s_v%d }},\n", f.Symbol, f.Versions[i], f.Dispatch, f.Dispatch, f.Versions[i])
+		}
+		fmt.Fprintf(&output, "\t\t\t{%q, func() { %s = %s_v1 }},\n", f.Symbol, f.Dispatch, f.Dispatch)
+		output.WriteString("\t\t},\n")
+		output.WriteString("\t},\n")
+	}
+	output.WriteString("}\n\n")
+
+	output.WriteString("// saveVersionedSymbols returns a function restoring the variables above to\n")
+	output.WriteString("// their current values.\n")
+	output.WriteString("func saveVersionedSymbols() func() {\n")
+	for _, f := range functions {
+		fmt.Fprintf(&output, "\t%s := %s\n", savedVariable(f.Dispatch), f.Dispatch)
+	}
+	output.WriteString("\treturn func() {\n")
+	for _, f := range functions {
+		fmt.Fprintf(&output, "\t\t%s = %s\n", f.Dispatch, savedVariable(f.Dispatch))
+	}
+	output.WriteString("\t}\n")
+	output.WriteString("}\n")
+
+	return output.String()
+}
+
+// savedVariable returns the name of the local variable holding the saved
+// value of a dispatch variable in saveVersionedSymbols
+func savedVariable(dispatch string) string {
+	return "saved" + strings.ToUpper(dispatch[:1]) + dispatch[1:]
 }
 
 func getWriter(outputFile string) (io.Writer, func() error, error) {
@@ -155,7 +567,7 @@ func getWriter(outputFile string) (io.Writer, func() error, error) {
 	return file, file.Close, nil
 }
 
-func generateHeader() (string, error) {
+func generateHeader(pkg string) (string, error) {
 	lines := []string{
 		"/**",
 		"# Copyright 2024 NVIDIA CORPORATION",
@@ -175,7 +587,7 @@ func generateHeader() (string, error) {
 		"",
 		"// Generated Code; DO NOT EDIT.",
 		"",
-		"package nvml",
+		"package " + pkg,
 		"",
 		"",
 	}
@@ -249,6 +661,485 @@ func generateInterface(sourceDir string, input GeneratableInterfacePoperties) (s
 	return signature.String(), nil
 }
 
+func generateMiddleware(sourceDir string, input GeneratableInterfacePoperties) (string, error) {
+	var output strings.Builder
+
+	methods, err := extractMethodsFromPackage(sourceDir, input)
+	if err != nil {
+		return "", err
+	}
+
+	wrapper := middlewareType(input.Interface)
+	fmt.Fprintf(&output, "// %s passes the calls made to the wrapped %s through a Middleware.\n", wrapper, input.Interface)
+	fmt.Fprintf(&output, "type %s struct {\n", wrapper)
+	fmt.Fprintf(&output, "\t%s\n", input.Interface)
+	output.WriteString("\tmiddleware Middleware\n")
+	output.WriteString("}\n\n")
+
+	fmt.Fprintf(&output, "// Wrap%s returns a wrapper that passes every call made to wrapped through m.\n", input.Interface)
+	fmt.Fprintf(&output, "func Wrap%s(wrapped %s, m Middleware) %s {\n", input.Interface, input.Interface, input.Interface)
+	output.WriteString("\tif wrapped == nil {\n")
+	output.WriteString("\t\treturn nil\n")
+	output.WriteString("\t}\n")
+	fmt.Fprintf(&output, "\treturn &%s{wrapped, m}\n", wrapper)
+	output.WriteString("}\n")
+
+	// Handles passed as arguments are unwrapped before being forwarded
+	if input.PackageMethodsAliasedFrom == "" {
+		output.WriteString("\n")
+		fmt.Fprintf(&output, "// unwrap%s removes all layers of middleware from v.\n", input.Interface)
+		fmt.Fprintf(&output, "func unwrap%s(v %s) %s {\n", input.Interface, input.Interface, input.Interface)
+		output.WriteString("\tfor {\n")
+		fmt.Fprintf(&output, "\t\tw, ok := v.(*%s)\n", wrapper)
+		output.WriteString("\t\tif !ok {\n")
+		output.WriteString("\t\t\treturn v\n")
+		output.WriteString("\t\t}\n")
+		fmt.Fprintf(&output, "\t\tv = w.%s\n", input.Interface)
+		output.WriteString("\t}\n")
+		output.WriteString("}\n")
+	}
+
+	if usesHandleSlice(sourceDir, input.Interface, false) {
+		output.WriteString("\n")
+		fmt.Fprintf(&output, "// wrap%ss wraps each %s of v with m.\n", input.Interface, input.Interface)
+		fmt.Fprintf(&output, "func wrap%ss(v []%s, m Middleware) []%s {\n", input.Interface, input.Interface, input.Interface)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\twrapped := make([]%s, len(v))\n", input.Interface)
+		output.WriteString("\tfor i := range v {\n")
+		fmt.Fprintf(&output, "\t\twrapped[i] = Wrap%s(v[i], m)\n", input.Interface)
+		output.WriteString("\t}\n")
+		output.WriteString("\treturn wrapped\n")
+		output.WriteString("}\n")
+	}
+
+	if usesHandleSlice(sourceDir, input.Interface, true) {
+		output.WriteString("\n")
+		fmt.Fprintf(&output, "// unwrap%ss removes a layer of middleware from each %s of v.\n", input.Interface, input.Interface)
+		fmt.Fprintf(&output, "func unwrap%ss(v []%s) []%s {\n", input.Interface, input.Interface, input.Interface)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\tunwrapped := make([]%s, len(v))\n", input.Interface)
+		output.WriteString("\tfor i := range v {\n")
+		fmt.Fprintf(&output, "\t\tunwrapped[i] = unwrap%s(v[i])\n", input.Interface)
+		output.WriteString("\t}\n")
+		output.WriteString("\treturn unwrapped\n")
+		output.WriteString("}\n")
+	}
+
+	structs := handleStructNames(sourceDir)
+	for _, method := range methods {
+		output.WriteString("\n")
+		output.WriteString(formatMiddlewareMethod(input, method, structs))
+	}
+
+	return output.String(), nil
+}
+
+// middlewareType returns the name of the middleware wrapper for an interface.
+func middlewareType(name string) string {
+	return strings.ToLower(name[:1]) + name[1:] + "Middleware"
+}
+
+// handleType returns the name of the handle interface of a type (or slice
+// thereof) along with whether it is a slice. An empty name is returned for
+// types that are not handles.
+func handleType(typ string) (string, bool) {
+	isSlice := strings.HasPrefix(typ, "[]")
+	typ = strings.TrimPrefix(typ, "[]")
+	for _, p := range GeneratableInterfaces {
+		if p.PackageMethodsAliasedFrom == "" && p.Interface == typ {
+			return typ, isSlice
+		}
+	}
+	return "", false
+}
+
+// usesHandleSlice returns whether any method takes (or returns) a slice of
+// the specified handle type.
+func usesHandleSlice(sourceDir string, handle string, param bool) bool {
+	for _, input := range GeneratableInterfaces {
+		methods, err := extractMethodsFromPackage(sourceDir, input)
+		if err != nil {
+			return false
+		}
+		for _, method := range methods {
+			list := method.Type.Results
+			if param {
+				list = method.Type.Params
+			}
+			if list == nil {
+				continue
+			}
+			for _, field := range list.List {
+				if name, isSlice := handleType(formatFieldList(field)); name == handle && isSlice {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// formatMiddlewareMethod formats a method of a middleware wrapper that passes
+// the call through the middleware before forwarding it to the wrapped value.
+// The handles held by returned structs (listed in structs) are wrapped too.
+func formatMiddlewareMethod(input GeneratableInterfacePoperties, decl *ast.FuncDecl, structs map[string]bool) string {
+	var method strings.Builder
+
+	var params, paramTypes []string
+	if decl.Type.Params != nil {
+		for _, field := range decl.Type.Params.List {
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{{Name: "_"}}
+			}
+			for _, name := range names {
+				param := name.Name
+				if param == "_" || param == "w" || param == "results" || strings.HasPrefix(param, "r") && len(param) > 1 && unicode.IsDigit(rune(param[1])) {
+					param = fmt.Sprintf("arg%d", len(params))
+				}
+				params = append(params, param)
+				paramTypes = append(paramTypes, formatFieldList(field))
+			}
+		}
+	}
+
+	var resultTypes []string
+	if decl.Type.Results != nil {
+		for _, field := range decl.Type.Results.List {
+			resultTypes = append(resultTypes, formatFieldList(field))
+			for i := 1; i < len(field.Names); i++ {
+				resultTypes = append(resultTypes, formatFieldList(field))
+			}
+		}
+	}
+
+	var signature []string
+	for i := range params {
+		signature = append(signature, params[i]+" "+paramTypes[i])
+	}
+	fmt.Fprintf(&method, "func (w *%s) %s(%s)", middlewareType(input.Interface), decl.Name.Name, strings.Join(signature, ", "))
+	switch len(resultTypes) {
+	case 0:
+	case 1:
+		fmt.Fprintf(&method, " %s", resultTypes[0])
+	default:
+		fmt.Fprintf(&method, " (%s)", strings.Join(resultTypes, ", "))
+	}
+	method.WriteString(" {\n")
+
+	// Handles are passed through the middleware as they were received, and
+	// forwarded to the wrapped value with a layer of middleware removed.
+	var callArgs []string
+	for i, param := range params {
+		name, isSlice := handleType(paramTypes[i])
+		switch {
+		case name == "":
+			callArgs = append(callArgs, param)
+		case isSlice:
+			callArgs = append(callArgs, fmt.Sprintf("unwrap%ss(%s)", name, param))
+		default:
+			callArgs = append(callArgs, fmt.Sprintf("unwrap%s(%s)", name, param))
+		}
+	}
+
+	var results []string
+	for i := range resultTypes {
+		results = append(results, fmt.Sprintf("r%d", i))
+	}
+
+	call := fmt.Sprintf("w.%s.%s(%s)", input.Interface, decl.Name.Name, strings.Join(callArgs, ", "))
+	info := fmt.Sprintf("CallInfo{%q, %q, w.%s, []any{%s}}", input.Interface, decl.Name.Name, input.Interface, strings.Join(params, ", "))
+	if len(results) == 0 {
+		fmt.Fprintf(&method, "\tw.middleware(%s, func() []any {\n", info)
+		fmt.Fprintf(&method, "\t\t%s\n", call)
+		method.WriteString("\t\treturn nil\n")
+		method.WriteString("\t})\n")
+		method.WriteString("}\n")
+		return method.String()
+	}
+
+	fmt.Fprintf(&method, "\tresults := w.middleware(%s, func() []any {\n", info)
+	fmt.Fprintf(&method, "\t\t%s := %s\n", strings.Join(results, ", "), call)
+	fmt.Fprintf(&method, "\t\treturn []any{%s}\n", strings.Join(results, ", "))
+	method.WriteString("\t})\n")
+
+	var returns []string
+	for i, result := range results {
+		fmt.Fprintf(&method, "\t%s, _ := results[%d].(%s)\n", result, i, resultTypes[i])
+		name, isSlice := handleType(resultTypes[i])
+		switch {
+		case structs[resultTypes[i]]:
+			returns = append(returns, fmt.Sprintf("wrap%s(%s, w.middleware)", resultTypes[i], result))
+		case name == "":
+			returns = append(returns, result)
+		case isSlice:
+			returns = append(returns, fmt.Sprintf("wrap%ss(%s, w.middleware)", name, result))
+		default:
+			returns = append(returns, fmt.Sprintf("Wrap%s(%s, w.middleware)", name, result))
+		}
+	}
+	fmt.Fprintf(&method, "\treturn %s\n", strings.Join(returns, ", "))
+	method.WriteString("}\n")
+
+	return method.String()
+}
+
+func writeErrors(sourceDir string, outputFile string) error {
+	header, err := generateHeader("nvmlerr")
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, "import \"github.com/NVIDIA/go-nvml/pkg/nvml\"\n\n")
+
+	for i, p := range GeneratableInterfaces {
+		output, err := generateErrors(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(writer, output)
+
+		if i < (len(GeneratableInterfaces) - 1) {
+			fmt.Fprint(writer, "\n")
+		}
+	}
+	return nil
+}
+
+// errorsType returns the name of the error-returning wrapper of an interface
+// in the nvmlerr package.
+func errorsType(input GeneratableInterfacePoperties) string {
+	if input.PackageMethodsAliasedFrom != "" {
+		return "Library"
+	}
+	return input.Interface
+}
+
+// errorsFunctionPrefix returns the prefix that turns the name of a method of
+// an interface into the name of the NVML function it calls (e.g. Device for
+// Device.GetName and nvmlDeviceGetName).
+func errorsFunctionPrefix(input GeneratableInterfacePoperties) string {
+	switch {
+	case input.PackageMethodsAliasedFrom != "":
+		return ""
+	case input.Interface == "VgpuTypeId":
+		return "VgpuType"
+	}
+	return input.Interface
+}
+
+func generateErrors(sourceDir string, input GeneratableInterfacePoperties) (string, error) {
+	var output strings.Builder
+
+	methods, err := extractMethodsFromPackage(sourceDir, input)
+	if err != nil {
+		return "", err
+	}
+
+	wrapper := errorsType(input)
+	fmt.Fprintf(&output, "// %s calls the methods of an nvml.%s, returning an error for every\n", wrapper, input.Interface)
+	output.WriteString("// Return other than SUCCESS. Methods that do not return a Return are called\n")
+	fmt.Fprintf(&output, "// on the embedded nvml.%s directly.\n", input.Interface)
+	fmt.Fprintf(&output, "type %s struct {\n", wrapper)
+	fmt.Fprintf(&output, "\tnvml.%s\n", input.Interface)
+	if input.Interface == "Device" {
+		output.WriteString("\tidentity identity\n")
+	}
+	output.WriteString("}\n\n")
+
+	if input.PackageMethodsAliasedFrom != "" {
+		fmt.Fprintf(&output, "// New returns a %s that calls the methods of lib.\n", wrapper)
+		fmt.Fprintf(&output, "func New(lib nvml.%s) *%s {\n", input.Interface, wrapper)
+		output.WriteString("\tif lib == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\treturn &%s{%s: lib}\n", wrapper, input.Interface)
+		output.WriteString("}\n")
+	} else {
+		fmt.Fprintf(&output, "// New%s returns a %s that calls the methods of v.\n", wrapper, wrapper)
+		fmt.Fprintf(&output, "func New%s(v nvml.%s) *%s {\n", wrapper, input.Interface, wrapper)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\treturn &%s{%s: v}\n", wrapper, input.Interface)
+		output.WriteString("}\n\n")
+
+		fmt.Fprintf(&output, "// unwrap%s returns the nvml.%s called by v.\n", wrapper, input.Interface)
+		fmt.Fprintf(&output, "func unwrap%s(v *%s) nvml.%s {\n", wrapper, wrapper, input.Interface)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\treturn v.%s\n", input.Interface)
+		output.WriteString("}\n")
+	}
+
+	if usesHandleSlice(sourceDir, input.Interface, false) {
+		output.WriteString("\n")
+		fmt.Fprintf(&output, "// new%ss wraps each nvml.%s of v.\n", wrapper, input.Interface)
+		fmt.Fprintf(&output, "func new%ss(v []nvml.%s) []*%s {\n", wrapper, input.Interface, wrapper)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\twrapped := make([]*%s, len(v))\n", wrapper)
+		output.WriteString("\tfor i := range v {\n")
+		fmt.Fprintf(&output, "\t\twrapped[i] = New%s(v[i])\n", wrapper)
+		output.WriteString("\t}\n")
+		output.WriteString("\treturn wrapped\n")
+		output.WriteString("}\n")
+	}
+
+	if usesHandleSlice(sourceDir, input.Interface, true) {
+		output.WriteString("\n")
+		fmt.Fprintf(&output, "// unwrap%ss returns the nvml.%s called by each element of v.\n", wrapper, input.Interface)
+		fmt.Fprintf(&output, "func unwrap%ss(v []*%s) []nvml.%s {\n", wrapper, wrapper, input.Interface)
+		output.WriteString("\tif v == nil {\n")
+		output.WriteString("\t\treturn nil\n")
+		output.WriteString("\t}\n")
+		fmt.Fprintf(&output, "\tunwrapped := make([]nvml.%s, len(v))\n", input.Interface)
+		output.WriteString("\tfor i := range v {\n")
+		fmt.Fprintf(&output, "\t\tunwrapped[i] = unwrap%s(v[i])\n", wrapper)
+		output.WriteString("\t}\n")
+		output.WriteString("\treturn unwrapped\n")
+		output.WriteString("}\n")
+	}
+
+	for _, method := range methods {
+		formatted, ok := formatErrorsMethod(input, method)
+		if !ok {
+			continue
+		}
+		output.WriteString("\n")
+		output.WriteString(formatted)
+	}
+
+	return output.String(), nil
+}
+
+// formatErrorsMethod formats a method of an error-returning wrapper that
+// replaces the trailing Return of the wrapped method with an error. Handles
+// are wrapped and unwrapped so that the wrappers can be used throughout.
+// Methods that do not end with a Return are not wrapped.
+func formatErrorsMethod(input GeneratableInterfacePoperties, decl *ast.FuncDecl) (string, bool) {
+	var resultTypes []string
+	if decl.Type.Results != nil {
+		for _, field := range decl.Type.Results.List {
+			resultTypes = append(resultTypes, formatFieldList(field))
+			for i := 1; i < len(field.Names); i++ {
+				resultTypes = append(resultTypes, formatFieldList(field))
+			}
+		}
+	}
+	if len(resultTypes) == 0 || resultTypes[len(resultTypes)-1] != "Return" {
+		return "", false
+	}
+	resultTypes = resultTypes[:len(resultTypes)-1]
+
+	var params, paramTypes []string
+	if decl.Type.Params != nil {
+		for _, field := range decl.Type.Params.List {
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{{Name: "_"}}
+			}
+			for _, name := range names {
+				param := name.Name
+				if param == "_" || param == "x" || param == "ret" || strings.HasPrefix(param, "r") && len(param) > 1 && unicode.IsDigit(rune(param[1])) {
+					param = fmt.Sprintf("arg%d", len(params))
+				}
+				params = append(params, param)
+				paramTypes = append(paramTypes, formatFieldList(field))
+			}
+		}
+	}
+
+	var method strings.Builder
+
+	var signature, callArgs []string
+	for i, param := range params {
+		name, isSlice := handleType(paramTypes[i])
+		switch {
+		case name == "":
+			signature = append(signature, param+" "+qualifyType(paramTypes[i]))
+			callArgs = append(callArgs, param)
+		case isSlice:
+			signature = append(signature, fmt.Sprintf("%s []*%s", param, name))
+			callArgs = append(callArgs, fmt.Sprintf("unwrap%ss(%s)", name, param))
+		default:
+			signature = append(signature, fmt.Sprintf("%s *%s", param, name))
+			callArgs = append(callArgs, fmt.Sprintf("unwrap%s(%s)", name, param))
+		}
+	}
+
+	var wrappedTypes, results, returns []string
+	for i, typ := range resultTypes {
+		result := fmt.Sprintf("r%d", i)
+		results = append(results, result)
+		name, isSlice := handleType(typ)
+		switch {
+		case name == "":
+			wrappedTypes = append(wrappedTypes, qualifyType(typ))
+			returns = append(returns, result)
+		case isSlice:
+			wrappedTypes = append(wrappedTypes, "[]*"+name)
+			returns = append(returns, fmt.Sprintf("new%ss(%s)", name, result))
+		default:
+			wrappedTypes = append(wrappedTypes, "*"+name)
+			returns = append(returns, fmt.Sprintf("New%s(%s)", name, result))
+		}
+	}
+	wrappedTypes = append(wrappedTypes, "error")
+	results = append(results, "ret")
+
+	// Errors of calls on a device carry the identity of the device
+	function := errorsFunctionPrefix(input) + decl.Name.Name
+	switch {
+	case input.Interface == "Device":
+		returns = append(returns, fmt.Sprintf("x.fromReturn(%q, ret)", function))
+	case len(paramTypes) > 0 && paramTypes[0] == "Device":
+		returns = append(returns, fmt.Sprintf("%s.fromReturn(%q, ret)", params[0], function))
+	default:
+		returns = append(returns, fmt.Sprintf("FromReturn(%q, ret)", function))
+	}
+
+	fmt.Fprintf(&method, "func (x *%s) %s(%s)", errorsType(input), decl.Name.Name, strings.Join(signature, ", "))
+	if len(wrappedTypes) == 1 {
+		fmt.Fprintf(&method, " %s", wrappedTypes[0])
+	} else {
+		fmt.Fprintf(&method, " (%s)", strings.Join(wrappedTypes, ", "))
+	}
+	method.WriteString(" {\n")
+	fmt.Fprintf(&method, "\t%s := x.%s.%s(%s)\n", strings.Join(results, ", "), input.Interface, decl.Name.Name, strings.Join(callArgs, ", "))
+	fmt.Fprintf(&method, "\treturn %s\n", strings.Join(returns, ", "))
+	method.WriteString("}\n")
+
+	return method.String(), true
+}
+
+// qualifyType qualifies the exported types of the nvml package in typ for use
+// in another package.
+func qualifyType(typ string) string {
+	for _, prefix := range []string{"[]", "*"} {
+		if strings.HasPrefix(typ, prefix) {
+			return prefix + qualifyType(strings.TrimPrefix(typ, prefix))
+		}
+	}
+	if isPublic(typ) {
+		return "nvml." + typ
+	}
+	return typ
+}
+
 func getGoFiles(sourceDir string) (map[string][]byte, error) {
 	gofiles := make(map[string][]byte)
 
@@ -18,34 +18,67 @@ package nvml
 
 // ExtendedInterface defines a set of extensions to the core NVML API.
 //
-// TODO: For now the list of methods in this interface need to be kept in sync
-// with the list of excluded methods for the Interface type in
-// gen/nvml/generateapi.go. In the future we should automate this.
+// TODO: For now the list of methods in this interface (and in LibraryInspector)
+// need to be kept in sync with the list of excluded methods for the Interface
+// type in gen/nvml/generateapi.go. In the future we should automate this.
 //
 //go:generate moq -out mock/extendedinterface.go -pkg mock . ExtendedInterface:ExtendedInterface
 type ExtendedInterface interface {
 	LookupSymbol(string) error
 }
 
+// LibraryInspector describes the NVML library that was loaded and how it was
+// found. It is implemented by the extensions of the libraries created with New
+// and is optional for other implementations of ExtendedInterface, so callers
+// must check for it with a type assertion.
+type LibraryInspector interface {
+	LibraryCandidates() []LibraryCandidate
+	LibraryInfo() (LibraryInfo, error)
+}
+
 // libraryOptions hold the parameters that can be set by a LibraryOption
 type libraryOptions struct {
-	path  string
-	flags int
+	path            string
+	flags           int
+	instrumentation InstrumentationSink
+	driverRoot      string
+	searchPaths     []string
 }
 
 // LibraryOption represents a functional option to configure the underlying NVML library
 type LibraryOption func(*libraryOptions)
 
 // WithLibraryPath provides an option to set the library name to be used by the NVML library.
+// The library is then loaded from this path as is, and the WithDriverRoot and
+// WithSearchPaths options are ignored.
 func WithLibraryPath(path string) LibraryOption {
 	return func(o *libraryOptions) {
 		o.path = path
 	}
 }
 
+// WithInstrumentation provides an option to report every call made through the
+// NVML library returned by New to the specified sink. Handles returned by the
+// library (e.g. a Device) are instrumented as well.
+func WithInstrumentation(sink InstrumentationSink) LibraryOption {
+	return func(o *libraryOptions) {
+		o.instrumentation = sink
+	}
+}
+
 // SetLibraryOptions applies the specified options to the NVML library.
 // If this is called when a library is already loaded, an error is raised.
+// Instrumentation is not supported for the package-level functions, and
+// raises an error as well.
 func SetLibraryOptions(opts ...LibraryOption) error {
+	o := libraryOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.instrumentation != nil {
+		return errInstrumentationNotSupported
+	}
+
 	libnvml.Lock()
 	defer libnvml.Unlock()
 	if libnvml.refcount != 0 {
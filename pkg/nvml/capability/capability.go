@@ -0,0 +1,231 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package capability reports which NVML functions are supported by the
+// loaded driver.
+//
+// A Report lists every function of nvml.Interface together with whether its
+// symbol is present in the loaded library. Query functions can additionally
+// be called on each device to find those that return ERROR_NOT_SUPPORTED:
+//
+//	if ret := lib.Init(); ret != nvml.SUCCESS {
+//		...
+//	}
+//	report, err := capability.Probe(lib, capability.WithDeviceQueries())
+//	if err != nil {
+//		...
+//	}
+//	for _, f := range report.Functions {
+//		...
+//	}
+package capability
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/nvmlerr"
+)
+
+// symbolOverrides maps the functions of nvml.Interface whose symbol is not
+// their name prefixed with nvml. An empty symbol marks functions implemented
+// in Go only, which are always present.
+var symbolOverrides = map[string]string{
+	"Extensions":             "",
+	"GpmMetricsGetV":         "nvmlGpmMetricsGet",
+	"GpmQueryDeviceSupportV": "nvmlGpmQueryDeviceSupport",
+}
+
+var (
+	interfaceType = reflect.TypeOf((*nvml.Interface)(nil)).Elem()
+	deviceType    = reflect.TypeOf((*nvml.Device)(nil)).Elem()
+	returnType    = reflect.TypeOf(nvml.SUCCESS)
+)
+
+// Function describes the support of an NVML function
+type Function struct {
+	// Name is the name of the function in nvml.Interface (e.g. DeviceGetName).
+	Name string
+	// Symbol is the library symbol implementing the function, or empty if the
+	// function is implemented in Go only.
+	Symbol string
+	// Present indicates whether the symbol exists in the loaded library.
+	Present bool
+	// Devices holds the result of calling the function on each device, indexed
+	// by device index. It is only populated for the query functions probed
+	// through WithDeviceQueries, and holds ERROR_FUNCTION_NOT_FOUND for all
+	// devices if the symbol is not present.
+	Devices []nvml.Return
+}
+
+// Supported indicates whether the function is supported on the device with
+// the specified index. Functions that were not probed on devices are
+// considered supported if their symbol is present.
+func (f Function) Supported(index int) bool {
+	if !f.Present {
+		return false
+	}
+	if f.Devices == nil || index < 0 || index >= len(f.Devices) {
+		return true
+	}
+	ret := f.Devices[index]
+	return ret != nvml.ERROR_NOT_SUPPORTED && ret != nvml.ERROR_FUNCTION_NOT_FOUND
+}
+
+// Report is the capability matrix of an NVML library
+type Report struct {
+	// DeviceCount is the number of devices the query functions were probed on.
+	DeviceCount int
+	// Functions holds all functions of nvml.Interface sorted by name.
+	Functions []Function
+}
+
+// Function returns the function with the specified name
+func (r *Report) Function(name string) (Function, bool) {
+	i := sort.Search(len(r.Functions), func(i int) bool {
+		return r.Functions[i].Name >= name
+	})
+	if i < len(r.Functions) && r.Functions[i].Name == name {
+		return r.Functions[i], true
+	}
+	return Function{}, false
+}
+
+// Missing returns the names of the functions whose symbol is not present
+func (r *Report) Missing() []string {
+	var missing []string
+	for _, f := range r.Functions {
+		if !f.Present {
+			missing = append(missing, f.Name)
+		}
+	}
+	return missing
+}
+
+// options hold the parameters that can be set by an Option
+type options struct {
+	probeDevices bool
+	queries      map[string]bool
+}
+
+// Option represents a functional option to configure Probe
+type Option func(*options)
+
+// WithDeviceQueries calls query functions on each device to find those that
+// return ERROR_NOT_SUPPORTED. Query functions are the Get functions of
+// nvml.Device that take no arguments, named as in nvml.Interface (e.g.
+// DeviceGetName). If no names are specified, all query functions are called.
+func WithDeviceQueries(names ...string) Option {
+	return func(o *options) {
+		o.probeDevices = true
+		if len(names) > 0 && o.queries == nil {
+			o.queries = make(map[string]bool)
+		}
+		for _, name := range names {
+			o.queries[name] = true
+		}
+	}
+}
+
+// Probe builds the capability matrix of an initialized NVML library
+func Probe(lib nvml.Interface, opts ...Option) (*Report, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	ext := lib.Extensions()
+	if err := ext.LookupSymbol("nvmlInit"); err != nil {
+		return nil, fmt.Errorf("error looking up symbols: %w", err)
+	}
+
+	report := &Report{}
+	for i := 0; i < interfaceType.NumMethod(); i++ {
+		name := interfaceType.Method(i).Name
+		symbol, ok := symbolOverrides[name]
+		if !ok {
+			symbol = "nvml" + name
+		}
+		f := Function{
+			Name:    name,
+			Symbol:  symbol,
+			Present: symbol == "" || ext.LookupSymbol(symbol) == nil,
+		}
+		report.Functions = append(report.Functions, f)
+	}
+
+	if o.probeDevices {
+		if err := report.probeDevices(lib, o.queries); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// probeDevices calls the selected query functions on each device
+func (r *Report) probeDevices(lib nvml.Interface, queries map[string]bool) error {
+	count, ret := lib.DeviceGetCount()
+	if err := nvmlerr.FromReturn("DeviceGetCount", ret); err != nil {
+		return err
+	}
+
+	var devices []reflect.Value
+	for i := 0; i < count; i++ {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		if err := nvmlerr.FromReturn("DeviceGetHandleByIndex", ret); err != nil {
+			return err
+		}
+		devices = append(devices, reflect.ValueOf(device))
+	}
+	r.DeviceCount = count
+
+	for i := range r.Functions {
+		f := &r.Functions[i]
+		method, ok := deviceQuery(f.Name)
+		if !ok || (queries != nil && !queries[f.Name]) {
+			continue
+		}
+		f.Devices = make([]nvml.Return, count)
+		for j, device := range devices {
+			// Calling a function whose symbol is missing would fail in the
+			// dynamic loader, so these are only recorded as not found.
+			if !f.Present {
+				f.Devices[j] = nvml.ERROR_FUNCTION_NOT_FOUND
+				continue
+			}
+			results := device.MethodByName(method).Call(nil)
+			f.Devices[j] = results[len(results)-1].Interface().(nvml.Return)
+		}
+	}
+	return nil
+}
+
+// deviceQuery returns the nvml.Device method of a query function
+func deviceQuery(name string) (string, bool) {
+	method, found := strings.CutPrefix(name, "Device")
+	if !found || !strings.HasPrefix(method, "Get") {
+		return "", false
+	}
+	m, ok := deviceType.MethodByName(method)
+	if !ok || m.Type.NumIn() != 0 || m.Type.NumOut() == 0 {
+		return "", false
+	}
+	return method, m.Type.Out(m.Type.NumOut()-1) == returnType
+}
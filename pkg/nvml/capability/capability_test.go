@@ -0,0 +1,91 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package capability
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+func TestProbe(t *testing.T) {
+	s, err := server.New(server.WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	errNotFound := errors.New("symbol not found")
+	s.LookupSymbolFunc = func(symbol string) error {
+		switch symbol {
+		case "nvmlDeviceGetUUID", "nvmlGpmMetricsGet":
+			return errNotFound
+		}
+		return nil
+	}
+	s.Devices[1].(*server.Device).GetNameFunc = func() (string, nvml.Return) {
+		return "", nvml.ERROR_NOT_SUPPORTED
+	}
+
+	report, err := Probe(s, WithDeviceQueries("DeviceGetName", "DeviceGetUUID", "DeviceGetTemperature"))
+	require.NoError(t, err)
+	require.Equal(t, 2, report.DeviceCount)
+	require.Equal(t, []string{"DeviceGetUUID", "GpmMetricsGet", "GpmMetricsGetV"}, report.Missing())
+
+	f, ok := report.Function("DeviceGetName")
+	require.True(t, ok)
+	require.Equal(t, "nvmlDeviceGetName", f.Symbol)
+	require.Equal(t, []nvml.Return{nvml.SUCCESS, nvml.ERROR_NOT_SUPPORTED}, f.Devices)
+	require.True(t, f.Supported(0))
+	require.False(t, f.Supported(1))
+
+	// Missing functions are not called on devices
+	f, ok = report.Function("DeviceGetUUID")
+	require.True(t, ok)
+	require.False(t, f.Present)
+	require.Equal(t, []nvml.Return{nvml.ERROR_FUNCTION_NOT_FOUND, nvml.ERROR_FUNCTION_NOT_FOUND}, f.Devices)
+	require.False(t, f.Supported(0))
+
+	// Functions taking arguments are not probed on devices
+	f, ok = report.Function("DeviceGetTemperature")
+	require.True(t, ok)
+	require.Nil(t, f.Devices)
+	require.True(t, f.Supported(0))
+
+	// Functions implemented in Go only are always present
+	f, ok = report.Function("Extensions")
+	require.True(t, ok)
+	require.Empty(t, f.Symbol)
+	require.True(t, f.Present)
+
+	_, ok = report.Function("DeviceGetNonExistent")
+	require.False(t, ok)
+}
+
+func TestProbeNotLoaded(t *testing.T) {
+	s, err := server.New()
+	require.NoError(t, err)
+
+	errNotLoaded := errors.New("library not loaded")
+	s.LookupSymbolFunc = func(symbol string) error {
+		return errNotLoaded
+	}
+	_, err = Probe(s)
+	require.ErrorIs(t, err, errNotLoaded)
+}
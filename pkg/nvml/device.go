@@ -1831,7 +1831,7 @@ func (l *library) DeviceRegisterEvents(device Device, eventTypes uint64, set Eve
 }
 
 func (device nvmlDevice) RegisterEvents(eventTypes uint64, set EventSet) Return {
-	return nvmlDeviceRegisterEvents(device, eventTypes, set.(nvmlEventSet))
+	return nvmlDeviceRegisterEvents(device, eventTypes, nvmlEventSetHandle(set))
 }
 
 // nvmlDeviceGetSupportedEventTypes()
@@ -1859,7 +1859,7 @@ func (l *library) DeviceQueryDrainState(pciInfo *PciInfo) (EnableState, Return)
 
 // nvml.DeviceRemoveGpu()
 func (l *library) DeviceRemoveGpu(pciInfo *PciInfo) Return {
-	return nvmlDeviceRemoveGpu(pciInfo)
+	return nvmlDeviceRemoveGpu_v1(pciInfo)
 }
 
 // nvml.DeviceRemoveGpu_v2()
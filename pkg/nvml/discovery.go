@@ -0,0 +1,203 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/go-nvml/pkg/dl"
+)
+
+// Sources of the candidates considered when discovering the NVML library
+const (
+	LibraryCandidateSearchPath = "search path"
+	LibraryCandidateLdcache    = "ldcache"
+	LibraryCandidateDriverRoot = "driver root"
+	LibraryCandidateDefault    = "default"
+)
+
+// commonLibraryDirs are the directories searched under a driver root
+var commonLibraryDirs = []string{
+	"/usr/lib64",
+	"/usr/lib/x86_64-linux-gnu",
+	"/usr/lib/aarch64-linux-gnu",
+	"/lib64",
+	"/lib/x86_64-linux-gnu",
+	"/lib/aarch64-linux-gnu",
+	"/usr/lib",
+	"/lib",
+}
+
+// LibraryCandidate is a library considered while discovering the NVML library
+type LibraryCandidate struct {
+	// Path is the path of the library, or its name if it was left to the
+	// dynamic loader to resolve.
+	Path string
+	// Source is where the candidate was found (e.g. LibraryCandidateLdcache).
+	Source string
+	// Err is the reason the candidate could not be loaded, or nil if it was
+	// selected.
+	Err error
+}
+
+// libraryDiscovery is a dynamicLibrary that searches for the NVML library
+// when opened, loading the first candidate that can be opened
+type libraryDiscovery struct {
+	name        string
+	flags       int
+	driverRoot  string
+	searchPaths []string
+	newLibrary  func(string, int) dynamicLibrary
+	lib         dynamicLibrary
+	candidates  []LibraryCandidate
+}
+
+var _ dynamicLibrary = (*libraryDiscovery)(nil)
+
+// WithDriverRoot provides an option to search for the NVML library under the
+// specified driver root (e.g. /run/nvidia/driver). The ldcache and the common
+// library directories of the root are searched. This has no effect if a
+// library path is set through WithLibraryPath.
+func WithDriverRoot(root string) LibraryOption {
+	return func(o *libraryOptions) {
+		o.driverRoot = root
+	}
+}
+
+// WithSearchPaths provides an option to search for the NVML library in the
+// specified directories before any other location. The directories are
+// resolved relative to the driver root, if any. This has no effect if a
+// library path is set through WithLibraryPath.
+func WithSearchPaths(paths ...string) LibraryOption {
+	return func(o *libraryOptions) {
+		o.searchPaths = append(o.searchPaths, paths...)
+	}
+}
+
+func newLibraryDiscovery(name string, flags int, driverRoot string, searchPaths []string) *libraryDiscovery {
+	return &libraryDiscovery{
+		name:        name,
+		flags:       flags,
+		driverRoot:  driverRoot,
+		searchPaths: searchPaths,
+		newLibrary: func(path string, flags int) dynamicLibrary {
+			return dl.New(path, flags)
+		},
+	}
+}
+
+// LibraryCandidates returns the candidates considered the last time the library
+// was loaded, in the order in which they were tried. This is only populated if
+// the library was discovered through WithDriverRoot or WithSearchPaths.
+func (l *library) LibraryCandidates() []LibraryCandidate {
+	l.Lock()
+	defer l.Unlock()
+	d, ok := l.dl.(*libraryDiscovery)
+	if !ok {
+		return nil
+	}
+	return append([]LibraryCandidate(nil), d.candidates...)
+}
+
+// Open loads the first candidate that can be opened
+func (d *libraryDiscovery) Open() error {
+	d.candidates = nil
+	var errs []error
+	for _, c := range d.probe() {
+		if filepath.IsAbs(c.Path) {
+			if _, err := os.Stat(c.Path); err != nil {
+				c.Err = err
+				d.candidates = append(d.candidates, c)
+				errs = append(errs, err)
+				continue
+			}
+		}
+		lib := d.newLibrary(c.Path, d.flags)
+		if err := lib.Open(); err != nil {
+			c.Err = err
+			d.candidates = append(d.candidates, c)
+			errs = append(errs, fmt.Errorf("%s: %w", c.Path, err))
+			continue
+		}
+		d.lib = lib
+		d.candidates = append(d.candidates, c)
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("%s not found", d.name)
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the loaded library
+func (d *libraryDiscovery) Close() error {
+	if d.lib == nil {
+		return nil
+	}
+	if err := d.lib.Close(); err != nil {
+		return err
+	}
+	d.lib = nil
+	return nil
+}
+
+// Lookup checks whether a symbol exists in the loaded library
+func (d *libraryDiscovery) Lookup(name string) error {
+	if d.lib == nil {
+		return errLibraryNotLoaded
+	}
+	return d.lib.Lookup(name)
+}
+
+// probe returns the candidates to try, in order. Candidates found in the
+// search paths come first, followed by those found through the ldcache and
+// the common library directories of the driver root. Without a driver root,
+// the dynamic loader resolves the library name as a last resort.
+func (d *libraryDiscovery) probe() []LibraryCandidate {
+	var candidates []LibraryCandidate
+	seen := make(map[string]bool)
+	add := func(path string, source string) {
+		if seen[path] {
+			return
+		}
+		seen[path] = true
+		candidates = append(candidates, LibraryCandidate{Path: path, Source: source})
+	}
+
+	for _, dir := range d.searchPaths {
+		add(filepath.Join(d.driverRoot, dir, d.name), LibraryCandidateSearchPath)
+	}
+
+	if d.driverRoot == "" {
+		add(d.name, LibraryCandidateDefault)
+		return candidates
+	}
+
+	paths, err := ldcacheLookup(filepath.Join(d.driverRoot, "/etc/ld.so.cache"), d.name, ldcacheFlags)
+	if err == nil {
+		for _, path := range paths {
+			add(filepath.Join(d.driverRoot, path), LibraryCandidateLdcache)
+		}
+	}
+	for _, dir := range commonLibraryDirs {
+		add(filepath.Join(d.driverRoot, dir, d.name), LibraryCandidateDriverRoot)
+	}
+	return candidates
+}
@@ -0,0 +1,194 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ldcacheEntry is an entry of an ld.so.cache written by writeLdcache
+type ldcacheEntry struct {
+	name  string
+	path  string
+	flags uint32
+}
+
+// writeLdcache writes an ld.so.cache in the new format mapping library names
+// to paths, optionally preceded by an empty section in the old format
+func writeLdcache(t *testing.T, path string, withOld bool, entries []ldcacheEntry) {
+	var strings []byte
+	offsets := make([][2]uint32, len(entries))
+	stringsOffset := ldcacheNewHeaderSize + len(entries)*ldcacheNewEntrySize
+	for i, e := range entries {
+		for j, s := range []string{e.name, e.path} {
+			offsets[i][j] = uint32(stringsOffset + len(strings))
+			strings = append(append(strings, s...), 0)
+		}
+	}
+
+	cache := make([]byte, ldcacheNewHeaderSize, stringsOffset)
+	copy(cache, ldcacheMagicNew)
+	binary.LittleEndian.PutUint32(cache[20:], uint32(len(entries)))
+	binary.LittleEndian.PutUint32(cache[24:], uint32(len(strings)))
+	for i, o := range offsets {
+		entry := make([]byte, ldcacheNewEntrySize)
+		binary.LittleEndian.PutUint32(entry[0:], entries[i].flags)
+		binary.LittleEndian.PutUint32(entry[4:], o[0])
+		binary.LittleEndian.PutUint32(entry[8:], o[1])
+		cache = append(cache, entry...)
+	}
+	cache = append(cache, strings...)
+
+	if withOld {
+		old := make([]byte, ldcacheOldHeaderSize)
+		copy(old, ldcacheMagicOld)
+		cache = append(old, cache...)
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, cache, 0644))
+}
+
+func TestLdcacheLookup(t *testing.T) {
+	for _, withOld := range []bool{false, true} {
+		path := filepath.Join(t.TempDir(), "ld.so.cache")
+		writeLdcache(t, path, withOld, []ldcacheEntry{
+			{"libnvidia-ml.so.1", "/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1", 0x0303},
+			{"libc.so.6", "/usr/lib/x86_64-linux-gnu/libc.so.6", 0x0303},
+			{"libnvidia-ml.so.1", "/usr/lib/i386-linux-gnu/libnvidia-ml.so.1", 0x0003},
+			{"libnvidia-ml.so.1", "/usr/lib/aarch64-linux-gnu/libnvidia-ml.so.1", 0x0a03},
+		})
+
+		paths, err := ldcacheLookup(path, "libnvidia-ml.so.1", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1",
+			"/usr/lib/i386-linux-gnu/libnvidia-ml.so.1",
+			"/usr/lib/aarch64-linux-gnu/libnvidia-ml.so.1",
+		}, paths)
+
+		// Only the entries matching the flags of an architecture are returned
+		paths, err = ldcacheLookup(path, "libnvidia-ml.so.1", ldcacheArchFlags["amd64"])
+		require.NoError(t, err)
+		require.Equal(t, []string{"/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"}, paths)
+
+		paths, err = ldcacheLookup(path, "libnvidia-ml.so.1", ldcacheArchFlags["arm64"])
+		require.NoError(t, err)
+		require.Equal(t, []string{"/usr/lib/aarch64-linux-gnu/libnvidia-ml.so.1"}, paths)
+	}
+
+	path := filepath.Join(t.TempDir(), "ld.so.cache")
+	require.NoError(t, os.WriteFile(path, []byte("not a cache"), 0644))
+	_, err := ldcacheLookup(path, "libnvidia-ml.so.1", 0)
+	require.ErrorIs(t, err, errInvalidLdcache)
+}
+
+func TestLibraryDiscovery(t *testing.T) {
+	root := t.TempDir()
+	writeLdcache(t, filepath.Join(root, "etc/ld.so.cache"), false, []ldcacheEntry{
+		{"libnvidia-ml.so.1", "/usr/lib/i386-linux-gnu/libnvidia-ml.so.1", 0x0003},
+		{"libnvidia-ml.so.1", "/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1", ldcacheFlags},
+	})
+	for _, dir := range []string{"opt/nvidia", "usr/lib/x86_64-linux-gnu", "usr/lib64"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, dir, "libnvidia-ml.so.1"), nil, 0644))
+	}
+
+	errOpen := errors.New("open error")
+	var opened []string
+	d := newLibraryDiscovery(defaultNvmlLibraryName, defaultNvmlLibraryLoadFlags, root, []string{"/opt/nvidia", "/missing"})
+	d.newLibrary = func(path string, flags int) dynamicLibrary {
+		return &dynamicLibraryMock{
+			OpenFunc: func() error {
+				opened = append(opened, path)
+				if path == filepath.Join(root, "opt/nvidia/libnvidia-ml.so.1") {
+					return errOpen
+				}
+				return nil
+			},
+		}
+	}
+
+	require.NoError(t, d.Open())
+	require.Equal(t, []string{
+		filepath.Join(root, "opt/nvidia/libnvidia-ml.so.1"),
+		filepath.Join(root, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+	}, opened)
+
+	require.Len(t, d.candidates, 3)
+	require.Equal(t, LibraryCandidateSearchPath, d.candidates[0].Source)
+	require.ErrorIs(t, d.candidates[0].Err, errOpen)
+	require.Equal(t, LibraryCandidateSearchPath, d.candidates[1].Source)
+	require.ErrorIs(t, d.candidates[1].Err, os.ErrNotExist)
+	require.Equal(t, LibraryCandidate{
+		Path:   filepath.Join(root, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+		Source: LibraryCandidateLdcache,
+	}, d.candidates[2])
+
+	// The common library directories are searched once the ldcache entries
+	// are exhausted, skipping candidates that were already tried
+	opened = nil
+	d.newLibrary = func(path string, flags int) dynamicLibrary {
+		return &dynamicLibraryMock{
+			OpenFunc: func() error {
+				opened = append(opened, path)
+				return errOpen
+			},
+		}
+	}
+	err := d.Open()
+	require.ErrorIs(t, err, errOpen)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.ErrorContains(t, err, filepath.Join(root, "missing/libnvidia-ml.so.1"))
+	require.Equal(t, []string{
+		filepath.Join(root, "opt/nvidia/libnvidia-ml.so.1"),
+		filepath.Join(root, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+		filepath.Join(root, "usr/lib64/libnvidia-ml.so.1"),
+	}, opened)
+}
+
+func TestWithDriverRoot(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "usr/lib64"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "usr/lib64/libnvidia-ml.so.1"), []byte("not a library"), 0644))
+
+	l := New(WithDriverRoot(root))
+	require.Equal(t, ERROR_LIBRARY_NOT_FOUND, l.Init())
+
+	var failed []LibraryCandidate
+	inspector, ok := l.Extensions().(LibraryInspector)
+	require.True(t, ok)
+	for _, c := range inspector.LibraryCandidates() {
+		require.Error(t, c.Err)
+		if !errors.Is(c.Err, os.ErrNotExist) {
+			failed = append(failed, c)
+		}
+	}
+	require.Len(t, failed, 1)
+	require.Equal(t, filepath.Join(root, "usr/lib64/libnvidia-ml.so.1"), failed[0].Path)
+	require.Equal(t, LibraryCandidateDriverRoot, failed[0].Source)
+
+	// An explicit library path disables discovery
+	l = New(WithDriverRoot(root), WithLibraryPath("libnvidia-ml.so.1"))
+	require.Nil(t, l.Extensions().(LibraryInspector).LibraryCandidates())
+}
@@ -34,6 +34,18 @@ func (e nvmlEventData) convert() EventData {
 	return out
 }
 
+// nvmlEventSetHandle returns the nvmlEventSet underlying an EventSet,
+// removing any middleware wrapping it
+func nvmlEventSetHandle(set EventSet) nvmlEventSet {
+	for {
+		w, ok := set.(*eventSetMiddleware)
+		if !ok {
+			return set.(nvmlEventSet)
+		}
+		set = w.EventSet
+	}
+}
+
 // nvml.EventSetCreate()
 func (l *library) EventSetCreate() (EventSet, Return) {
 	var Set nvmlEventSet
@@ -0,0 +1,212 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package events delivers NVML events on a Go channel.
+//
+// A Watcher registers the requested event types with the devices of an NVML
+// library and waits on the resulting event set in a goroutine until its
+// context is cancelled:
+//
+//	w, err := events.Watch(ctx, lib, nvml.EventTypeXidCriticalError)
+//	if err != nil {
+//		...
+//	}
+//	for e := range w.Events() {
+//		...
+//	}
+//	if err := w.Err(); err != nil {
+//		...
+//	}
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/nvmlerr"
+)
+
+// DefaultPollInterval is the timeout of each wait on the event set. It bounds
+// the time taken to stop a watcher once its context is cancelled.
+const DefaultPollInterval = 100 * time.Millisecond
+
+var errNoSupportedDevices = errors.New("no device supports the requested event types")
+
+// Watcher delivers the events of an NVML event set on a channel
+type Watcher struct {
+	sync.Mutex
+	events chan nvml.EventData
+	err    error
+}
+
+// options hold the parameters that can be set by an Option
+type options struct {
+	devices      []nvml.Device
+	pollInterval time.Duration
+}
+
+// Option represents a functional option to configure a Watcher
+type Option func(*options)
+
+// WithDevices sets the devices to watch. All devices are watched by default.
+func WithDevices(devices ...nvml.Device) Option {
+	return func(o *options) {
+		o.devices = devices
+	}
+}
+
+// WithPollInterval sets the timeout of each wait on the event set
+func WithPollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = interval
+	}
+}
+
+// Watch registers the specified event types with the devices of lib and
+// delivers their events until ctx is cancelled. Event types that a device
+// does not support are not registered for it, and devices that support none
+// of the event types are skipped. The event set is freed and the events
+// channel closed once the watcher stops.
+func Watch(ctx context.Context, lib nvml.Interface, eventTypes uint64, opts ...Option) (*Watcher, error) {
+	o := options{
+		pollInterval: DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.devices == nil {
+		devices, err := getDevices(lib)
+		if err != nil {
+			return nil, err
+		}
+		o.devices = devices
+	}
+
+	set, ret := lib.EventSetCreate()
+	if ret != nvml.SUCCESS {
+		return nil, nvmlerr.FromReturn("EventSetCreate", ret)
+	}
+	if err := register(set, o.devices, eventTypes); err != nil {
+		_ = set.Free()
+		return nil, err
+	}
+
+	w := &Watcher{
+		events: make(chan nvml.EventData),
+	}
+	go w.run(ctx, set, o.pollInterval)
+	return w, nil
+}
+
+// Events returns the channel on which events are delivered. The channel is
+// closed once the watcher stops.
+func (w *Watcher) Events() <-chan nvml.EventData {
+	return w.events
+}
+
+// Err returns the error that stopped the watcher, if any. It returns nil while
+// the watcher is running and if it was stopped by cancelling its context.
+func (w *Watcher) Err() error {
+	w.Lock()
+	defer w.Unlock()
+	return w.err
+}
+
+// getDevices returns all the devices of the library
+func getDevices(lib nvml.Interface) ([]nvml.Device, error) {
+	count, ret := lib.DeviceGetCount()
+	if ret != nvml.SUCCESS {
+		return nil, nvmlerr.FromReturn("DeviceGetCount", ret)
+	}
+	var devices []nvml.Device
+	for i := 0; i < count; i++ {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			return nil, nvmlerr.FromReturn("DeviceGetHandleByIndex", ret)
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+// register registers the supported event types of each device with the set
+func register(set nvml.EventSet, devices []nvml.Device, eventTypes uint64) error {
+	registered := 0
+	for i, device := range devices {
+		supported, ret := device.GetSupportedEventTypes()
+		if ret == nvml.ERROR_NOT_SUPPORTED {
+			continue
+		}
+		if ret != nvml.SUCCESS {
+			return nvmlerr.FromDeviceReturn("DeviceGetSupportedEventTypes", fmt.Sprintf("index %d", i), ret)
+		}
+		if eventTypes&supported == 0 {
+			continue
+		}
+		ret = device.RegisterEvents(eventTypes&supported, set)
+		if ret != nvml.SUCCESS {
+			return nvmlerr.FromDeviceReturn("DeviceRegisterEvents", fmt.Sprintf("index %d", i), ret)
+		}
+		registered++
+	}
+	if registered == 0 {
+		return errNoSupportedDevices
+	}
+	return nil
+}
+
+// run waits on the event set and delivers its events until ctx is cancelled
+// or waiting fails with a non-retryable error
+func (w *Watcher) run(ctx context.Context, set nvml.EventSet, pollInterval time.Duration) {
+	defer close(w.events)
+	defer func() {
+		_ = set.Free()
+	}()
+
+	timeoutms := uint32(pollInterval / time.Millisecond)
+	if timeoutms == 0 {
+		timeoutms = 1
+	}
+	for ctx.Err() == nil {
+		e, ret := set.Wait(timeoutms)
+		if ret == nvml.ERROR_TIMEOUT {
+			continue
+		}
+		if err := nvmlerr.FromReturn("EventSetWait", ret); err != nil {
+			if !nvmlerr.IsRetryable(err) {
+				w.Lock()
+				w.err = err
+				w.Unlock()
+				return
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(pollInterval):
+			}
+			continue
+		}
+
+		select {
+		case w.events <- e:
+		case <-ctx.Done():
+		}
+	}
+}
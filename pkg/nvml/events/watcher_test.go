@@ -0,0 +1,104 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+func TestWatch(t *testing.T) {
+	s, err := server.New(server.WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w, err := Watch(ctx, s, nvml.EventTypeXidCriticalError|nvml.EventTypeSingleBitEccErrorStorm, WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	require.Equal(t, 0, s.EmitEvent(s.Devices[0], nvml.EventTypePState, 0))
+	require.Equal(t, 1, s.EmitEvent(s.Devices[1], nvml.EventTypeXidCriticalError, 79))
+
+	select {
+	case e := <-w.Events():
+		require.Equal(t, s.Devices[1], e.Device)
+		require.EqualValues(t, 79, e.EventData)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	// Cancelling the context closes the channel and frees the event set
+	cancel()
+	select {
+	case _, ok := <-w.Events():
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watcher to stop")
+	}
+	require.NoError(t, w.Err())
+	require.Equal(t, 0, s.EmitEvent(s.Devices[1], nvml.EventTypeXidCriticalError, 79))
+}
+
+func TestWatchDevices(t *testing.T) {
+	s, err := server.New(server.WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	_, err = Watch(context.Background(), s, nvml.EventTypeSingleBitEccErrorStorm)
+	require.ErrorIs(t, err, errNoSupportedDevices)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err = Watch(ctx, s, nvml.EventTypeXidCriticalError, WithDevices(s.Devices[0]))
+	require.NoError(t, err)
+
+	require.Equal(t, 1, s.EmitEvent(s.Devices[0], nvml.EventTypeXidCriticalError, 79))
+	require.Equal(t, 0, s.EmitEvent(s.Devices[1], nvml.EventTypeXidCriticalError, 79))
+}
+
+func TestWatchError(t *testing.T) {
+	s, err := server.New(server.WithGPUs(gpus.H100_SXM5_80GB))
+	require.NoError(t, err)
+
+	// Fail waiting on the event set once an event has been delivered
+	lib := nvml.WrapInterface(s, func(ctx nvml.CallInfo, next func() []any) []any {
+		results := next()
+		if ctx.Interface == "EventSet" && ctx.Method == "Wait" && results[1] == nvml.SUCCESS {
+			return []any{nvml.EventData{}, nvml.ERROR_GPU_IS_LOST}
+		}
+		return results
+	})
+
+	w, err := Watch(context.Background(), lib, nvml.EventTypeXidCriticalError, WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, 1, s.EmitEvent(s.Devices[0], nvml.EventTypeXidCriticalError, 79))
+
+	select {
+	case _, ok := <-w.Events():
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watcher to stop")
+	}
+	require.ErrorIs(t, w.Err(), nvml.ERROR_GPU_IS_LOST)
+}
@@ -23,12 +23,24 @@ type GpmMetricsGetType struct {
 	Metrics    [333]GpmMetric
 }
 
+// nvmlGpmSampleHandle returns the nvmlGpmSample underlying a GpmSample,
+// removing any middleware wrapping it
+func nvmlGpmSampleHandle(sample GpmSample) nvmlGpmSample {
+	for {
+		w, ok := sample.(*gpmSampleMiddleware)
+		if !ok {
+			return sample.(nvmlGpmSample)
+		}
+		sample = w.GpmSample
+	}
+}
+
 func (g *GpmMetricsGetType) convert() *nvmlGpmMetricsGetType {
 	out := &nvmlGpmMetricsGetType{
 		Version:    g.Version,
 		NumMetrics: g.NumMetrics,
-		Sample1:    g.Sample1.(nvmlGpmSample),
-		Sample2:    g.Sample2.(nvmlGpmSample),
+		Sample1:    nvmlGpmSampleHandle(g.Sample1),
+		Sample2:    nvmlGpmSampleHandle(g.Sample2),
 	}
 	copy(out.Metrics[:], g.Metrics[:])
 
@@ -73,7 +85,10 @@ func (l *library) GpmMetricsGet(metricsGet *GpmMetricsGetType) Return {
 func gpmMetricsGet(metricsGet *GpmMetricsGetType) Return {
 	nvmlMetricsGet := metricsGet.convert()
 	ret := nvmlGpmMetricsGetStub(nvmlMetricsGet)
+	// Keep the caller's samples, which may be wrapped in middleware
+	sample1, sample2 := metricsGet.Sample1, metricsGet.Sample2
 	*metricsGet = *nvmlMetricsGet.convert()
+	metricsGet.Sample1, metricsGet.Sample2 = sample1, sample2
 	return ret
 }
 
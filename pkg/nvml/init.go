@@ -21,7 +21,11 @@ func (l *library) Init() Return {
 	if err := l.load(); err != nil {
 		return ERROR_LIBRARY_NOT_FOUND
 	}
-	return nvmlInit()
+	ret := nvmlInit()
+	if ret == SUCCESS {
+		l.setInitFlags(0)
+	}
+	return ret
 }
 
 // nvml.InitWithFlags()
@@ -29,7 +33,11 @@ func (l *library) InitWithFlags(flags uint32) Return {
 	if err := l.load(); err != nil {
 		return ERROR_LIBRARY_NOT_FOUND
 	}
-	return nvmlInitWithFlags(flags)
+	ret := nvmlInitWithFlags(flags)
+	if ret == SUCCESS {
+		l.setInitFlags(flags)
+	}
+	return ret
 }
 
 // nvml.Shutdown()
@@ -0,0 +1,131 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+	"time"
+)
+
+// InstrumentationSink receives the calls made through an instrumented NVML
+// library. It is typically backed by metrics such as Prometheus counters and
+// histograms.
+type InstrumentationSink interface {
+	// ObserveCall is called after each call with the name of the NVML function
+	// (e.g. "DeviceGetName"), the duration of the call and the Return of the
+	// call. Calls that do not return a Return are reported as SUCCESS.
+	ObserveCall(function string, duration time.Duration, ret Return)
+}
+
+// uninstrumentedMethods holds the methods of the wrapped interfaces that do not
+// map to an NVML function and are therefore not reported, keyed by interface.
+var uninstrumentedMethods = map[string]map[string]bool{
+	"Interface": {"Extensions": true},
+}
+
+// instrument returns a Middleware reporting the calls passed through it to sink.
+// Calls made on handles are named after the NVML function they map to, so that
+// Device.GetName() and DeviceGetName() are both reported as "DeviceGetName".
+func instrument(sink InstrumentationSink) Middleware {
+	return func(ctx CallInfo, next func() []any) []any {
+		if uninstrumentedMethods[ctx.Interface][ctx.Method] {
+			return next()
+		}
+
+		function := ctx.Method
+		if ctx.Interface != "Interface" {
+			function = ctx.Interface + ctx.Method
+		}
+
+		start := time.Now()
+		results := next()
+		duration := time.Since(start)
+
+		ret := SUCCESS
+		for _, result := range results {
+			if r, ok := result.(Return); ok {
+				ret = r
+			}
+		}
+		sink.ObserveCall(function, duration, ret)
+		return results
+	}
+}
+
+// CallStat holds the statistics of the calls made to an NVML function
+type CallStat struct {
+	Count         uint64
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+	// Errors holds the number of calls per non-SUCCESS Return
+	Errors map[Return]uint64
+}
+
+// CallStats is an InstrumentationSink aggregating the calls made to each NVML
+// function in memory.
+type CallStats struct {
+	sync.Mutex
+	stats map[string]*CallStat
+}
+
+var _ InstrumentationSink = (*CallStats)(nil)
+
+// NewCallStats creates an empty set of call statistics
+func NewCallStats() *CallStats {
+	return &CallStats{
+		stats: make(map[string]*CallStat),
+	}
+}
+
+// ObserveCall records a call to an NVML function
+func (s *CallStats) ObserveCall(function string, duration time.Duration, ret Return) {
+	s.Lock()
+	defer s.Unlock()
+	stat, exists := s.stats[function]
+	if !exists {
+		stat = &CallStat{Errors: make(map[Return]uint64)}
+		s.stats[function] = stat
+	}
+	stat.Count++
+	stat.TotalDuration += duration
+	if duration > stat.MaxDuration {
+		stat.MaxDuration = duration
+	}
+	if ret != SUCCESS {
+		stat.Errors[ret]++
+	}
+}
+
+// Snapshot returns a copy of the statistics of each NVML function called so far
+func (s *CallStats) Snapshot() map[string]CallStat {
+	s.Lock()
+	defer s.Unlock()
+	snapshot := make(map[string]CallStat, len(s.stats))
+	for function, stat := range s.stats {
+		errors := make(map[Return]uint64, len(stat.Errors))
+		for ret, count := range stat.Errors {
+			errors[ret] = count
+		}
+		snapshot[function] = CallStat{
+			Count:         stat.Count,
+			TotalDuration: stat.TotalDuration,
+			MaxDuration:   stat.MaxDuration,
+			Errors:        errors,
+		}
+	}
+	return snapshot
+}
@@ -0,0 +1,65 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentation(t *testing.T) {
+	stats := NewCallStats()
+	lib := WrapInterface(&middlewareTestLibrary{
+		devices: []Device{&middlewareTestDevice{name: "GPU-0"}},
+	}, instrument(stats))
+
+	device, ret := lib.DeviceGetHandleByIndex(0)
+	require.Equal(t, SUCCESS, ret)
+	_, ret = device.GetName()
+	require.Equal(t, SUCCESS, ret)
+	_, ret = lib.DeviceGetName(device)
+	require.Equal(t, SUCCESS, ret)
+	_, ret = lib.DeviceGetName(nvmlDevice{})
+	require.Equal(t, ERROR_INVALID_ARGUMENT, ret)
+
+	snapshot := stats.Snapshot()
+	require.Len(t, snapshot, 2)
+	require.EqualValues(t, 1, snapshot["DeviceGetHandleByIndex"].Count)
+	require.Empty(t, snapshot["DeviceGetHandleByIndex"].Errors)
+
+	// Device.GetName() and DeviceGetName() are reported as the same function
+	stat := snapshot["DeviceGetName"]
+	require.EqualValues(t, 3, stat.Count)
+	require.Equal(t, map[Return]uint64{ERROR_INVALID_ARGUMENT: 1}, stat.Errors)
+	require.GreaterOrEqual(t, stat.TotalDuration, stat.MaxDuration)
+}
+
+func TestWithInstrumentation(t *testing.T) {
+	stats := NewCallStats()
+
+	l := New(WithInstrumentation(stats))
+	require.Equal(t, "SUCCESS", l.ErrorString(SUCCESS))
+	require.EqualValues(t, 1, stats.Snapshot()["ErrorString"].Count)
+
+	// Accessors that are not NVML functions are not reported
+	require.NotNil(t, l.Extensions())
+	require.NotContains(t, stats.Snapshot(), "Extensions")
+	require.Len(t, stats.Snapshot(), 1)
+
+	require.ErrorIs(t, SetLibraryOptions(WithInstrumentation(stats)), errInstrumentationNotSupported)
+}
@@ -0,0 +1,119 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+)
+
+// The layout of ld.so.cache as written by glibc's ldconfig. The cache holds
+// an optional section in the old format followed by a section in the new
+// format, whose string offsets are relative to the start of the section.
+const (
+	ldcacheMagicOld      = "ld.so-1.7.0"
+	ldcacheOldHeaderSize = 16
+	ldcacheOldEntrySize  = 12
+	ldcacheMagicNew      = "glibc-ld.so.cache1.1"
+	ldcacheNewHeaderSize = 48
+	ldcacheNewEntrySize  = 24
+)
+
+var errInvalidLdcache = errors.New("invalid ld.so.cache")
+
+// ldcacheArchFlags are the flags of the ld.so.cache entries of 64-bit ELF
+// libraries that can be loaded on each architecture. These combine glibc's
+// FLAG_ELF_LIBC6 with the flag of the architecture (e.g. FLAG_X8664_LIB64).
+var ldcacheArchFlags = map[string]uint32{
+	"amd64":   0x0303,
+	"arm64":   0x0a03,
+	"ppc64le": 0x0503,
+}
+
+// ldcacheFlags are the flags of the ld.so.cache entries that can be loaded by
+// the running binary, or 0 if its architecture is not known
+var ldcacheFlags = ldcacheArchFlags[runtime.GOARCH]
+
+// ldcacheLookup returns the paths of the libraries with the specified name
+// in an ld.so.cache file, in the order in which they appear in the cache.
+// If flags is not 0, only entries with matching flags are returned, so that
+// libraries built for another architecture (e.g. 32-bit libraries) are
+// skipped. The cache is assumed to be little-endian.
+func ldcacheLookup(path string, name string, flags uint32) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(data, []byte(ldcacheMagicOld)) {
+		if len(data) < ldcacheOldHeaderSize {
+			return nil, fmt.Errorf("%w: truncated header", errInvalidLdcache)
+		}
+		nlibs := int(binary.LittleEndian.Uint32(data[12:16]))
+		offset := ldcacheOldHeaderSize + nlibs*ldcacheOldEntrySize
+		offset = (offset + 7) &^ 7
+		if offset > len(data) {
+			return nil, fmt.Errorf("%w: truncated entries", errInvalidLdcache)
+		}
+		data = data[offset:]
+	}
+
+	if !bytes.HasPrefix(data, []byte(ldcacheMagicNew)) || len(data) < ldcacheNewHeaderSize {
+		return nil, fmt.Errorf("%w: unsupported format", errInvalidLdcache)
+	}
+	nlibs := int(binary.LittleEndian.Uint32(data[20:24]))
+	if ldcacheNewHeaderSize+nlibs*ldcacheNewEntrySize > len(data) {
+		return nil, fmt.Errorf("%w: truncated entries", errInvalidLdcache)
+	}
+
+	var paths []string
+	for i := 0; i < nlibs; i++ {
+		entry := data[ldcacheNewHeaderSize+i*ldcacheNewEntrySize:]
+		if flags != 0 && binary.LittleEndian.Uint32(entry[0:4]) != flags {
+			continue
+		}
+		key, err := ldcacheString(data, binary.LittleEndian.Uint32(entry[4:8]))
+		if err != nil {
+			return nil, err
+		}
+		if key != name {
+			continue
+		}
+		value, err := ldcacheString(data, binary.LittleEndian.Uint32(entry[8:12]))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, value)
+	}
+	return paths, nil
+}
+
+// ldcacheString returns the NUL-terminated string at an offset of the cache
+func ldcacheString(data []byte, offset uint32) (string, error) {
+	if int(offset) >= len(data) {
+		return "", fmt.Errorf("%w: string offset out of range", errInvalidLdcache)
+	}
+	end := bytes.IndexByte(data[offset:], 0)
+	if end < 0 {
+		return "", fmt.Errorf("%w: unterminated string", errInvalidLdcache)
+	}
+	return string(data[offset : int(offset)+end]), nil
+}
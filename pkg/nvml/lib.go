@@ -33,6 +33,7 @@ const (
 
 var errLibraryNotLoaded = errors.New("library not loaded")
 var errLibraryAlreadyLoaded = errors.New("library already loaded")
+var errInstrumentationNotSupported = errors.New("instrumentation is only supported for libraries created with New")
 
 // dynamicLibrary is an interface for abstacting the underlying library.
 // This also allows for mocking and testing.
@@ -48,18 +49,27 @@ type dynamicLibrary interface {
 // This includes a reference to the underlying DynamicLibrary
 type library struct {
 	sync.Mutex
-	path     string
-	refcount refcount
-	dl       dynamicLibrary
+	path             string
+	flags            int
+	refcount         refcount
+	dl               dynamicLibrary
+	instrumentation  InstrumentationSink
+	versionedSymbols []string
+	initFlags        uint32
 }
 
 var _ Interface = (*library)(nil)
+var _ LibraryInspector = (*library)(nil)
 
 // libnvml is a global instance of the nvml library.
 var libnvml = newLibrary()
 
 func New(opts ...LibraryOption) Interface {
-	return newLibrary(opts...)
+	l := newLibrary(opts...)
+	if l.instrumentation != nil {
+		return WrapInterface(l, instrument(l.instrumentation))
+	}
+	return l
 }
 
 func newLibrary(opts ...LibraryOption) *library {
@@ -74,6 +84,8 @@ func (l *library) init(opts ...LibraryOption) {
 		opt(&o)
 	}
 
+	// The library is only searched for if no explicit path is set
+	discover := o.path == "" && (o.driverRoot != "" || len(o.searchPaths) > 0)
 	if o.path == "" {
 		o.path = defaultNvmlLibraryName
 	}
@@ -82,7 +94,12 @@ func (l *library) init(opts ...LibraryOption) {
 	}
 
 	l.path = o.path
+	l.flags = o.flags
 	l.dl = dl.New(o.path, o.flags)
+	if discover {
+		l.dl = newLibraryDiscovery(o.path, o.flags, o.driverRoot, o.searchPaths)
+	}
+	l.instrumentation = o.instrumentation
 }
 
 func (l *library) Extensions() ExtendedInterface {
@@ -117,6 +134,7 @@ func (l *library) load() (rerr error) {
 	errorStringFunc = nvmlErrorString
 
 	// Update all versioned symbols
+	l.versionedSymbols = nil
 	l.updateVersionedSymbols()
 
 	return nil
@@ -144,26 +162,8 @@ func (l *library) close() (rerr error) {
 	return nil
 }
 
-// Default all versioned APIs to v1 (to infer the types)
-var nvmlInit = nvmlInit_v1
-var nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v1
-var nvmlDeviceGetCount = nvmlDeviceGetCount_v1
-var nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v1
-var nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v1
-var nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v1
-var nvmlDeviceRemoveGpu = nvmlDeviceRemoveGpu_v1
-var nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v1
-var nvmlEventSetWait = nvmlEventSetWait_v1
-var nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v1
-var nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v1
-var deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v1
-var deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v1
-var deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v1
 var GetBlacklistDeviceCount = GetExcludedDeviceCount
 var GetBlacklistDeviceInfoByIndex = GetExcludedDeviceInfoByIndex
-var nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v1
-var nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v1
-var nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v1
 
 // BlacklistDeviceInfo was replaced by ExcludedDeviceInfo
 type BlacklistDeviceInfo = ExcludedDeviceInfo
@@ -194,103 +194,29 @@ func (pis ProcessInfo_v2Slice) ToProcessInfoSlice() []ProcessInfo {
 	return newInfos
 }
 
-// updateVersionedSymbols checks for versioned symbols in the loaded dynamic library.
-// If newer versioned symbols exist, these replace the default `v1` symbols initialized above.
-// When new versioned symbols are added, these would have to be initialized above and have
-// corresponding checks and subsequent assignments added below.
+// versionedFunction is a function with versioned symbols. Its versions are
+// listed from the newest to v1, each selecting the Go function implementing it.
+type versionedFunction struct {
+	symbol   string
+	versions []versionedSymbol
+}
+
+// versionedSymbol is a version of a versionedFunction
+type versionedSymbol struct {
+	symbol string
+	use    func()
+}
+
+// updateVersionedSymbols selects the newest version of each versioned function
+// that exists in the loaded dynamic library, falling back to v1. The functions
+// and their versions are generated from nvml.h in zz_generated.versions.go.
 func (l *library) updateVersionedSymbols() {
-	err := l.dl.Lookup("nvmlInit_v2")
-	if err == nil {
-		nvmlInit = nvmlInit_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetPciInfo_v2")
-	if err == nil {
-		nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetPciInfo_v3")
-	if err == nil {
-		nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetCount_v2")
-	if err == nil {
-		nvmlDeviceGetCount = nvmlDeviceGetCount_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetHandleByIndex_v2")
-	if err == nil {
-		nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetHandleByPciBusId_v2")
-	if err == nil {
-		nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetNvLinkRemotePciInfo_v2")
-	if err == nil {
-		nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v2
-	}
-	// Unable to overwrite nvmlDeviceRemoveGpu() because the v2 function takes
-	// a different set of parameters than the v1 function.
-	//err = l.dl.Lookup("nvmlDeviceRemoveGpu_v2")
-	//if err == nil {
-	//    nvmlDeviceRemoveGpu = nvmlDeviceRemoveGpu_v2
-	//}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v2")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v3")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v4")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v4
-	}
-	err = l.dl.Lookup("nvmlEventSetWait_v2")
-	if err == nil {
-		nvmlEventSetWait = nvmlEventSetWait_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetAttributes_v2")
-	if err == nil {
-		nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v2
-	}
-	err = l.dl.Lookup("nvmlComputeInstanceGetInfo_v2")
-	if err == nil {
-		nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v2")
-	if err == nil {
-		deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v3")
-	if err == nil {
-		deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v2")
-	if err == nil {
-		deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v3")
-	if err == nil {
-		deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v2")
-	if err == nil {
-		deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v3")
-	if err == nil {
-		deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGpuInstancePossiblePlacements_v2")
-	if err == nil {
-		nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v2
-	}
-	err = l.dl.Lookup("nvmlVgpuInstanceGetLicenseInfo_v2")
-	if err == nil {
-		nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetDriverModel_v2")
-	if err == nil {
-		nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v2
+	for _, f := range versionedFunctions {
+		for i, v := range f.versions {
+			if i == len(f.versions)-1 || l.selectVersionedSymbol(v.symbol) {
+				v.use()
+				break
+			}
+		}
 	}
 }
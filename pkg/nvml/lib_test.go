@@ -19,6 +19,7 @@ package nvml
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -243,3 +244,49 @@ func TestLoadAndCloseWithErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateVersionedSymbols(t *testing.T) {
+	funcPointer := func(f any) uintptr {
+		return reflect.ValueOf(f).Pointer()
+	}
+
+	// Loading a library updates the package-level dispatch variables, which
+	// are restored for the tests that follow
+	restore := saveVersionedSymbols()
+	t.Cleanup(restore)
+	initial := funcPointer(nvmlDeviceGetPciInfo)
+
+	// The newest version present in the library is selected
+	l := newTestLibrary(&dynamicLibraryMock{
+		LookupFunc: func(name string) error {
+			switch name {
+			case "nvmlDeviceGetPciInfo_v2", "nvmlDeviceGetComputeRunningProcesses_v2":
+				return nil
+			}
+			return fmt.Errorf("%s not found", name)
+		},
+	})
+	require.NoError(t, l.load())
+	require.Equal(t, []string{"nvmlDeviceGetComputeRunningProcesses_v2", "nvmlDeviceGetPciInfo_v2"}, l.versionedSymbols)
+	require.Equal(t, funcPointer(nvmlDeviceGetPciInfo_v2), funcPointer(nvmlDeviceGetPciInfo))
+	require.Equal(t, funcPointer(deviceGetComputeRunningProcesses_v2), funcPointer(deviceGetComputeRunningProcesses))
+	require.Equal(t, funcPointer(nvmlInit_v1), funcPointer(nvmlInit))
+	require.NoError(t, l.close())
+
+	// Loading a library with all versions selects the newest of each
+	l = newTestLibrary(&dynamicLibraryMock{})
+	require.NoError(t, l.load())
+	require.Len(t, l.versionedSymbols, len(versionedFunctions))
+	require.Equal(t, funcPointer(nvmlDeviceGetPciInfo_v3), funcPointer(nvmlDeviceGetPciInfo))
+	require.Equal(t, funcPointer(deviceGetComputeRunningProcesses_v3), funcPointer(deviceGetComputeRunningProcesses))
+	require.Equal(t, funcPointer(nvmlInit_v2), funcPointer(nvmlInit))
+	require.NoError(t, l.close())
+
+	// Each versioned function falls back to its unversioned symbol
+	for _, f := range versionedFunctions {
+		require.Equal(t, f.symbol, f.versions[len(f.versions)-1].symbol)
+	}
+
+	restore()
+	require.Equal(t, initial, funcPointer(nvmlDeviceGetPciInfo))
+}
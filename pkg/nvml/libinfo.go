@@ -0,0 +1,129 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+var errLibraryPathNotSupported = errors.New("library path not supported")
+
+// LibraryInfo describes the NVML library that is currently loaded
+type LibraryInfo struct {
+	// Path is the on-disk path of the loaded library with all symlinks
+	// resolved.
+	Path string
+	// Version is the driver version embedded in the file name of the library
+	// (e.g. 550.54.15 for libnvidia-ml.so.550.54.15), or empty if the file
+	// name does not include a version.
+	Version string
+	// VersionedSymbols are the versioned symbols selected in place of their
+	// v1 counterparts when the library was loaded (e.g. nvmlDeviceGetPciInfo_v3).
+	VersionedSymbols []string
+	// LoadFlags are the flags the library was opened with (e.g. RTLD_LAZY).
+	LoadFlags int
+	// InitFlags are the flags NVML was initialized with through InitWithFlags.
+	// These are 0 if Init was used.
+	InitFlags uint32
+}
+
+// pathLibrary is implemented by dynamic libraries that can report the path
+// from which they were loaded
+type pathLibrary interface {
+	Path() (string, error)
+}
+
+// LibraryInfo returns information about the loaded library.
+// Note that this requires that the library be loaded.
+func (l *library) LibraryInfo() (LibraryInfo, error) {
+	l.Lock()
+	defer l.Unlock()
+	if l.refcount == 0 {
+		return LibraryInfo{}, fmt.Errorf("error getting library info: %w", errLibraryNotLoaded)
+	}
+
+	info := LibraryInfo{
+		VersionedSymbols: append([]string(nil), l.versionedSymbols...),
+		LoadFlags:        l.flags,
+		InitFlags:        l.initFlags,
+	}
+
+	path, err := libraryPath(l.dl)
+	if err != nil {
+		return info, fmt.Errorf("error getting path of %s: %w", l.path, err)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return info, fmt.Errorf("error resolving %s: %w", path, err)
+	}
+	info.Path = resolved
+	info.Version = libraryVersion(resolved)
+
+	return info, nil
+}
+
+// setInitFlags records the flags NVML was last initialized with
+func (l *library) setInitFlags(flags uint32) {
+	l.Lock()
+	defer l.Unlock()
+	l.initFlags = flags
+}
+
+// libraryPath returns the path of a loaded dynamic library
+func libraryPath(lib dynamicLibrary) (string, error) {
+	if d, ok := lib.(*libraryDiscovery); ok {
+		if d.lib == nil {
+			return "", errLibraryNotLoaded
+		}
+		lib = d.lib
+	}
+	p, ok := lib.(pathLibrary)
+	if !ok {
+		return "", errLibraryPathNotSupported
+	}
+	return p.Path()
+}
+
+// libraryVersion returns the version suffix of a library file name such as
+// libnvidia-ml.so.550.54.15. Only numeric, dot-separated suffixes with more
+// than one component are considered versions, so that the soname version of
+// libnvidia-ml.so.1 is not reported as a driver version.
+func libraryVersion(path string) string {
+	_, version, found := strings.Cut(filepath.Base(path), ".so.")
+	if !found || !strings.Contains(version, ".") {
+		return ""
+	}
+	for _, part := range strings.Split(version, ".") {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return ""
+		}
+	}
+	return version
+}
+
+// selectVersionedSymbol checks whether a versioned symbol exists in the
+// loaded library, recording it as selected if it does
+func (l *library) selectVersionedSymbol(name string) bool {
+	if err := l.dl.Lookup(name); err != nil {
+		return false
+	}
+	l.versionedSymbols = append(l.versionedSymbols, name)
+	return true
+}
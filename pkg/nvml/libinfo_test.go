@@ -0,0 +1,96 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pathLibraryMock is a dynamicLibrary that reports the path it was loaded from
+type pathLibraryMock struct {
+	dynamicLibraryMock
+	path string
+}
+
+func (p *pathLibraryMock) Path() (string, error) {
+	return p.path, nil
+}
+
+func TestLibraryInfo(t *testing.T) {
+	t.Cleanup(saveVersionedSymbols())
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "libnvidia-ml.so.550.54.15")
+	link := filepath.Join(dir, "libnvidia-ml.so.1")
+	require.NoError(t, os.WriteFile(target, nil, 0644))
+	require.NoError(t, os.Symlink(filepath.Base(target), link))
+
+	l := newTestLibrary(&pathLibraryMock{path: link})
+	l.flags = defaultNvmlLibraryLoadFlags
+
+	_, err := l.LibraryInfo()
+	require.ErrorIs(t, err, errLibraryNotLoaded)
+
+	require.NoError(t, l.load())
+	defer func() { require.NoError(t, l.close()) }()
+	l.setInitFlags(1)
+
+	info, err := l.LibraryInfo()
+	require.NoError(t, err)
+	require.Equal(t, target, info.Path)
+	require.Equal(t, "550.54.15", info.Version)
+	require.Equal(t, defaultNvmlLibraryLoadFlags, info.LoadFlags)
+	require.EqualValues(t, 1, info.InitFlags)
+	require.Contains(t, info.VersionedSymbols, "nvmlInit_v2")
+	require.Contains(t, info.VersionedSymbols, "nvmlDeviceGetPciInfo_v3")
+	require.NotContains(t, info.VersionedSymbols, "nvmlDeviceGetPciInfo_v2")
+
+	// Libraries that cannot report their path still report the symbols
+	l = newTestLibrary(&dynamicLibraryMock{})
+	require.NoError(t, l.load())
+	defer func() { require.NoError(t, l.close()) }()
+	info, err = l.LibraryInfo()
+	require.ErrorIs(t, err, errLibraryPathNotSupported)
+	require.Contains(t, info.VersionedSymbols, "nvmlDeviceGetDriverModel_v2")
+}
+
+func TestLibraryVersion(t *testing.T) {
+	testCases := map[string]string{
+		"/usr/lib/libnvidia-ml.so.550.54.15": "550.54.15",
+		"libnvidia-ml.so.535.104.05":         "535.104.05",
+		"libnvidia-ml.so.1":                  "",
+		"libnvidia-ml.so":                    "",
+		"libnvidia-ml.so.550.54.15.bak":      "",
+	}
+	for path, expected := range testCases {
+		require.Equal(t, expected, libraryVersion(path), path)
+	}
+}
+
+func TestLibraryInspector(t *testing.T) {
+	passthrough := func(ctx CallInfo, next func() []any) []any {
+		return next()
+	}
+	for _, l := range []Interface{New(), WrapInterface(New(), passthrough)} {
+		_, ok := l.Extensions().(LibraryInspector)
+		require.True(t, ok)
+	}
+}
@@ -0,0 +1,39 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// CallInfo describes a call made through a middleware wrapper.
+type CallInfo struct {
+	// Interface is the name of the interface the method belongs to (e.g. "Device").
+	Interface string
+	// Method is the name of the method being called (e.g. "GetName").
+	Method string
+	// Receiver is the wrapped value the method is called on.
+	Receiver any
+	// Args holds the arguments of the call.
+	Args []any
+}
+
+// Middleware intercepts the calls made through a wrapper returned by one of
+// the Wrap* functions. It makes the call by invoking next and returns the
+// results to pass back to the caller, in the order of the method's results.
+//
+// A Middleware can inspect or replace the results of next, or skip the call
+// altogether by returning its own results. Handles (e.g. a Device) in the
+// results, including those held by returned structs such as GpuInstanceInfo,
+// are wrapped with the same Middleware before being returned.
+type Middleware func(ctx CallInfo, next func() []any) []any
@@ -0,0 +1,165 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type middlewareTestDevice struct {
+	Device
+	name string
+}
+
+func (d *middlewareTestDevice) GetName() (string, Return) {
+	return d.name, SUCCESS
+}
+
+func (d *middlewareTestDevice) GetIndex() (int, Return) {
+	return 0, SUCCESS
+}
+
+type middlewareTestLibrary struct {
+	Interface
+	devices []Device
+}
+
+func (l *middlewareTestLibrary) DeviceGetHandleByIndex(index int) (Device, Return) {
+	return l.devices[index], SUCCESS
+}
+
+func (l *middlewareTestLibrary) DeviceGetName(device Device) (string, Return) {
+	// Only unwrapped devices are expected to reach the wrapped library
+	if _, ok := device.(*middlewareTestDevice); !ok {
+		return "", ERROR_INVALID_ARGUMENT
+	}
+	return device.GetName()
+}
+
+func TestMiddleware(t *testing.T) {
+	device := &middlewareTestDevice{name: "GPU-0"}
+	lib := &middlewareTestLibrary{devices: []Device{device}}
+
+	var calls []CallInfo
+	wrapped := WrapInterface(lib, func(ctx CallInfo, next func() []any) []any {
+		calls = append(calls, ctx)
+		if ctx.Method == "GetIndex" {
+			return []any{0, ERROR_GPU_IS_LOST}
+		}
+		return next()
+	})
+
+	d, ret := wrapped.DeviceGetHandleByIndex(0)
+	require.Equal(t, SUCCESS, ret)
+	require.NotEqual(t, device, d)
+
+	name, ret := d.GetName()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "GPU-0", name)
+
+	name, ret = wrapped.DeviceGetName(d)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "GPU-0", name)
+
+	_, ret = d.GetIndex()
+	require.Equal(t, ERROR_GPU_IS_LOST, ret)
+
+	require.Equal(t, []CallInfo{
+		{Interface: "Interface", Method: "DeviceGetHandleByIndex", Receiver: lib, Args: []any{0}},
+		{Interface: "Device", Method: "GetName", Receiver: device, Args: []any{}},
+		{Interface: "Interface", Method: "DeviceGetName", Receiver: lib, Args: []any{d}},
+		{Interface: "Device", Method: "GetIndex", Receiver: device, Args: []any{}},
+	}, calls)
+
+	// Wrapped devices are still converted to NVML handles
+	require.Equal(t, nvmlDevice{}, nvmlDeviceHandle(WrapDevice(nvmlDevice{}, nil)))
+}
+
+type middlewareTestGpuInstance struct {
+	GpuInstance
+	device Device
+}
+
+func (gi *middlewareTestGpuInstance) GetInfo() (GpuInstanceInfo, Return) {
+	return GpuInstanceInfo{Device: gi.device, Id: 1}, SUCCESS
+}
+
+func TestMiddlewareHandlesInStructs(t *testing.T) {
+	device := &middlewareTestDevice{name: "GPU-0"}
+	gi := &middlewareTestGpuInstance{device: device}
+
+	var calls []string
+	wrapped := WrapGpuInstance(gi, func(ctx CallInfo, next func() []any) []any {
+		calls = append(calls, ctx.Interface+"."+ctx.Method)
+		return next()
+	})
+
+	// Handles held by returned structs are wrapped with the same middleware
+	info, ret := wrapped.GetInfo()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(1), info.Id)
+	require.IsType(t, &deviceMiddleware{}, info.Device)
+
+	name, ret := info.Device.GetName()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "GPU-0", name)
+	require.Equal(t, []string{"GpuInstance.GetInfo", "Device.GetName"}, calls)
+
+	// Nil handles are not wrapped
+	require.Nil(t, wrapComputeInstanceInfo(ComputeInstanceInfo{}, nil).Device)
+}
+
+func TestMiddlewareUnwrapsAllLayers(t *testing.T) {
+	device := &middlewareTestDevice{name: "GPU-0"}
+	lib := &middlewareTestLibrary{devices: []Device{device}}
+
+	passthrough := func(ctx CallInfo, next func() []any) []any {
+		return next()
+	}
+	wrapped := WrapInterface(lib, passthrough)
+
+	// A device wrapped more than once is unwrapped completely before reaching
+	// the wrapped library
+	d := WrapDevice(WrapDevice(device, passthrough), passthrough)
+	name, ret := wrapped.DeviceGetName(d)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "GPU-0", name)
+	require.Equal(t, Device(device), unwrapDevice(d))
+}
+
+func TestMiddlewareGpmSamples(t *testing.T) {
+	defer setNvmlGpmMetricsGetStubForTest(func(metricsGet *nvmlGpmMetricsGetType) Return {
+		return SUCCESS
+	})()
+
+	passthrough := func(ctx CallInfo, next func() []any) []any {
+		return next()
+	}
+	sample1 := WrapGpmSample(nvmlGpmSample{}, passthrough)
+	sample2 := WrapGpmSample(WrapGpmSample(nvmlGpmSample{}, passthrough), passthrough)
+
+	metrics := GpmMetricsGetType{
+		Sample1: sample1,
+		Sample2: sample2,
+	}
+	ret := GpmMetricsGet(&metrics)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, sample1, metrics.Sample1)
+	require.Equal(t, sample2, metrics.Sample2)
+}
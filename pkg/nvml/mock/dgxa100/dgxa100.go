@@ -53,7 +53,29 @@ type ComputeInstance = server.ComputeInstance
 type CudaComputeCapability = server.CudaComputeCapability
 
 func New() *Server {
-	return NewWithGPUs(gpus.Multiple(8, gpus.A100_SXM4_40GB)...)
+	s := NewWithGPUs(gpus.Multiple(8, gpus.A100_SXM4_40GB)...)
+	_ = s.SetTopology(Topology())
+	return s
+}
+
+// Topology returns the topology of a DGX A100: two AMD EPYC 7742 sockets,
+// pairs of GPUs behind a PCIe switch hierarchy and six NVSwitches with twelve
+// NVLinks per GPU.
+func Topology() *server.Topology {
+	return &server.Topology{
+		NUMANodes: []server.NUMANode{
+			{CPUs: append(server.CPURange(0, 63), server.CPURange(128, 191)...), Devices: []int{0, 1, 2, 3}},
+			{CPUs: append(server.CPURange(64, 127), server.CPURange(192, 255)...), Devices: []int{4, 5, 6, 7}},
+		},
+		PCIeSwitches: []server.PCIeSwitch{
+			{Devices: []int{0, 1}, Level: nvml.TOPOLOGY_MULTIPLE},
+			{Devices: []int{2, 3}, Level: nvml.TOPOLOGY_MULTIPLE},
+			{Devices: []int{4, 5}, Level: nvml.TOPOLOGY_MULTIPLE},
+			{Devices: []int{6, 7}, Level: nvml.TOPOLOGY_MULTIPLE},
+		},
+		NVSwitches:       6,
+		NVLinksPerDevice: 12,
+	}
 }
 
 func NewDevice(index int) *Device {
@@ -298,6 +298,11 @@ func TestGpuInstanceLifecycle(t *testing.T) {
 	profileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
 	require.Equal(t, nvml.SUCCESS, ret)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Create GPU instance
 	gi, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -340,6 +345,11 @@ func TestGpuInstanceWithPlacement(t *testing.T) {
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.NotEmpty(t, placements)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Create GPU instance with specific placement
 	gi, ret := device.CreateGpuInstanceWithPlacement(&profileInfo, &placements[0])
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -365,6 +375,11 @@ func TestComputeInstanceLifecycle(t *testing.T) {
 	giProfileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
 	require.Equal(t, nvml.SUCCESS, ret)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	gi, ret := device.CreateGpuInstance(&giProfileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.NotNil(t, gi)
@@ -576,3 +591,25 @@ func TestHeterogeneousGPUs(t *testing.T) {
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.Equal(t, uint32(0x20B510DE), pci3.PciDeviceId) // A100-PCIE-80GB
 }
+
+func TestTopology(t *testing.T) {
+	server := New()
+
+	level, ret := server.DeviceGetTopologyCommonAncestor(server.Devices[0], server.Devices[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.TOPOLOGY_MULTIPLE, level)
+
+	level, ret = server.DeviceGetTopologyCommonAncestor(server.Devices[0], server.Devices[4])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.TOPOLOGY_SYSTEM, level)
+
+	node, ret := server.Devices[5].GetNumaNodeId()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 1, node)
+
+	deviceType, ret := server.Devices[0].GetNvLinkRemoteDeviceType(11)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.NVLINK_DEVICE_TYPE_SWITCH, deviceType)
+	_, ret = server.Devices[0].GetNvLinkState(12)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
@@ -63,6 +63,11 @@ func TestB200Server(t *testing.T) {
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.Equal(t, uint32(1), profileInfo.IsP2pSupported)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Test MIG functionality
 	gpuInstance, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -160,6 +165,11 @@ func TestB200AdvancedFeatures(t *testing.T) {
 	require.Equal(t, uint32(4), profileInfo.JpegCount)    // JPEG engines
 	require.Equal(t, uint32(4), profileInfo.OfaCount)     // OFA engines
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Test GPU instance creation with advanced profile
 	gpuInstance, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -178,6 +188,11 @@ func TestB200MIGInstanceManagement(t *testing.T) {
 	profileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_2_SLICE)
 	require.Equal(t, nvml.SUCCESS, ret)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Create GPU instance
 	gi, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -62,6 +62,11 @@ func TestH100Server(t *testing.T) {
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.Equal(t, uint32(1), profileInfo.IsP2pSupported)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Test MIG functionality
 	gpuInstance, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -72,3 +77,23 @@ func TestH100Server(t *testing.T) {
 	require.Equal(t, uint32(0), giInfo.Id)
 	require.Equal(t, uint32(nvml.GPU_INSTANCE_PROFILE_1_SLICE), giInfo.ProfileId)
 }
+
+func TestH100Topology(t *testing.T) {
+	server := New()
+
+	level, ret := server.DeviceGetTopologyCommonAncestor(server.Devices[0], server.Devices[3])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.TOPOLOGY_NODE, level)
+
+	gpuSet, ret := server.SystemGetTopologyGpuSet(56)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, server.Devices[4:], gpuSet)
+
+	status, ret := server.DeviceGetP2PStatus(server.Devices[0], server.Devices[7], nvml.P2P_CAPS_INDEX_NVLINK)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_OK, status)
+
+	state, ret := server.Devices[0].GetNvLinkState(17)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.FEATURE_ENABLED, state)
+}
@@ -23,7 +23,22 @@ import (
 )
 
 func New() *server.Server {
-	return NewWithGPUs(gpus.Multiple(8, gpus.H200_SXM5_141GB)...)
+	s := NewWithGPUs(gpus.Multiple(8, gpus.H200_SXM5_141GB)...)
+	_ = s.SetTopology(Topology())
+	return s
+}
+
+// Topology returns the topology of a DGX H200: two Intel Xeon 8480C sockets
+// with four GPUs each and four NVSwitches with eighteen NVLinks per GPU.
+func Topology() *server.Topology {
+	return &server.Topology{
+		NUMANodes: []server.NUMANode{
+			{CPUs: append(server.CPURange(0, 55), server.CPURange(112, 167)...), Devices: []int{0, 1, 2, 3}},
+			{CPUs: append(server.CPURange(56, 111), server.CPURange(168, 223)...), Devices: []int{4, 5, 6, 7}},
+		},
+		NVSwitches:       4,
+		NVLinksPerDevice: 18,
+	}
 }
 
 func NewWithGPUs(gpus ...gpus.Config) *server.Server {
@@ -63,6 +63,11 @@ func TestH200Server(t *testing.T) {
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.Equal(t, uint32(1), profileInfo.IsP2pSupported)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	// Test MIG functionality
 	gpuInstance, ret := device.CreateGpuInstance(&profileInfo)
 	require.Equal(t, nvml.SUCCESS, ret)
@@ -148,6 +153,11 @@ func TestH200MIGInstanceCreation(t *testing.T) {
 	profileInfo1, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
 	require.Equal(t, nvml.SUCCESS, ret)
 
+	// MIG mode must be enabled to create GPU instances
+	currentRet, pendingRet := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, currentRet)
+	require.Equal(t, nvml.SUCCESS, pendingRet)
+
 	gi1, ret := device.CreateGpuInstance(&profileInfo1)
 	require.Equal(t, nvml.SUCCESS, ret)
 	require.NotNil(t, gi1)
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gpus
+
+import "sort"
+
+// catalog maps the name of each predefined GPU configuration to its Config.
+// The names match the exported variable names in this package.
+var catalog = map[string]Config{
+	"A100_PCIE_40GB":  A100_PCIE_40GB,
+	"A100_PCIE_80GB":  A100_PCIE_80GB,
+	"A100_SXM4_40GB":  A100_SXM4_40GB,
+	"A100_SXM4_80GB":  A100_SXM4_80GB,
+	"A30_PCIE_24GB":   A30_PCIE_24GB,
+	"H100_SXM5_80GB":  H100_SXM5_80GB,
+	"H200_SXM5_141GB": H200_SXM5_141GB,
+	"B200_SXM5_180GB": B200_SXM5_180GB,
+}
+
+// Lookup returns the predefined GPU configuration with the specified name.
+func Lookup(name string) (Config, bool) {
+	config, exists := catalog[name]
+	return config, exists
+}
+
+// Names returns the sorted names of all predefined GPU configurations.
+func Names() []string {
+	var names []string
+	for name := range catalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -12,20 +12,20 @@ func Multiple(count int, gpu Config) []Config {
 
 // Config contains the minimal configuration needed for a GPU generation
 type Config struct {
-	Name         string
-	Architecture nvml.DeviceArchitecture
-	Brand        nvml.BrandType
-	MemoryMB     uint64
-	CudaMajor    int
-	CudaMinor    int
-	PciDeviceId  uint32
-	MIGProfiles  MIGProfileConfig
+	Name         string                  `yaml:"name,omitempty"`
+	Architecture nvml.DeviceArchitecture `yaml:"architecture,omitempty"`
+	Brand        nvml.BrandType          `yaml:"brand,omitempty"`
+	MemoryMB     uint64                  `yaml:"memoryMB,omitempty"`
+	CudaMajor    int                     `yaml:"cudaMajor,omitempty"`
+	CudaMinor    int                     `yaml:"cudaMinor,omitempty"`
+	PciDeviceId  uint32                  `yaml:"pciDeviceId,omitempty"`
+	MIGProfiles  MIGProfileConfig        `yaml:"migProfiles,omitempty"`
 }
 
 // MIGProfileConfig contains MIG profile configuration for a GPU
 type MIGProfileConfig struct {
-	GpuInstanceProfiles       map[int]nvml.GpuInstanceProfileInfo
-	ComputeInstanceProfiles   map[int]map[int]nvml.ComputeInstanceProfileInfo
-	GpuInstancePlacements     map[int][]nvml.GpuInstancePlacement
-	ComputeInstancePlacements map[int]map[int][]nvml.ComputeInstancePlacement
+	GpuInstanceProfiles       map[int]nvml.GpuInstanceProfileInfo             `yaml:"gpuInstanceProfiles,omitempty"`
+	ComputeInstanceProfiles   map[int]map[int]nvml.ComputeInstanceProfileInfo `yaml:"computeInstanceProfiles,omitempty"`
+	GpuInstancePlacements     map[int][]nvml.GpuInstancePlacement             `yaml:"gpuInstancePlacements,omitempty"`
+	ComputeInstancePlacements map[int]map[int][]nvml.ComputeInstancePlacement `yaml:"computeInstancePlacements,omitempty"`
 }
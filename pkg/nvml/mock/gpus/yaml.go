@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gpus
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// The types below mirror the nvml structs held by a MIGProfileConfig with
+// camelCase YAML keys. The nvml structs are generated without tags, which
+// yaml.v3 would otherwise encode as lowercased field names (e.g. slicecount).
+
+type migProfileConfigYAML struct {
+	GpuInstanceProfiles       map[int]gpuInstanceProfileInfoYAML             `yaml:"gpuInstanceProfiles,omitempty"`
+	ComputeInstanceProfiles   map[int]map[int]computeInstanceProfileInfoYAML `yaml:"computeInstanceProfiles,omitempty"`
+	GpuInstancePlacements     map[int][]placementYAML                        `yaml:"gpuInstancePlacements,omitempty"`
+	ComputeInstancePlacements map[int]map[int][]placementYAML                `yaml:"computeInstancePlacements,omitempty"`
+}
+
+type gpuInstanceProfileInfoYAML struct {
+	Id                  uint32 `yaml:"id"`
+	IsP2pSupported      uint32 `yaml:"isP2pSupported,omitempty"`
+	SliceCount          uint32 `yaml:"sliceCount"`
+	InstanceCount       uint32 `yaml:"instanceCount"`
+	MultiprocessorCount uint32 `yaml:"multiprocessorCount,omitempty"`
+	CopyEngineCount     uint32 `yaml:"copyEngineCount,omitempty"`
+	DecoderCount        uint32 `yaml:"decoderCount,omitempty"`
+	EncoderCount        uint32 `yaml:"encoderCount,omitempty"`
+	JpegCount           uint32 `yaml:"jpegCount,omitempty"`
+	OfaCount            uint32 `yaml:"ofaCount,omitempty"`
+	MemorySizeMB        uint64 `yaml:"memorySizeMB,omitempty"`
+}
+
+type computeInstanceProfileInfoYAML struct {
+	Id                    uint32 `yaml:"id"`
+	SliceCount            uint32 `yaml:"sliceCount"`
+	InstanceCount         uint32 `yaml:"instanceCount"`
+	MultiprocessorCount   uint32 `yaml:"multiprocessorCount,omitempty"`
+	SharedCopyEngineCount uint32 `yaml:"sharedCopyEngineCount,omitempty"`
+	SharedDecoderCount    uint32 `yaml:"sharedDecoderCount,omitempty"`
+	SharedEncoderCount    uint32 `yaml:"sharedEncoderCount,omitempty"`
+	SharedJpegCount       uint32 `yaml:"sharedJpegCount,omitempty"`
+	SharedOfaCount        uint32 `yaml:"sharedOfaCount,omitempty"`
+}
+
+type placementYAML struct {
+	Start uint32 `yaml:"start"`
+	Size  uint32 `yaml:"size"`
+}
+
+// MarshalYAML writes the MIG profiles with camelCase keys
+func (c MIGProfileConfig) MarshalYAML() (interface{}, error) {
+	out := migProfileConfigYAML{}
+	if c.GpuInstanceProfiles != nil {
+		out.GpuInstanceProfiles = make(map[int]gpuInstanceProfileInfoYAML)
+		for id, info := range c.GpuInstanceProfiles {
+			out.GpuInstanceProfiles[id] = gpuInstanceProfileInfoYAML(info)
+		}
+	}
+	if c.ComputeInstanceProfiles != nil {
+		out.ComputeInstanceProfiles = make(map[int]map[int]computeInstanceProfileInfoYAML)
+		for giProfile, profiles := range c.ComputeInstanceProfiles {
+			out.ComputeInstanceProfiles[giProfile] = make(map[int]computeInstanceProfileInfoYAML)
+			for id, info := range profiles {
+				out.ComputeInstanceProfiles[giProfile][id] = computeInstanceProfileInfoYAML(info)
+			}
+		}
+	}
+	if c.GpuInstancePlacements != nil {
+		out.GpuInstancePlacements = make(map[int][]placementYAML)
+		for id, placements := range c.GpuInstancePlacements {
+			for _, p := range placements {
+				out.GpuInstancePlacements[id] = append(out.GpuInstancePlacements[id], placementYAML(p))
+			}
+		}
+	}
+	if c.ComputeInstancePlacements != nil {
+		out.ComputeInstancePlacements = make(map[int]map[int][]placementYAML)
+		for giProfile, profiles := range c.ComputeInstancePlacements {
+			out.ComputeInstancePlacements[giProfile] = make(map[int][]placementYAML)
+			for id, placements := range profiles {
+				for _, p := range placements {
+					out.ComputeInstancePlacements[giProfile][id] = append(out.ComputeInstancePlacements[giProfile][id], placementYAML(p))
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// UnmarshalYAML reads MIG profiles written with camelCase keys
+func (c *MIGProfileConfig) UnmarshalYAML(value *yaml.Node) error {
+	var in migProfileConfigYAML
+	if err := value.Decode(&in); err != nil {
+		return err
+	}
+	*c = MIGProfileConfig{}
+	if in.GpuInstanceProfiles != nil {
+		c.GpuInstanceProfiles = make(map[int]nvml.GpuInstanceProfileInfo)
+		for id, info := range in.GpuInstanceProfiles {
+			c.GpuInstanceProfiles[id] = nvml.GpuInstanceProfileInfo(info)
+		}
+	}
+	if in.ComputeInstanceProfiles != nil {
+		c.ComputeInstanceProfiles = make(map[int]map[int]nvml.ComputeInstanceProfileInfo)
+		for giProfile, profiles := range in.ComputeInstanceProfiles {
+			c.ComputeInstanceProfiles[giProfile] = make(map[int]nvml.ComputeInstanceProfileInfo)
+			for id, info := range profiles {
+				c.ComputeInstanceProfiles[giProfile][id] = nvml.ComputeInstanceProfileInfo(info)
+			}
+		}
+	}
+	if in.GpuInstancePlacements != nil {
+		c.GpuInstancePlacements = make(map[int][]nvml.GpuInstancePlacement)
+		for id, placements := range in.GpuInstancePlacements {
+			for _, p := range placements {
+				c.GpuInstancePlacements[id] = append(c.GpuInstancePlacements[id], nvml.GpuInstancePlacement(p))
+			}
+		}
+	}
+	if in.ComputeInstancePlacements != nil {
+		c.ComputeInstancePlacements = make(map[int]map[int][]nvml.ComputeInstancePlacement)
+		for giProfile, profiles := range in.ComputeInstancePlacements {
+			c.ComputeInstancePlacements[giProfile] = make(map[int][]nvml.ComputeInstancePlacement)
+			for id, placements := range profiles {
+				for _, p := range placements {
+					c.ComputeInstancePlacements[giProfile][id] = append(c.ComputeInstancePlacements[giProfile][id], nvml.ComputeInstancePlacement(p))
+				}
+			}
+		}
+	}
+	return nil
+}
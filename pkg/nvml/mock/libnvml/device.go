@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+/*
+#include "nvml.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+// deviceHandle returns the handle for a device
+func deviceHandle(d nvml.Device) C.nvmlDevice_t {
+	lib.Lock()
+	defer lib.Unlock()
+	return C.nvmlDevice_t{handle: (*C.struct_nvmlDevice_st)(lib.devices.get(d))}
+}
+
+// withDevice calls f with the device for a handle
+func withDevice(device C.nvmlDevice_t, f func(nvml.Device) C.nvmlReturn_t) C.nvmlReturn_t {
+	return with(&lib.devices, unsafe.Pointer(device.handle), f)
+}
+
+// getDeviceHandle stores the handle for a device returned by the server
+func getDeviceHandle(handle *C.nvmlDevice_t, d nvml.Device, ret nvml.Return) C.nvmlReturn_t {
+	if ret != nvml.SUCCESS {
+		return C.nvmlReturn_t(ret)
+	}
+	if handle == nil {
+		return C.NVML_ERROR_INVALID_ARGUMENT
+	}
+	*handle = deviceHandle(d)
+	return C.NVML_SUCCESS
+}
+
+//export nvmlDeviceGetCount
+func nvmlDeviceGetCount(deviceCount *C.uint) C.nvmlReturn_t {
+	return nvmlDeviceGetCount_v2(deviceCount)
+}
+
+//export nvmlDeviceGetCount_v2
+func nvmlDeviceGetCount_v2(deviceCount *C.uint) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		count, ret := s.DeviceGetCount()
+		*deviceCount = C.uint(count)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetHandleByIndex
+func nvmlDeviceGetHandleByIndex(index C.uint, device *C.nvmlDevice_t) C.nvmlReturn_t {
+	return nvmlDeviceGetHandleByIndex_v2(index, device)
+}
+
+//export nvmlDeviceGetHandleByIndex_v2
+func nvmlDeviceGetHandleByIndex_v2(index C.uint, device *C.nvmlDevice_t) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		d, ret := s.DeviceGetHandleByIndex(int(index))
+		return getDeviceHandle(device, d, ret)
+	})
+}
+
+// mockDeviceGetHandleByUUID backs nvmlDeviceGetHandleByUUID
+//
+//export mockDeviceGetHandleByUUID
+func mockDeviceGetHandleByUUID(uuid *C.char, device *C.nvmlDevice_t) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		d, ret := s.DeviceGetHandleByUUID(C.GoString(uuid))
+		return getDeviceHandle(device, d, ret)
+	})
+}
+
+// mockDeviceGetHandleByPciBusId backs nvmlDeviceGetHandleByPciBusId_v2
+//
+//export mockDeviceGetHandleByPciBusId
+func mockDeviceGetHandleByPciBusId(pciBusId *C.char, device *C.nvmlDevice_t) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		d, ret := s.DeviceGetHandleByPciBusId(C.GoString(pciBusId))
+		return getDeviceHandle(device, d, ret)
+	})
+}
+
+//export nvmlDeviceGetName
+func nvmlDeviceGetName(device C.nvmlDevice_t, name *C.char, length C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetName()
+		return copyString(name, length, value, ret)
+	})
+}
+
+//export nvmlDeviceGetUUID
+func nvmlDeviceGetUUID(device C.nvmlDevice_t, uuid *C.char, length C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetUUID()
+		return copyString(uuid, length, value, ret)
+	})
+}
+
+//export nvmlDeviceGetIndex
+func nvmlDeviceGetIndex(device C.nvmlDevice_t, index *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetIndex()
+		*index = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetMinorNumber
+func nvmlDeviceGetMinorNumber(device C.nvmlDevice_t, minorNumber *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetMinorNumber()
+		*minorNumber = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetBrand
+func nvmlDeviceGetBrand(device C.nvmlDevice_t, brandType *C.nvmlBrandType_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetBrand()
+		*brandType = C.nvmlBrandType_t(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetArchitecture
+func nvmlDeviceGetArchitecture(device C.nvmlDevice_t, arch *C.nvmlDeviceArchitecture_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetArchitecture()
+		*arch = C.nvmlDeviceArchitecture_t(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetCudaComputeCapability
+func nvmlDeviceGetCudaComputeCapability(device C.nvmlDevice_t, major *C.int, minor *C.int) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		majorValue, minorValue, ret := d.GetCudaComputeCapability()
+		*major, *minor = C.int(majorValue), C.int(minorValue)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetMemoryInfo
+func nvmlDeviceGetMemoryInfo(device C.nvmlDevice_t, memory *C.nvmlMemory_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetMemoryInfo()
+		*(*nvml.Memory)(unsafe.Pointer(memory)) = value
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+// nvmlDeviceGetMemoryInfo_v2 is derived from the v1 memory info since the
+// server does not track reserved memory.
+//
+//export nvmlDeviceGetMemoryInfo_v2
+func nvmlDeviceGetMemoryInfo_v2(device C.nvmlDevice_t, memory *C.nvmlMemory_v2_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		m := (*nvml.Memory_v2)(unsafe.Pointer(memory))
+		if m.Version != nvml.STRUCT_VERSION(*m, 2) {
+			return C.NVML_ERROR_ARGUMENT_VERSION_MISMATCH
+		}
+		value, ret := d.GetMemoryInfo()
+		m.Total, m.Free, m.Used = value.Total, value.Free, value.Used
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetPciInfo_v3
+func nvmlDeviceGetPciInfo_v3(device C.nvmlDevice_t, pci *C.nvmlPciInfo_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetPciInfo()
+		*(*nvml.PciInfo)(unsafe.Pointer(pci)) = value
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetComputeRunningProcesses_v3
+func nvmlDeviceGetComputeRunningProcesses_v3(device C.nvmlDevice_t, infoCount *C.uint, infos *C.nvmlProcessInfo_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		values, ret := d.GetComputeRunningProcesses()
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		return copyArray((*nvml.ProcessInfo)(unsafe.Pointer(infos)), infoCount, values)
+	})
+}
+
+//export nvmlDeviceGetTemperature
+func nvmlDeviceGetTemperature(device C.nvmlDevice_t, sensorType C.nvmlTemperatureSensors_t, temp *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetTemperature(nvml.TemperatureSensors(sensorType))
+		*temp = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetPowerUsage
+func nvmlDeviceGetPowerUsage(device C.nvmlDevice_t, power *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetPowerUsage()
+		*power = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetUtilizationRates
+func nvmlDeviceGetUtilizationRates(device C.nvmlDevice_t, utilization *C.nvmlUtilization_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetUtilizationRates()
+		*(*nvml.Utilization)(unsafe.Pointer(utilization)) = value
+		return C.nvmlReturn_t(ret)
+	})
+}
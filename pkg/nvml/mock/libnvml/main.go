@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command libnvml builds a stub libnvidia-ml.so backed by the mock server.
+// The library exports a subset of the nvml* C symbols so that the cgo
+// bindings in pkg/nvml can be exercised on machines without a GPU driver:
+//
+//	go build -buildmode=c-shared -o libnvidia-ml.so.1 ./pkg/nvml/mock/libnvml
+//
+// and then load it with nvml.New(nvml.WithLibraryPath(".../libnvidia-ml.so.1")).
+//
+// The server is created on the first call to nvmlInit and is configured
+// through the following environment variables:
+//
+//	NVML_MOCK_CONFIG      a fixture file (see server.LoadFixture); a DGX A100
+//	                      is simulated if unset
+//	NVML_MOCK_STATE_FILE  a state file shared with other processes (see
+//	                      server.WithStateFile)
+//
+// Calling a symbol that is not exported by the library aborts the process
+// with an unresolved symbol error, as it would for an older driver.
+package main
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../.. -DNVML_NO_UNVERSIONED_FUNC_DEFS=1
+#include "nvml.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/dgxa100"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+const (
+	configEnvvar    = "NVML_MOCK_CONFIG"
+	stateFileEnvvar = "NVML_MOCK_STATE_FILE"
+)
+
+// lib holds the server backing the library. The server outlives
+// nvmlShutdown so that state is retained across init cycles, as it would be
+// by the driver.
+var lib struct {
+	sync.Mutex
+	server   *server.Server
+	refcount int
+
+	devices          handles[nvml.Device]
+	gpuInstances     handles[nvml.GpuInstance]
+	computeInstances handles[nvml.ComputeInstance]
+	errorStrings     map[nvml.Return]*C.char
+}
+
+func main() {}
+
+// newServer creates the server from the environment
+func newServer() (*server.Server, error) {
+	opts := []server.Option{
+		server.WithGPUs(gpus.Multiple(8, gpus.A100_SXM4_40GB)...),
+		server.WithDriverVersion("550.54.15"),
+		server.WithNVMLVersion("12.550.54.15"),
+		server.WithCUDADriverVersion(12040),
+		server.WithTopology(dgxa100.Topology()),
+	}
+	if path := os.Getenv(configEnvvar); path != "" {
+		opts = []server.Option{server.WithConfigFile(path)}
+	}
+	if path := os.Getenv(stateFileEnvvar); path != "" {
+		opts = append(opts, server.WithStateFile(path))
+	}
+	return server.New(opts...)
+}
+
+func initialize() C.nvmlReturn_t {
+	lib.Lock()
+	defer lib.Unlock()
+
+	if lib.server == nil {
+		s, err := newServer()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error creating mock server: %v\n", err)
+			return C.NVML_ERROR_UNKNOWN
+		}
+		lib.server = s
+	}
+	if ret := lib.server.Init(); ret != nvml.SUCCESS {
+		return C.nvmlReturn_t(ret)
+	}
+	lib.refcount++
+	return C.NVML_SUCCESS
+}
+
+//export nvmlInit
+func nvmlInit() C.nvmlReturn_t {
+	return initialize()
+}
+
+//export nvmlInit_v2
+func nvmlInit_v2() C.nvmlReturn_t {
+	return initialize()
+}
+
+//export nvmlInitWithFlags
+func nvmlInitWithFlags(flags C.uint) C.nvmlReturn_t {
+	return initialize()
+}
+
+//export nvmlShutdown
+func nvmlShutdown() C.nvmlReturn_t {
+	lib.Lock()
+	defer lib.Unlock()
+	if lib.refcount == 0 {
+		return C.NVML_ERROR_UNINITIALIZED
+	}
+	lib.refcount--
+	return C.nvmlReturn_t(lib.server.Shutdown())
+}
+
+// mockErrorString backs nvmlErrorString. The returned strings are never
+// freed since callers may hold on to them.
+//
+//export mockErrorString
+func mockErrorString(result C.nvmlReturn_t) *C.char {
+	lib.Lock()
+	defer lib.Unlock()
+	ret := nvml.Return(result)
+	if s, exists := lib.errorStrings[ret]; exists {
+		return s
+	}
+	if lib.errorStrings == nil {
+		lib.errorStrings = make(map[nvml.Return]*C.char)
+	}
+	s := C.CString(ret.Error())
+	lib.errorStrings[ret] = s
+	return s
+}
+
+//export nvmlSystemGetDriverVersion
+func nvmlSystemGetDriverVersion(version *C.char, length C.uint) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		value, ret := s.SystemGetDriverVersion()
+		return copyString(version, length, value, ret)
+	})
+}
+
+//export nvmlSystemGetNVMLVersion
+func nvmlSystemGetNVMLVersion(version *C.char, length C.uint) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		value, ret := s.SystemGetNVMLVersion()
+		return copyString(version, length, value, ret)
+	})
+}
+
+//export nvmlSystemGetCudaDriverVersion
+func nvmlSystemGetCudaDriverVersion(cudaDriverVersion *C.int) C.nvmlReturn_t {
+	return nvmlSystemGetCudaDriverVersion_v2(cudaDriverVersion)
+}
+
+//export nvmlSystemGetCudaDriverVersion_v2
+func nvmlSystemGetCudaDriverVersion_v2(cudaDriverVersion *C.int) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		version, ret := s.SystemGetCudaDriverVersion()
+		*cudaDriverVersion = C.int(version)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlSystemGetProcessName
+func nvmlSystemGetProcessName(pid C.uint, name *C.char, length C.uint) C.nvmlReturn_t {
+	return withServer(func(s *server.Server) C.nvmlReturn_t {
+		value, ret := s.SystemGetProcessName(int(pid))
+		return copyString(name, length, value, ret)
+	})
+}
+
+// withServer calls f with the server if the library has been initialized
+func withServer(f func(*server.Server) C.nvmlReturn_t) C.nvmlReturn_t {
+	lib.Lock()
+	s, refcount := lib.server, lib.refcount
+	lib.Unlock()
+	if refcount == 0 {
+		return C.NVML_ERROR_UNINITIALIZED
+	}
+	return call(func() C.nvmlReturn_t { return f(s) })
+}
+
+// with calls f with the object for a handle if the library has been
+// initialized and the handle is valid
+func with[T comparable](h *handles[T], handle unsafe.Pointer, f func(T) C.nvmlReturn_t) C.nvmlReturn_t {
+	lib.Lock()
+	object, exists := h.lookup(handle)
+	refcount := lib.refcount
+	lib.Unlock()
+	if refcount == 0 {
+		return C.NVML_ERROR_UNINITIALIZED
+	}
+	if !exists {
+		return C.NVML_ERROR_INVALID_ARGUMENT
+	}
+	return call(func() C.nvmlReturn_t { return f(object) })
+}
+
+// call invokes f, reporting methods that the server does not implement as
+// not supported instead of crashing the calling process
+func call(f func() C.nvmlReturn_t) (ret C.nvmlReturn_t) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", r)
+			ret = C.NVML_ERROR_NOT_SUPPORTED
+		}
+	}()
+	return f()
+}
+
+// copyString copies a string returned by the server to a NUL-terminated C
+// buffer of the specified length.
+func copyString(buffer *C.char, length C.uint, value string, ret nvml.Return) C.nvmlReturn_t {
+	if ret != nvml.SUCCESS {
+		return C.nvmlReturn_t(ret)
+	}
+	if buffer == nil {
+		return C.NVML_ERROR_INVALID_ARGUMENT
+	}
+	if len(value)+1 > int(length) {
+		return C.NVML_ERROR_INSUFFICIENT_SIZE
+	}
+	b := unsafe.Slice((*byte)(unsafe.Pointer(buffer)), length)
+	copy(b, value)
+	b[len(value)] = 0
+	return C.NVML_SUCCESS
+}
+
+// copyArray copies values returned by the server to a C array whose capacity
+// is given by count. The number of values is always returned in count.
+func copyArray[T any](array *T, count *C.uint, values []T) C.nvmlReturn_t {
+	if count == nil {
+		return C.NVML_ERROR_INVALID_ARGUMENT
+	}
+	capacity := int(*count)
+	*count = C.uint(len(values))
+	if capacity < len(values) {
+		return C.NVML_ERROR_INSUFFICIENT_SIZE
+	}
+	if len(values) == 0 {
+		return C.NVML_SUCCESS
+	}
+	if array == nil {
+		return C.NVML_ERROR_INVALID_ARGUMENT
+	}
+	copy(unsafe.Slice(array, capacity), values)
+	return C.NVML_SUCCESS
+}
+
+// handles maps server objects to the opaque handles returned to callers.
+// Handles are allocated from C memory so that they are never mistaken for
+// Go pointers in the calling process.
+type handles[T comparable] struct {
+	handles map[T]unsafe.Pointer
+	objects map[unsafe.Pointer]T
+}
+
+// get returns the handle for an object, allocating one if required. The lib
+// lock must be held.
+func (h *handles[T]) get(object T) unsafe.Pointer {
+	if handle, exists := h.handles[object]; exists {
+		return handle
+	}
+	if h.handles == nil {
+		h.handles = make(map[T]unsafe.Pointer)
+		h.objects = make(map[unsafe.Pointer]T)
+	}
+	handle := C.malloc(1)
+	h.handles[object] = handle
+	h.objects[handle] = object
+	return handle
+}
+
+// lookup returns the object for a handle. The lib lock must be held.
+func (h *handles[T]) lookup(handle unsafe.Pointer) (T, bool) {
+	object, exists := h.objects[handle]
+	return object, exists
+}
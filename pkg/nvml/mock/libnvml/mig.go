@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+/*
+#include "nvml.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// gpuInstanceHandle returns the handle for a GPU instance
+func gpuInstanceHandle(gi nvml.GpuInstance) C.nvmlGpuInstance_t {
+	lib.Lock()
+	defer lib.Unlock()
+	return C.nvmlGpuInstance_t{handle: (*C.struct_nvmlGpuInstance_st)(lib.gpuInstances.get(gi))}
+}
+
+// computeInstanceHandle returns the handle for a compute instance
+func computeInstanceHandle(ci nvml.ComputeInstance) C.nvmlComputeInstance_t {
+	lib.Lock()
+	defer lib.Unlock()
+	return C.nvmlComputeInstance_t{handle: (*C.struct_nvmlComputeInstance_st)(lib.computeInstances.get(ci))}
+}
+
+// withGpuInstance calls f with the GPU instance for a handle
+func withGpuInstance(gpuInstance C.nvmlGpuInstance_t, f func(nvml.GpuInstance) C.nvmlReturn_t) C.nvmlReturn_t {
+	return with(&lib.gpuInstances, unsafe.Pointer(gpuInstance.handle), f)
+}
+
+// withComputeInstance calls f with the compute instance for a handle
+func withComputeInstance(computeInstance C.nvmlComputeInstance_t, f func(nvml.ComputeInstance) C.nvmlReturn_t) C.nvmlReturn_t {
+	return with(&lib.computeInstances, unsafe.Pointer(computeInstance.handle), f)
+}
+
+//export nvmlDeviceGetMigMode
+func nvmlDeviceGetMigMode(device C.nvmlDevice_t, currentMode *C.uint, pendingMode *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		current, pending, ret := d.GetMigMode()
+		*currentMode, *pendingMode = C.uint(current), C.uint(pending)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceSetMigMode
+func nvmlDeviceSetMigMode(device C.nvmlDevice_t, mode C.uint, activationStatus *C.nvmlReturn_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		status, ret := d.SetMigMode(int(mode))
+		*activationStatus = C.nvmlReturn_t(status)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetMaxMigDeviceCount
+func nvmlDeviceGetMaxMigDeviceCount(device C.nvmlDevice_t, count *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetMaxMigDeviceCount()
+		*count = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetMigDeviceHandleByIndex
+func nvmlDeviceGetMigDeviceHandleByIndex(device C.nvmlDevice_t, index C.uint, migDevice *C.nvmlDevice_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		md, ret := d.GetMigDeviceHandleByIndex(int(index))
+		return getDeviceHandle(migDevice, md, ret)
+	})
+}
+
+//export nvmlDeviceGetDeviceHandleFromMigDeviceHandle
+func nvmlDeviceGetDeviceHandleFromMigDeviceHandle(migDevice C.nvmlDevice_t, device *C.nvmlDevice_t) C.nvmlReturn_t {
+	return withDevice(migDevice, func(md nvml.Device) C.nvmlReturn_t {
+		d, ret := md.GetDeviceHandleFromMigDeviceHandle()
+		return getDeviceHandle(device, d, ret)
+	})
+}
+
+//export nvmlDeviceIsMigDeviceHandle
+func nvmlDeviceIsMigDeviceHandle(device C.nvmlDevice_t, isMigDevice *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.IsMigDeviceHandle()
+		*isMigDevice = 0
+		if value {
+			*isMigDevice = 1
+		}
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetGpuInstanceId
+func nvmlDeviceGetGpuInstanceId(device C.nvmlDevice_t, id *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetGpuInstanceId()
+		*id = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetComputeInstanceId
+func nvmlDeviceGetComputeInstanceId(device C.nvmlDevice_t, id *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetComputeInstanceId()
+		*id = C.uint(value)
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceGetGpuInstanceProfileInfo
+func nvmlDeviceGetGpuInstanceProfileInfo(device C.nvmlDevice_t, profile C.uint, info *C.nvmlGpuInstanceProfileInfo_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		value, ret := d.GetGpuInstanceProfileInfo(int(profile))
+		*(*nvml.GpuInstanceProfileInfo)(unsafe.Pointer(info)) = value
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlDeviceCreateGpuInstance
+func nvmlDeviceCreateGpuInstance(device C.nvmlDevice_t, profileId C.uint, gpuInstance *C.nvmlGpuInstance_t) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		profile, ret := profileInfo(d, uint32(profileId))
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		gi, ret := d.CreateGpuInstance(&profile)
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		*gpuInstance = gpuInstanceHandle(gi)
+		return C.NVML_SUCCESS
+	})
+}
+
+//export nvmlDeviceGetGpuInstances
+func nvmlDeviceGetGpuInstances(device C.nvmlDevice_t, profileId C.uint, gpuInstances *C.nvmlGpuInstance_t, count *C.uint) C.nvmlReturn_t {
+	return withDevice(device, func(d nvml.Device) C.nvmlReturn_t {
+		profile, ret := profileInfo(d, uint32(profileId))
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		gis, ret := d.GetGpuInstances(&profile)
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		var handles []C.nvmlGpuInstance_t
+		for _, gi := range gis {
+			handles = append(handles, gpuInstanceHandle(gi))
+		}
+		return copyArray(gpuInstances, count, handles)
+	})
+}
+
+//export nvmlGpuInstanceGetInfo
+func nvmlGpuInstanceGetInfo(gpuInstance C.nvmlGpuInstance_t, info *C.nvmlGpuInstanceInfo_t) C.nvmlReturn_t {
+	return withGpuInstance(gpuInstance, func(gi nvml.GpuInstance) C.nvmlReturn_t {
+		value, ret := gi.GetInfo()
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		info.device = deviceHandle(value.Device)
+		info.id = C.uint(value.Id)
+		info.profileId = C.uint(value.ProfileId)
+		info.placement.start = C.uint(value.Placement.Start)
+		info.placement.size = C.uint(value.Placement.Size)
+		return C.NVML_SUCCESS
+	})
+}
+
+//export nvmlGpuInstanceDestroy
+func nvmlGpuInstanceDestroy(gpuInstance C.nvmlGpuInstance_t) C.nvmlReturn_t {
+	return withGpuInstance(gpuInstance, func(gi nvml.GpuInstance) C.nvmlReturn_t {
+		return C.nvmlReturn_t(gi.Destroy())
+	})
+}
+
+//export nvmlGpuInstanceGetComputeInstanceProfileInfo
+func nvmlGpuInstanceGetComputeInstanceProfileInfo(gpuInstance C.nvmlGpuInstance_t, profile C.uint, engProfile C.uint, info *C.nvmlComputeInstanceProfileInfo_t) C.nvmlReturn_t {
+	return withGpuInstance(gpuInstance, func(gi nvml.GpuInstance) C.nvmlReturn_t {
+		value, ret := gi.GetComputeInstanceProfileInfo(int(profile), int(engProfile))
+		*(*nvml.ComputeInstanceProfileInfo)(unsafe.Pointer(info)) = value
+		return C.nvmlReturn_t(ret)
+	})
+}
+
+//export nvmlGpuInstanceCreateComputeInstance
+func nvmlGpuInstanceCreateComputeInstance(gpuInstance C.nvmlGpuInstance_t, profileId C.uint, computeInstance *C.nvmlComputeInstance_t) C.nvmlReturn_t {
+	return withGpuInstance(gpuInstance, func(gi nvml.GpuInstance) C.nvmlReturn_t {
+		profile, ret := computeInstanceProfileInfo(gi, uint32(profileId))
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		ci, ret := gi.CreateComputeInstance(&profile)
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		*computeInstance = computeInstanceHandle(ci)
+		return C.NVML_SUCCESS
+	})
+}
+
+//export nvmlGpuInstanceGetComputeInstances
+func nvmlGpuInstanceGetComputeInstances(gpuInstance C.nvmlGpuInstance_t, profileId C.uint, computeInstances *C.nvmlComputeInstance_t, count *C.uint) C.nvmlReturn_t {
+	return withGpuInstance(gpuInstance, func(gi nvml.GpuInstance) C.nvmlReturn_t {
+		profile, ret := computeInstanceProfileInfo(gi, uint32(profileId))
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		cis, ret := gi.GetComputeInstances(&profile)
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		var handles []C.nvmlComputeInstance_t
+		for _, ci := range cis {
+			handles = append(handles, computeInstanceHandle(ci))
+		}
+		return copyArray(computeInstances, count, handles)
+	})
+}
+
+//export nvmlComputeInstanceDestroy
+func nvmlComputeInstanceDestroy(computeInstance C.nvmlComputeInstance_t) C.nvmlReturn_t {
+	return withComputeInstance(computeInstance, func(ci nvml.ComputeInstance) C.nvmlReturn_t {
+		return C.nvmlReturn_t(ci.Destroy())
+	})
+}
+
+//export nvmlComputeInstanceGetInfo_v2
+func nvmlComputeInstanceGetInfo_v2(computeInstance C.nvmlComputeInstance_t, info *C.nvmlComputeInstanceInfo_t) C.nvmlReturn_t {
+	return withComputeInstance(computeInstance, func(ci nvml.ComputeInstance) C.nvmlReturn_t {
+		value, ret := ci.GetInfo()
+		if ret != nvml.SUCCESS {
+			return C.nvmlReturn_t(ret)
+		}
+		info.device = deviceHandle(value.Device)
+		info.gpuInstance = gpuInstanceHandle(value.GpuInstance)
+		info.id = C.uint(value.Id)
+		info.profileId = C.uint(value.ProfileId)
+		info.placement.start = C.uint(value.Placement.Start)
+		info.placement.size = C.uint(value.Placement.Size)
+		return C.NVML_SUCCESS
+	})
+}
+
+// profileInfo returns the GPU instance profile with the specified ID. The C
+// API identifies profiles by ID whereas the server expects the profile info.
+func profileInfo(d nvml.Device, id uint32) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+	for profile := 0; profile < nvml.GPU_INSTANCE_PROFILE_COUNT; profile++ {
+		info, ret := d.GetGpuInstanceProfileInfo(profile)
+		if ret == nvml.SUCCESS && info.Id == id {
+			return info, nvml.SUCCESS
+		}
+	}
+	return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+}
+
+// computeInstanceProfileInfo returns the compute instance profile with the
+// specified ID for the shared engine profile.
+func computeInstanceProfileInfo(gi nvml.GpuInstance, id uint32) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+	for profile := 0; profile < nvml.COMPUTE_INSTANCE_PROFILE_COUNT; profile++ {
+		info, ret := gi.GetComputeInstanceProfileInfo(profile, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+		if ret == nvml.SUCCESS && info.Id == id {
+			return info, nvml.SUCCESS
+		}
+	}
+	return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+}
@@ -0,0 +1,411 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// entry is a recorded call. Handle 0 is the library itself.
+type entry struct {
+	Handle  int         `json:"handle"`
+	Method  string      `json:"method"`
+	Args    []any       `json:"args"`
+	Results []any       `json:"results"`
+	Outputs map[int]any `json:"outputs,omitempty"`
+	// Error is set instead of the results if the call could not be recorded
+	Error string `json:"error,omitempty"`
+}
+
+// handleType describes an NVML interface whose values are recorded as handles
+type handleType struct {
+	name  string
+	iface reflect.Type
+	new   func() any
+}
+
+var handleTypes = []handleType{
+	{"Interface", reflect.TypeOf((*nvml.Interface)(nil)).Elem(), func() any { return &mock.Interface{} }},
+	{"ExtendedInterface", reflect.TypeOf((*nvml.ExtendedInterface)(nil)).Elem(), func() any { return &mock.ExtendedInterface{} }},
+	{"Device", reflect.TypeOf((*nvml.Device)(nil)).Elem(), func() any { return &mock.Device{} }},
+	{"GpuInstance", reflect.TypeOf((*nvml.GpuInstance)(nil)).Elem(), func() any { return &mock.GpuInstance{} }},
+	{"ComputeInstance", reflect.TypeOf((*nvml.ComputeInstance)(nil)).Elem(), func() any { return &mock.ComputeInstance{} }},
+	{"EventSet", reflect.TypeOf((*nvml.EventSet)(nil)).Elem(), func() any { return &mock.EventSet{} }},
+	{"GpmSample", reflect.TypeOf((*nvml.GpmSample)(nil)).Elem(), func() any { return &mock.GpmSample{} }},
+	{"Unit", reflect.TypeOf((*nvml.Unit)(nil)).Elem(), func() any { return &mock.Unit{} }},
+	{"VgpuInstance", reflect.TypeOf((*nvml.VgpuInstance)(nil)).Elem(), func() any { return &mock.VgpuInstance{} }},
+	{"VgpuTypeId", reflect.TypeOf((*nvml.VgpuTypeId)(nil)).Elem(), func() any { return &mock.VgpuTypeId{} }},
+}
+
+var (
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+	returnType = reflect.TypeOf(nvml.SUCCESS)
+)
+
+// lookupHandleType returns the handle type for an interface type
+func lookupHandleType(t reflect.Type) (*handleType, bool) {
+	for i := range handleTypes {
+		if handleTypes[i].iface == t {
+			return &handleTypes[i], true
+		}
+	}
+	return nil, false
+}
+
+// handleTypeByName returns the handle type with the specified name
+func handleTypeByName(name string) (*handleType, bool) {
+	for i := range handleTypes {
+		if handleTypes[i].name == name {
+			return &handleTypes[i], true
+		}
+	}
+	return nil, false
+}
+
+// setMockFuncs sets every function of a mock to call f with the name of the
+// mocked method
+func setMockFuncs(m any, f func(method string, ft reflect.Type, args []reflect.Value) []reflect.Value) {
+	v := reflect.ValueOf(m).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Type.Kind() != reflect.Func || !strings.HasSuffix(field.Name, "Func") {
+			continue
+		}
+		method, ft := strings.TrimSuffix(field.Name, "Func"), field.Type
+		v.Field(i).Set(reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+			return f(method, ft, args)
+		}))
+	}
+}
+
+// zeroResults returns the results of a call that was not recorded
+func zeroResults(ft reflect.Type) []reflect.Value {
+	results := make([]reflect.Value, ft.NumOut())
+	for i := range results {
+		results[i] = reflect.Zero(ft.Out(i))
+		if ft.Out(i) == returnType {
+			results[i] = reflect.ValueOf(nvml.ERROR_NOT_SUPPORTED)
+		}
+	}
+	return results
+}
+
+// isOutput returns whether an argument of the specified type can be written to
+// by the callee
+func isOutput(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice
+}
+
+var interfaceTypes sync.Map
+
+// containsHandles returns whether values of the type can contain handles
+func containsHandles(t reflect.Type) bool {
+	if c, ok := interfaceTypes.Load(t); ok {
+		return c.(bool)
+	}
+	var c bool
+	switch t.Kind() {
+	case reflect.Interface:
+		_, c = lookupHandleType(t)
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		c = containsHandles(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField() && !c; i++ {
+			c = containsHandles(t.Field(i).Type)
+		}
+	}
+	interfaceTypes.Store(t, c)
+	return c
+}
+
+// convert returns a copy of v in which every handle is replaced by the result
+// of f. Values that cannot contain handles are returned as is.
+func convert(v reflect.Value, f func(h *handleType, v reflect.Value) reflect.Value) reflect.Value {
+	t := v.Type()
+	if !containsHandles(t) {
+		return v
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		h, _ := lookupHandleType(t)
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(t).Elem()
+		out.Set(f(h, v))
+		return out
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(t.Elem())
+		out.Elem().Set(convert(v.Elem(), f))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(convert(v.Index(i), f))
+		}
+		return out
+	case reflect.Array:
+		out := reflect.New(t).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(convert(v.Index(i), f))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(t).Elem()
+		out.Set(v)
+		for i := 0; i < t.NumField(); i++ {
+			if containsHandles(t.Field(i).Type) {
+				out.Field(i).Set(convert(v.Field(i), f))
+			}
+		}
+		return out
+	}
+	return v
+}
+
+// writeBack copies the value of an output argument into the argument passed
+// by the caller
+func writeBack(dst, src reflect.Value) {
+	switch {
+	case dst.Kind() == reflect.Ptr && !dst.IsNil() && !src.IsNil():
+		dst.Elem().Set(src.Elem())
+	case dst.Kind() == reflect.Slice:
+		reflect.Copy(dst, src)
+	}
+}
+
+// encode returns a JSON-compatible representation of v. Handles are encoded
+// using the IDs returned by id.
+func encode(v reflect.Value, id func(v any) (int, bool)) (any, error) {
+	t := v.Type()
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if t == errorType {
+			return map[string]any{"$error": v.Interface().(error).Error()}, nil
+		}
+		h, ok := lookupHandleType(t)
+		if !ok {
+			return nil, fmt.Errorf("unsupported interface type %v", t)
+		}
+		handle, ok := id(v.Elem().Interface())
+		if !ok {
+			return nil, fmt.Errorf("unknown %v handle", h.name)
+		}
+		return map[string]any{"$handle": handle, "$type": h.name}, nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+			return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+		}
+		return v.Float(), nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		fallthrough
+	case reflect.Array:
+		values := make([]any, v.Len())
+		for i := range values {
+			value, err := encode(v.Index(i), id)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	case reflect.Struct:
+		fields := make(map[string]any)
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				return nil, fmt.Errorf("unsupported type %v with unexported fields", t)
+			}
+			value, err := encode(v.Field(i), id)
+			if err != nil {
+				return nil, err
+			}
+			fields[t.Field(i).Name] = value
+		}
+		return fields, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encode(v.Elem(), id)
+	}
+	return nil, fmt.Errorf("unsupported type %v", t)
+}
+
+// encodeValues encodes a list of values
+func encodeValues(values []reflect.Value, id func(v any) (int, bool)) ([]any, error) {
+	encoded := make([]any, len(values))
+	for i, v := range values {
+		e, err := encode(v, id)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = e
+	}
+	return encoded, nil
+}
+
+// decode creates a value of type t from its JSON representation, as decoded
+// with json.Decoder.UseNumber. Handles are resolved through handle.
+func decode(data any, t reflect.Type, handle func(id int, name string) (any, error)) (reflect.Value, error) {
+	out := reflect.New(t).Elem()
+	if data == nil {
+		return out, nil
+	}
+	invalid := fmt.Errorf("invalid %v value %v", t, data)
+	switch t.Kind() {
+	case reflect.Interface:
+		m, ok := data.(map[string]any)
+		if !ok {
+			return out, invalid
+		}
+		if msg, ok := m["$error"].(string); ok && t == errorType {
+			out.Set(reflect.ValueOf(errors.New(msg)))
+			return out, nil
+		}
+		id, err := decodeInt(m["$handle"])
+		name, _ := m["$type"].(string)
+		if err != nil {
+			return out, invalid
+		}
+		h, err := handle(int(id), name)
+		if err != nil {
+			return out, err
+		}
+		if !reflect.TypeOf(h).Implements(t) {
+			return out, invalid
+		}
+		out.Set(reflect.ValueOf(h))
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return out, invalid
+		}
+		out.SetBool(b)
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return out, invalid
+		}
+		out.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := decodeInt(data)
+		if err != nil {
+			return out, invalid
+		}
+		out.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, ok := data.(json.Number)
+		if !ok {
+			return out, invalid
+		}
+		u, err := strconv.ParseUint(n.String(), 10, 64)
+		if err != nil {
+			return out, invalid
+		}
+		out.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var s string
+		switch d := data.(type) {
+		case json.Number:
+			s = d.String()
+		case string:
+			s = d
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return out, invalid
+		}
+		out.SetFloat(f)
+	case reflect.Slice, reflect.Array:
+		values, ok := data.([]any)
+		if !ok {
+			return out, invalid
+		}
+		if t.Kind() == reflect.Slice {
+			out.Set(reflect.MakeSlice(t, len(values), len(values)))
+		} else if len(values) != t.Len() {
+			return out, invalid
+		}
+		for i, value := range values {
+			v, err := decode(value, t.Elem(), handle)
+			if err != nil {
+				return out, err
+			}
+			out.Index(i).Set(v)
+		}
+	case reflect.Struct:
+		fields, ok := data.(map[string]any)
+		if !ok {
+			return out, invalid
+		}
+		for i := 0; i < t.NumField(); i++ {
+			v, err := decode(fields[t.Field(i).Name], t.Field(i).Type, handle)
+			if err != nil {
+				return out, err
+			}
+			out.Field(i).Set(v)
+		}
+	case reflect.Ptr:
+		v, err := decode(data, t.Elem(), handle)
+		if err != nil {
+			return out, err
+		}
+		out.Set(reflect.New(t.Elem()))
+		out.Elem().Set(v)
+	default:
+		return out, fmt.Errorf("unsupported type %v", t)
+	}
+	return out, nil
+}
+
+func decodeInt(data any) (int64, error) {
+	n, ok := data.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("not a number: %v", data)
+	}
+	return strconv.ParseInt(n.String(), 10, 64)
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// Recorder is an nvml.Interface that forwards every call to another
+// implementation and records it. Handles returned by the wrapped
+// implementation are replaced by recording handles.
+type Recorder struct {
+	*mock.Interface
+	sync.Mutex
+	encoder *json.Encoder
+	err     error
+	// handles maps wrapped handles to their recorded handle
+	handles map[any]*recordedHandle
+	// wrappers maps recording handles to their recorded handle
+	wrappers map[any]*recordedHandle
+}
+
+var _ nvml.Interface = (*Recorder)(nil)
+
+// recordedHandle is a handle returned by the wrapped implementation
+type recordedHandle struct {
+	id      int
+	value   reflect.Value
+	wrapper any
+}
+
+// NewRecorder returns an nvml.Interface that records the calls made to lib to w
+func NewRecorder(lib nvml.Interface, w io.Writer) *Recorder {
+	r := &Recorder{
+		Interface: &mock.Interface{},
+		encoder:   json.NewEncoder(w),
+		handles:   make(map[any]*recordedHandle),
+		wrappers:  make(map[any]*recordedHandle),
+	}
+	h := &recordedHandle{value: reflect.ValueOf(lib), wrapper: r.Interface}
+	r.wrappers[r.Interface] = h
+	setMockFuncs(r.Interface, func(method string, _ reflect.Type, args []reflect.Value) []reflect.Value {
+		return r.call(h, method, args)
+	})
+	return r
+}
+
+// Err returns the first error encountered while recording, such as a failure
+// to write the recording or a returned handle that cannot be tracked. Calls
+// are no longer recorded once an error is encountered, so a recording is only
+// complete if Err returns nil.
+func (r *Recorder) Err() error {
+	r.Lock()
+	defer r.Unlock()
+	return r.err
+}
+
+// wrap returns the recording handle for a handle of the wrapped implementation
+func (r *Recorder) wrap(t *handleType, v reflect.Value) reflect.Value {
+	value := v.Elem().Interface()
+	r.Lock()
+	defer r.Unlock()
+	if !reflect.TypeOf(value).Comparable() {
+		r.fail(fmt.Errorf("cannot record %v handle of non-comparable type %T", t.name, value))
+		return v
+	}
+	if h, exists := r.handles[value]; exists {
+		return reflect.ValueOf(h.wrapper)
+	}
+	h := &recordedHandle{id: len(r.wrappers), value: v.Elem(), wrapper: t.new()}
+	r.handles[value] = h
+	r.wrappers[h.wrapper] = h
+	setMockFuncs(h.wrapper, func(method string, _ reflect.Type, args []reflect.Value) []reflect.Value {
+		return r.call(h, method, args)
+	})
+	return reflect.ValueOf(h.wrapper)
+}
+
+// unwrap returns the handle of the wrapped implementation for a recording handle
+func (r *Recorder) unwrap(_ *handleType, v reflect.Value) reflect.Value {
+	r.Lock()
+	defer r.Unlock()
+	if h, exists := r.wrappers[v.Elem().Interface()]; exists {
+		return h.value
+	}
+	return v
+}
+
+// fail records the first error encountered while recording. The caller must
+// hold the lock.
+func (r *Recorder) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// id returns the ID of a recording handle. The caller must hold the lock.
+func (r *Recorder) id(v any) (int, bool) {
+	h, exists := r.wrappers[v]
+	if !exists {
+		return 0, false
+	}
+	return h.id, true
+}
+
+// call forwards a call to the wrapped implementation and records it. Calls
+// whose values cannot be encoded are recorded with the reason as their error.
+func (r *Recorder) call(h *recordedHandle, method string, args []reflect.Value) []reflect.Value {
+	r.Lock()
+	encodedArgs, argsErr := encodeValues(args, r.id)
+	r.Unlock()
+
+	callArgs := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		callArgs[i] = convert(arg, r.unwrap)
+	}
+	m := h.value.MethodByName(method)
+	var results []reflect.Value
+	if m.Type().IsVariadic() {
+		results = m.CallSlice(callArgs)
+	} else {
+		results = m.Call(callArgs)
+	}
+	for i := range args {
+		if isOutput(args[i].Type()) && containsHandles(args[i].Type()) {
+			writeBack(args[i], convert(callArgs[i], r.wrap))
+		}
+	}
+	for i := range results {
+		results[i] = convert(results[i], r.wrap)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.err != nil {
+		return results
+	}
+	e, err := encodeEntry(h.id, method, encodedArgs, argsErr, args, results, r.id)
+	if err != nil {
+		e = entry{Handle: h.id, Method: method, Args: encodedArgs, Error: err.Error()}
+	}
+	if err := r.encoder.Encode(e); err != nil {
+		r.fail(fmt.Errorf("error writing %v call: %w", method, err))
+	}
+	return results
+}
+
+// encodeEntry encodes a call as a recording entry
+func encodeEntry(handle int, method string, encodedArgs []any, argsErr error, args []reflect.Value, results []reflect.Value, id func(v any) (int, bool)) (entry, error) {
+	if argsErr != nil {
+		return entry{}, fmt.Errorf("error encoding arguments: %w", argsErr)
+	}
+	encodedResults, err := encodeValues(results, id)
+	if err != nil {
+		return entry{}, fmt.Errorf("error encoding results: %w", err)
+	}
+	e := entry{Handle: handle, Method: method, Args: encodedArgs, Results: encodedResults}
+	for i, arg := range args {
+		if !isOutput(arg.Type()) {
+			continue
+		}
+		output, err := encode(arg, id)
+		if err != nil {
+			return entry{}, fmt.Errorf("error encoding argument %d: %w", i, err)
+		}
+		if e.Outputs == nil {
+			e.Outputs = make(map[int]any)
+		}
+		e.Outputs[i] = output
+	}
+	return e, nil
+}
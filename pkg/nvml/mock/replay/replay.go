@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package replay records the calls made through an nvml.Interface and serves
+// the recordings back.
+//
+// A recording is a sequence of JSON lines, one per call, holding the handle
+// the call was made on, the method, the arguments and the results. Handles
+// are identified by the order in which they were first returned, with 0 being
+// the library itself. Calls whose values cannot be encoded are recorded with
+// an error in place of their results.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// Replayer is an nvml.Interface that serves recorded calls. Calls are matched
+// on their handle, method and arguments. Matching calls are served in the
+// order in which they were recorded, repeating the last one once the
+// recording is exhausted. Calls that were not recorded, including those the
+// recorder could not encode, return zero values and ERROR_NOT_SUPPORTED.
+type Replayer struct {
+	*mock.Interface
+	sync.Mutex
+	calls   map[string]*recordedCalls
+	handles map[int]any
+	ids     map[any]int
+	missing []string
+}
+
+var _ nvml.Interface = (*Replayer)(nil)
+
+// recordedCalls holds the recorded calls with the same handle, method and arguments
+type recordedCalls struct {
+	entries []entry
+	next    int
+}
+
+// Load reads a recording from a file
+func Load(path string) (*Replayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening recording: %w", err)
+	}
+	defer f.Close()
+	return New(f)
+}
+
+// New reads a recording
+func New(r io.Reader) (*Replayer, error) {
+	p := &Replayer{
+		Interface: &mock.Interface{},
+		calls:     make(map[string]*recordedCalls),
+		handles:   make(map[int]any),
+		ids:       make(map[any]int),
+	}
+	p.handles[0], p.ids[p.Interface] = p.Interface, 0
+	setMockFuncs(p.Interface, func(method string, ft reflect.Type, args []reflect.Value) []reflect.Value {
+		return p.call(0, method, ft, args)
+	})
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.UseNumber()
+		var e entry
+		if err := decoder.Decode(&e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		key, err := callKey(e.Handle, e.Method, e.Args)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if p.calls[key] == nil {
+			p.calls[key] = &recordedCalls{}
+		}
+		p.calls[key].entries = append(p.calls[key].entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading recording: %w", err)
+	}
+	return p, nil
+}
+
+// Missing returns the calls that were made but not found in the recording
+func (p *Replayer) Missing() []string {
+	p.Lock()
+	defer p.Unlock()
+	return append([]string(nil), p.missing...)
+}
+
+// callKey returns the key used to match a call
+func callKey(handle int, method string, args []any) (string, error) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d.%s(%s)", handle, method, encoded), nil
+}
+
+// handle returns the replay handle with the specified ID, creating it if
+// needed. The caller must hold the lock.
+func (p *Replayer) handle(id int, name string) (any, error) {
+	if h, exists := p.handles[id]; exists {
+		return h, nil
+	}
+	t, ok := handleTypeByName(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown handle type %q", name)
+	}
+	h := t.new()
+	setMockFuncs(h, func(method string, ft reflect.Type, args []reflect.Value) []reflect.Value {
+		return p.call(id, method, ft, args)
+	})
+	p.handles[id], p.ids[h] = h, id
+	return h, nil
+}
+
+// id returns the ID of a replay handle. The caller must hold the lock.
+func (p *Replayer) id(v any) (int, bool) {
+	id, exists := p.ids[v]
+	return id, exists
+}
+
+// call serves a recorded call
+func (p *Replayer) call(handle int, method string, ft reflect.Type, args []reflect.Value) []reflect.Value {
+	p.Lock()
+	defer p.Unlock()
+	encodedArgs, err := encodeValues(args, p.id)
+	if err != nil {
+		p.missing = append(p.missing, fmt.Sprintf("%d.%s: %v", handle, method, err))
+		return zeroResults(ft)
+	}
+	key, err := callKey(handle, method, encodedArgs)
+	calls, exists := p.calls[key]
+	if err != nil || !exists {
+		p.missing = append(p.missing, key)
+		return zeroResults(ft)
+	}
+
+	e := calls.entries[calls.next]
+	if calls.next < len(calls.entries)-1 {
+		calls.next++
+	}
+	if e.Error != "" {
+		p.missing = append(p.missing, fmt.Sprintf("%s: not recorded: %s", key, e.Error))
+		return zeroResults(ft)
+	}
+	results := make([]reflect.Value, ft.NumOut())
+	if len(e.Results) != len(results) {
+		p.missing = append(p.missing, key)
+		return zeroResults(ft)
+	}
+	for i := range results {
+		results[i], err = decode(e.Results[i], ft.Out(i), p.handle)
+		if err != nil {
+			p.missing = append(p.missing, fmt.Sprintf("%s: %v", key, err))
+			return zeroResults(ft)
+		}
+	}
+	for i, output := range e.Outputs {
+		if i < 0 || i >= len(args) || !isOutput(args[i].Type()) {
+			continue
+		}
+		v, err := decode(output, args[i].Type(), p.handle)
+		if err != nil {
+			p.missing = append(p.missing, fmt.Sprintf("%s: %v", key, err))
+			continue
+		}
+		writeBack(args[i], v)
+	}
+	return results
+}
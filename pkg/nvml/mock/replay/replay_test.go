@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/server"
+)
+
+// observation holds the values observed by exercise
+type observation struct {
+	Count     int
+	Names     []string
+	Memory    nvml.Memory
+	Processes [][]nvml.ProcessInfo
+	GiInfo    nvml.GpuInstanceInfo
+	Metric    nvml.GpmMetric
+	Missing   nvml.Return
+}
+
+// exercise makes a series of calls representative of a monitoring agent
+func exercise(t *testing.T, lib nvml.Interface, startProcess func()) observation {
+	var o observation
+	require.Equal(t, nvml.SUCCESS, lib.Init())
+
+	var ret nvml.Return
+	o.Count, ret = lib.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	var devices []nvml.Device
+	for i := 0; i < o.Count; i++ {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		require.Equal(t, nvml.SUCCESS, ret)
+		name, ret := device.GetName()
+		require.Equal(t, nvml.SUCCESS, ret)
+		o.Names = append(o.Names, name)
+		devices = append(devices, device)
+	}
+	o.Memory, ret = devices[0].GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// The same call returns different results over time
+	for i := 0; i < 2; i++ {
+		processes, ret := devices[1].GetComputeRunningProcesses()
+		require.Equal(t, nvml.SUCCESS, ret)
+		o.Processes = append(o.Processes, processes)
+		startProcess()
+	}
+
+	_, ret = devices[0].SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	profile, ret := devices[0].GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := devices[0].CreateGpuInstance(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	o.GiInfo, ret = gi.GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, devices[0], o.GiInfo.Device)
+	o.GiInfo.Device = nil
+
+	// Handles are passed inside structures that are written to
+	sample1, ret := lib.GpmSampleAlloc()
+	require.Equal(t, nvml.SUCCESS, ret)
+	sample2, ret := lib.GpmSampleAlloc()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.SUCCESS, devices[1].GpmSampleGet(sample1))
+	require.Equal(t, nvml.SUCCESS, devices[1].GpmSampleGet(sample2))
+	metrics := nvml.GpmMetricsGetType{NumMetrics: 1, Sample1: sample1, Sample2: sample2}
+	metrics.Metrics[0].MetricId = uint32(nvml.GPM_METRIC_SM_UTIL)
+	require.Equal(t, nvml.SUCCESS, lib.GpmMetricsGet(&metrics))
+	require.Equal(t, sample1, metrics.Sample1)
+	o.Metric = metrics.Metrics[0]
+
+	_, o.Missing = lib.DeviceGetHandleByIndex(o.Count)
+	require.Equal(t, nvml.SUCCESS, lib.Shutdown())
+	return o
+}
+
+func TestRecordReplay(t *testing.T) {
+	clock := time.Unix(1700000000, 0)
+	s, err := server.New(
+		server.WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		server.WithClock(func() time.Time {
+			clock = clock.Add(time.Second)
+			return clock
+		}),
+		server.WithGpmActivity(&server.GpmActivity{SMUtil: server.Constant(75)}),
+	)
+	require.NoError(t, err)
+
+	var recording bytes.Buffer
+	recorder := NewRecorder(s, &recording)
+	pid := uint32(100)
+	recorded := exercise(t, recorder, func() {
+		require.Equal(t, nvml.SUCCESS, s.Devices[1].(*server.Device).StartProcess(server.Process{Pid: pid, UsedGpuMemory: 1 << 30}))
+		pid++
+	})
+	require.NoError(t, recorder.Err())
+	require.Len(t, recorded.Processes[1], 1)
+	require.InDelta(t, 75.0, recorded.Metric.Value, 0.001)
+
+	replayer, err := New(&recording)
+	require.NoError(t, err)
+	replayed := exercise(t, replayer, func() {})
+	require.Equal(t, recorded, replayed)
+	require.Empty(t, replayer.Missing())
+
+	// Calls that were not recorded are not supported
+	_, ret := replayer.DeviceGetHandleByUUID("GPU-unknown")
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	require.Len(t, replayer.Missing(), 1)
+}
+
+// sliceDevice is a device handle of a non-comparable type
+type sliceDevice struct {
+	nvml.Device
+	ids []int
+}
+
+func TestRecorderErrors(t *testing.T) {
+	// Calls whose arguments cannot be encoded are recorded with an error
+	var recording bytes.Buffer
+	recorder := NewRecorder(&mock.Interface{
+		DeviceGetNameFunc: func(device nvml.Device) (string, nvml.Return) {
+			return "foreign", nvml.SUCCESS
+		},
+	}, &recording)
+	name, ret := recorder.DeviceGetName(&mock.Device{})
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "foreign", name)
+	require.NoError(t, recorder.Err())
+	require.Contains(t, recording.String(), `"error":"error encoding arguments: unknown Device handle"`)
+
+	replayer, err := New(&recording)
+	require.NoError(t, err)
+	_, ret = replayer.DeviceGetName(&mock.Device{})
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	require.Len(t, replayer.Missing(), 1)
+
+	// Handles of non-comparable types fail the recording
+	recording.Reset()
+	recorder = NewRecorder(&mock.Interface{
+		DeviceGetHandleByIndexFunc: func(n int) (nvml.Device, nvml.Return) {
+			return sliceDevice{}, nvml.SUCCESS
+		},
+	}, &recording)
+	_, ret = recorder.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.ErrorContains(t, recorder.Err(), "non-comparable type replay.sliceDevice")
+	require.Empty(t, recording.String())
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+// Capture walks an initialized nvml.Interface and returns a fixture from
+// which an equivalent mock server can be created.
+//
+// Compute instance profiles can only be queried through an existing GPU
+// instance. They are therefore only captured for GPU instance profiles that
+// have at least one GPU instance at the time of the capture.
+func Capture(lib nvml.Interface) (*Fixture, error) {
+	driverVersion, ret := lib.SystemGetDriverVersion()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting driver version: %v", ret)
+	}
+	nvmlVersion, ret := lib.SystemGetNVMLVersion()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting NVML version: %v", ret)
+	}
+	cudaDriverVersion, ret := lib.SystemGetCudaDriverVersion()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting CUDA driver version: %v", ret)
+	}
+
+	count, ret := lib.DeviceGetCount()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting device count: %v", ret)
+	}
+
+	fixture := &Fixture{
+		Version:           FixtureVersion,
+		DriverVersion:     driverVersion,
+		NvmlVersion:       nvmlVersion,
+		CudaDriverVersion: cudaDriverVersion,
+	}
+
+	var devices []nvml.Device
+	for i := 0; i < count; i++ {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("error getting device %d: %v", i, ret)
+		}
+		gpu, err := captureDevice(device)
+		if err != nil {
+			return nil, fmt.Errorf("error capturing device %d: %w", i, err)
+		}
+		devices = append(devices, device)
+		fixture.GPUs = append(fixture.GPUs, *gpu)
+	}
+
+	topology, err := captureTopology(devices)
+	if err != nil {
+		return nil, err
+	}
+	fixture.Topology = topology
+
+	return fixture, nil
+}
+
+func captureDevice(device nvml.Device) (*GPUFixture, error) {
+	config := &gpus.Config{}
+
+	var ret nvml.Return
+	if config.Name, ret = device.GetName(); ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting name: %v", ret)
+	}
+	if config.Architecture, ret = device.GetArchitecture(); ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting architecture: %v", ret)
+	}
+	if config.Brand, ret = device.GetBrand(); ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting brand: %v", ret)
+	}
+	if config.CudaMajor, config.CudaMinor, ret = device.GetCudaComputeCapability(); ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting CUDA compute capability: %v", ret)
+	}
+	memory, ret := device.GetMemoryInfo()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting memory info: %v", ret)
+	}
+	config.MemoryMB = memory.Total / (1024 * 1024)
+
+	pciInfo, ret := device.GetPciInfo()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting PCI info: %v", ret)
+	}
+	config.PciDeviceId = pciInfo.PciDeviceId
+
+	uuid, ret := device.GetUUID()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("error getting UUID: %v", ret)
+	}
+
+	gpu := &GPUFixture{
+		Config:   config,
+		UUID:     uuid,
+		PciBusID: int8ToString(pciInfo.BusId[:]),
+	}
+
+	migMode, _, ret := device.GetMigMode()
+	switch ret {
+	case nvml.SUCCESS:
+	case nvml.ERROR_NOT_SUPPORTED:
+		return gpu, nil
+	default:
+		return nil, fmt.Errorf("error getting MIG mode: %v", ret)
+	}
+	gpu.MigMode = migMode
+
+	if err := captureMIG(device, gpu); err != nil {
+		return nil, err
+	}
+	return gpu, nil
+}
+
+func captureMIG(device nvml.Device, gpu *GPUFixture) error {
+	profiles := gpus.MIGProfileConfig{
+		GpuInstanceProfiles:       make(map[int]nvml.GpuInstanceProfileInfo),
+		ComputeInstanceProfiles:   make(map[int]map[int]nvml.ComputeInstanceProfileInfo),
+		GpuInstancePlacements:     make(map[int][]nvml.GpuInstancePlacement),
+		ComputeInstancePlacements: make(map[int]map[int][]nvml.ComputeInstancePlacement),
+	}
+
+	for giProfileId := 0; giProfileId < nvml.GPU_INSTANCE_PROFILE_COUNT; giProfileId++ {
+		giProfile, ret := device.GetGpuInstanceProfileInfo(giProfileId)
+		if ret == nvml.ERROR_NOT_SUPPORTED || ret == nvml.ERROR_INVALID_ARGUMENT {
+			continue
+		}
+		if ret != nvml.SUCCESS {
+			return fmt.Errorf("error getting GPU instance profile %d: %v", giProfileId, ret)
+		}
+		profiles.GpuInstanceProfiles[giProfileId] = giProfile
+
+		placements, ret := device.GetGpuInstancePossiblePlacements(&giProfile)
+		if ret != nvml.SUCCESS {
+			return fmt.Errorf("error getting GPU instance placements for profile %d: %v", giProfileId, ret)
+		}
+		profiles.GpuInstancePlacements[giProfileId] = placements
+
+		if gpu.MigMode != nvml.DEVICE_MIG_ENABLE {
+			continue
+		}
+		gis, ret := device.GetGpuInstances(&giProfile)
+		if ret != nvml.SUCCESS {
+			return fmt.Errorf("error getting GPU instances for profile %d: %v", giProfileId, ret)
+		}
+		type instance struct {
+			gi   nvml.GpuInstance
+			info nvml.GpuInstanceInfo
+		}
+		var instances []instance
+		for _, gi := range gis {
+			info, ret := gi.GetInfo()
+			if ret != nvml.SUCCESS {
+				return fmt.Errorf("error getting GPU instance info: %v", ret)
+			}
+			instances = append(instances, instance{gi, info})
+		}
+		sort.Slice(instances, func(i, j int) bool { return instances[i].info.Id < instances[j].info.Id })
+		for _, instance := range instances {
+			gif, err := captureGpuInstance(instance.gi, instance.info, &profiles)
+			if err != nil {
+				return err
+			}
+			gpu.GpuInstances = append(gpu.GpuInstances, *gif)
+		}
+	}
+
+	if len(profiles.GpuInstanceProfiles) > 0 {
+		gpu.Config.MIGProfiles = profiles
+	}
+	return nil
+}
+
+func captureGpuInstance(gi nvml.GpuInstance, info nvml.GpuInstanceInfo, profiles *gpus.MIGProfileConfig) (*GpuInstanceFixture, error) {
+	giProfileId := int(info.ProfileId)
+	placement := info.Placement
+	gif := &GpuInstanceFixture{
+		Profile:   ProfileID{ID: giProfileId},
+		Placement: &placement,
+	}
+
+	if _, exists := profiles.ComputeInstanceProfiles[giProfileId]; !exists {
+		profiles.ComputeInstanceProfiles[giProfileId] = make(map[int]nvml.ComputeInstanceProfileInfo)
+		profiles.ComputeInstancePlacements[giProfileId] = make(map[int][]nvml.ComputeInstancePlacement)
+	}
+
+	for ciProfileId := 0; ciProfileId < nvml.COMPUTE_INSTANCE_PROFILE_COUNT; ciProfileId++ {
+		ciProfile, ret := gi.GetComputeInstanceProfileInfo(ciProfileId, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+		if ret == nvml.ERROR_NOT_SUPPORTED || ret == nvml.ERROR_INVALID_ARGUMENT {
+			continue
+		}
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("error getting compute instance profile %d: %v", ciProfileId, ret)
+		}
+		profiles.ComputeInstanceProfiles[giProfileId][ciProfileId] = ciProfile
+
+		placements, ret := gi.GetComputeInstancePossiblePlacements(&ciProfile)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("error getting compute instance placements for profile %d: %v", ciProfileId, ret)
+		}
+		profiles.ComputeInstancePlacements[giProfileId][ciProfileId] = placements
+
+		cis, ret := gi.GetComputeInstances(&ciProfile)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("error getting compute instances for profile %d: %v", ciProfileId, ret)
+		}
+		var infos []nvml.ComputeInstanceInfo
+		for _, ci := range cis {
+			info, ret := ci.GetInfo()
+			if ret != nvml.SUCCESS {
+				return nil, fmt.Errorf("error getting compute instance info: %v", ret)
+			}
+			infos = append(infos, info)
+		}
+		sort.Slice(infos, func(i, j int) bool { return infos[i].Id < infos[j].Id })
+		for _, info := range infos {
+			placement := info.Placement
+			gif.ComputeInstances = append(gif.ComputeInstances, ComputeInstanceFixture{
+				Profile:   ProfileID{ID: ciProfileId},
+				Placement: &placement,
+			})
+		}
+	}
+	return gif, nil
+}
+
+// captureTopology returns the common ancestor of each pair of devices. A nil
+// matrix is returned if topology queries are not supported.
+func captureTopology(devices []nvml.Device) ([][]nvml.GpuTopologyLevel, error) {
+	if len(devices) < 2 {
+		return nil, nil
+	}
+	topology := make([][]nvml.GpuTopologyLevel, len(devices))
+	for i, d1 := range devices {
+		topology[i] = make([]nvml.GpuTopologyLevel, len(devices))
+		for j, d2 := range devices {
+			if i == j {
+				topology[i][j] = nvml.TOPOLOGY_INTERNAL
+				continue
+			}
+			level, ret := d1.GetTopologyCommonAncestor(d2)
+			if ret == nvml.ERROR_NOT_SUPPORTED {
+				return nil, nil
+			}
+			if ret != nvml.SUCCESS {
+				return nil, fmt.Errorf("error getting common ancestor of devices %d and %d: %v", i, j, ret)
+			}
+			topology[i][j] = level
+		}
+	}
+	return topology, nil
+}
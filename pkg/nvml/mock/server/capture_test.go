@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestCaptureRoundTrip(t *testing.T) {
+	original, err := New(
+		WithGPUs(gpus.H100_SXM5_80GB, gpus.H100_SXM5_80GB),
+		WithDriverVersion("550.54.15"),
+		WithNVMLVersion("12.550.54.15"),
+		WithCUDADriverVersion(12040),
+	)
+	require.NoError(t, err)
+	original.TopologyLevels = [][]nvml.GpuTopologyLevel{
+		{nvml.TOPOLOGY_INTERNAL, nvml.TOPOLOGY_NODE},
+		{nvml.TOPOLOGY_NODE, nvml.TOPOLOGY_INTERNAL},
+	}
+
+	device := original.Devices[0]
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstanceWithPlacement(&giProfile, &nvml.GpuInstancePlacement{Start: 4, Size: 3})
+	require.Equal(t, nvml.SUCCESS, ret)
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = gi.CreateComputeInstanceWithPlacement(&ciProfile, &nvml.ComputeInstancePlacement{Start: 2, Size: 1})
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	fixture, err := Capture(original)
+	require.NoError(t, err)
+
+	data, err := yaml.Marshal(fixture)
+	require.NoError(t, err)
+	parsed, err := ParseFixture(data)
+	require.NoError(t, err)
+
+	replayed, err := New(WithFixture(parsed))
+	require.NoError(t, err)
+
+	require.Equal(t, original.DriverVersion, replayed.DriverVersion)
+	require.Equal(t, original.NvmlVersion, replayed.NvmlVersion)
+	require.Equal(t, original.CudaDriverVersion, replayed.CudaDriverVersion)
+	require.Equal(t, original.TopologyLevels, replayed.TopologyLevels)
+	require.Len(t, replayed.Devices, 2)
+
+	for i := range original.Devices {
+		o := original.Devices[i].(*Device)
+		r := replayed.Devices[i].(*Device)
+		require.Equal(t, o.UUID, r.UUID)
+		require.Equal(t, o.PciBusID, r.PciBusID)
+		require.Equal(t, o.MigMode, r.MigMode)
+		require.Equal(t, o.Config.Name, r.Config.Name)
+		require.Equal(t, o.Config.MemoryMB, r.Config.MemoryMB)
+		require.Equal(t, o.Config.MIGProfiles.GpuInstanceProfiles, r.Config.MIGProfiles.GpuInstanceProfiles)
+		require.Equal(t, o.Config.MIGProfiles.GpuInstancePlacements, r.Config.MIGProfiles.GpuInstancePlacements)
+	}
+
+	level, ret := replayed.DeviceGetTopologyCommonAncestor(replayed.Devices[0], replayed.Devices[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.TOPOLOGY_NODE, level)
+
+	gis, ret := replayed.Devices[0].GetGpuInstances(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, gis, 1)
+	info, ret := gis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GpuInstancePlacement{Start: 4, Size: 3}, info.Placement)
+	cis, ret := gis[0].GetComputeInstances(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, cis, 1)
+	ciInfo, ret := cis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.ComputeInstancePlacement{Start: 2, Size: 1}, ciInfo.Placement)
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// EventSetBufferSize is the number of undelivered events an event set holds.
+// Further events are dropped until the event set is waited on.
+const EventSetBufferSize = 64
+
+// DefaultSupportedEventTypes are the event types supported by a device unless
+// overridden through Device.SupportedEventTypes
+const DefaultSupportedEventTypes = nvml.EventTypeSingleBitEccError |
+	nvml.EventTypeDoubleBitEccError |
+	nvml.EventTypePState |
+	nvml.EventTypeXidCriticalError |
+	nvml.EventTypeClock |
+	nvml.EventTypePowerSourceChange |
+	nvml.EventMigConfigChange
+
+// noInstanceID is reported as the GPU and compute instance ID of events that
+// are not attributable to a MIG device
+const noInstanceID = 0xFFFFFFFF
+
+// EventSet provides a reusable in-memory event set implementation
+type EventSet struct {
+	mock.EventSet
+	sync.Mutex
+	devices map[*Device]struct{}
+	events  chan nvml.EventData
+	done    chan struct{}
+	freed   bool
+}
+
+var _ nvml.EventSet = (*EventSet)(nil)
+
+// NewEventSet creates a new, empty event set
+func NewEventSet() *EventSet {
+	set := &EventSet{
+		devices: make(map[*Device]struct{}),
+		events:  make(chan nvml.EventData, EventSetBufferSize),
+		done:    make(chan struct{}),
+	}
+	set.SetMockFuncs()
+	return set
+}
+
+// SetMockFuncs configures all the mock function implementations for the event set
+func (set *EventSet) SetMockFuncs() {
+	set.WaitFunc = func(timeoutms uint32) (nvml.EventData, nvml.Return) {
+		set.Lock()
+		freed := set.freed
+		set.Unlock()
+		if freed {
+			return nvml.EventData{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+
+		if timeoutms == 0 {
+			select {
+			case e := <-set.events:
+				return e, nvml.SUCCESS
+			default:
+				return nvml.EventData{}, nvml.ERROR_TIMEOUT
+			}
+		}
+
+		timer := time.NewTimer(time.Duration(timeoutms) * time.Millisecond)
+		defer timer.Stop()
+		select {
+		case e := <-set.events:
+			return e, nvml.SUCCESS
+		case <-set.done:
+			return nvml.EventData{}, nvml.ERROR_INVALID_ARGUMENT
+		case <-timer.C:
+			return nvml.EventData{}, nvml.ERROR_TIMEOUT
+		}
+	}
+
+	set.FreeFunc = func() nvml.Return {
+		set.Lock()
+		if set.freed {
+			set.Unlock()
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		set.freed = true
+		close(set.done)
+		devices := set.devices
+		set.devices = nil
+		set.Unlock()
+
+		for d := range devices {
+			d.Lock()
+			delete(d.eventSets, set)
+			d.Unlock()
+		}
+		return nvml.SUCCESS
+	}
+}
+
+// register records that the event set receives events from the device
+func (set *EventSet) register(d *Device) nvml.Return {
+	set.Lock()
+	defer set.Unlock()
+	if set.freed {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	set.devices[d] = struct{}{}
+	return nvml.SUCCESS
+}
+
+// deliver queues an event on the event set, returning false if the event set
+// has been freed or is full
+func (set *EventSet) deliver(e nvml.EventData) bool {
+	set.Lock()
+	defer set.Unlock()
+	if set.freed {
+		return false
+	}
+	select {
+	case set.events <- e:
+		return true
+	default:
+		return false
+	}
+}
+
+// setEventFuncs configures the event registration of a device
+func (d *Device) setEventFuncs() {
+	d.GetSupportedEventTypesFunc = func() (uint64, nvml.Return) {
+		return d.SupportedEventTypes, nvml.SUCCESS
+	}
+
+	d.RegisterEventsFunc = func(eventTypes uint64, set nvml.EventSet) nvml.Return {
+		s, ok := set.(*EventSet)
+		if !ok {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		d.Lock()
+		defer d.Unlock()
+		if eventTypes&^d.SupportedEventTypes != 0 {
+			return nvml.ERROR_NOT_SUPPORTED
+		}
+		if ret := s.register(d); ret != nvml.SUCCESS {
+			return ret
+		}
+		d.eventSets[s] |= eventTypes
+		return nvml.SUCCESS
+	}
+}
+
+// EmitEvent delivers an event to all event sets that the device is registered
+// with for the event type. If the device is a MIG device, the event is
+// reported for its parent device along with the GPU and compute instance IDs
+// of the MIG device. The number of event sets the event was delivered to is
+// returned.
+func (s *Server) EmitEvent(device nvml.Device, eventType uint64, eventData uint64) int {
+	e := nvml.EventData{
+		EventType:         eventType,
+		EventData:         eventData,
+		GpuInstanceId:     noInstanceID,
+		ComputeInstanceId: noInstanceID,
+	}
+
+	var d *Device
+	switch device := device.(type) {
+	case *Device:
+		d = device
+	case *MigDevice:
+		d = device.parent()
+		e.GpuInstanceId = device.gpuInstance().Info.Id
+		e.ComputeInstanceId = device.ComputeInstance.Info.Id
+	default:
+		return 0
+	}
+	e.Device = d
+
+	d.RLock()
+	var sets []*EventSet
+	for set, mask := range d.eventSets {
+		if mask&eventType != 0 {
+			sets = append(sets, set)
+		}
+	}
+	d.RUnlock()
+
+	delivered := 0
+	for _, set := range sets {
+		if set.deliver(e) {
+			delivered++
+		}
+	}
+	return delivered
+}
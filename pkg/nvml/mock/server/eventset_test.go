@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestEventSet(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	set, ret := s.EventSetCreate()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	supported, ret := s.DeviceGetSupportedEventTypes(s.Devices[0])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(DefaultSupportedEventTypes), supported)
+
+	ret = s.DeviceRegisterEvents(s.Devices[0], nvml.EventTypeSingleBitEccErrorStorm, set)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	ret = s.DeviceRegisterEvents(s.Devices[0], nvml.EventTypeXidCriticalError, set)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	_, ret = s.EventSetWait(set, 0)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+
+	start := time.Now()
+	_, ret = s.EventSetWait(set, 20)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	require.Equal(t, 0, s.EmitEvent(s.Devices[0], nvml.EventTypePState, 0))
+	require.Equal(t, 0, s.EmitEvent(s.Devices[1], nvml.EventTypeXidCriticalError, 79))
+	require.Equal(t, 1, s.EmitEvent(s.Devices[0], nvml.EventTypeXidCriticalError, 79))
+
+	e, ret := set.Wait(1000)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, s.Devices[0], e.Device)
+	require.Equal(t, uint64(nvml.EventTypeXidCriticalError), e.EventType)
+	require.Equal(t, uint64(79), e.EventData)
+	require.Equal(t, uint32(0xFFFFFFFF), e.GpuInstanceId)
+	require.Equal(t, uint32(0xFFFFFFFF), e.ComputeInstanceId)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.EmitEvent(s.Devices[0], nvml.EventTypeXidCriticalError, 48)
+	}()
+	e, ret = set.Wait(1000)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(48), e.EventData)
+
+	ret = s.EventSetFree(set)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 0, s.EmitEvent(s.Devices[0], nvml.EventTypeXidCriticalError, 79))
+
+	_, ret = set.Wait(0)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, set.Free())
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, s.DeviceRegisterEvents(s.Devices[0], nvml.EventTypeXidCriticalError, set))
+}
+
+func TestEventSetMigDevice(t *testing.T) {
+	s, err := New(WithGPUs(gpus.H100_SXM5_80GB))
+	require.NoError(t, err)
+	device := s.Devices[0]
+
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	migDevice, ret := device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	set := NewEventSet()
+	ret = device.RegisterEvents(nvml.EventTypeXidCriticalError|nvml.EventTypeDoubleBitEccError, set)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	require.Equal(t, 1, s.EmitEvent(migDevice, nvml.EventTypeXidCriticalError, 94))
+
+	e, ret := set.Wait(1000)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, device, e.Device)
+	require.Equal(t, uint64(94), e.EventData)
+
+	giInfo, ret := gi.GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, giInfo.Id, e.GpuInstanceId)
+	ciId, ret := migDevice.GetComputeInstanceId()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(ciId), e.ComputeInstanceId)
+}
@@ -0,0 +1,381 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Fault describes calls that should fail with a specific return code instead
+// of being handled by the mock implementation.
+type Fault struct {
+	// Method is the name of the faulted method, e.g. "GetTemperature" for a
+	// Device method or "DeviceGetCount" for a Server method. Server methods
+	// that forward to a device, GPU instance or compute instance method (e.g.
+	// DeviceGetTemperature) are intercepted through that method, so either
+	// name matches calls made through both. An empty Method matches all
+	// methods.
+	Method string
+	// Devices restricts the fault to calls on (or with) the devices with the
+	// specified indices. An empty list matches all devices.
+	Devices []int
+	// UUID restricts the fault to calls on (or with) the device with the
+	// specified UUID.
+	UUID string
+	// Return is the code returned by faulted calls.
+	Return nvml.Return
+	// After is the number of matching calls that succeed before the fault
+	// takes effect.
+	After int
+	// Probability is the probability, between 0 and 1, with which a matching
+	// call fails once the fault is in effect. If unset (0), it defaults to
+	// DefaultFaultProbability so that all matching calls fail.
+	Probability float64
+	// Times limits how often the fault fires. A value of 0 means no limit.
+	Times int
+}
+
+// DefaultFaultProbability is the probability of faults that do not set one
+const DefaultFaultProbability = 1.0
+
+// activeFault tracks the state of an injected fault
+type activeFault struct {
+	Fault
+	calls int
+	fired int
+}
+
+// faultInjector intercepts the mock functions of a server, its devices and
+// their GPU instances, compute instances and MIG devices
+type faultInjector struct {
+	sync.Mutex
+	faults      []*activeFault
+	rand        *rand.Rand
+	intercepted map[interface{}]bool
+}
+
+// WithFaults injects the specified faults into the server
+func WithFaults(faults ...Fault) Option {
+	return func(o *options) error {
+		for _, f := range faults {
+			if f.Probability < 0 || f.Probability > 1 {
+				return fmt.Errorf("invalid probability %v for fault on %q", f.Probability, f.Method)
+			}
+		}
+		o.faults = append(o.faults, faults...)
+		return nil
+	}
+}
+
+// WithFaultSeed sets the seed used for probabilistic faults
+func WithFaultSeed(seed int64) Option {
+	return func(o *options) error {
+		o.faultSeed = seed
+		return nil
+	}
+}
+
+// InjectFault adds a fault to the server. The returned function removes it.
+// Faults only apply to the mock functions set when the server was created.
+func (s *Server) InjectFault(f Fault) func() {
+	if f.Probability == 0 {
+		f.Probability = DefaultFaultProbability
+	}
+	fi := s.faultInjector()
+	fi.Lock()
+	defer fi.Unlock()
+	af := &activeFault{Fault: f}
+	fi.faults = append(fi.faults, af)
+	return func() {
+		fi.Lock()
+		defer fi.Unlock()
+		for i, other := range fi.faults {
+			if other == af {
+				fi.faults = append(fi.faults[:i], fi.faults[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// ClearFaults removes all faults from the server
+func (s *Server) ClearFaults() {
+	fi := s.faultInjector()
+	fi.Lock()
+	defer fi.Unlock()
+	fi.faults = nil
+}
+
+// installFaultInjector intercepts the mock functions of the server and its
+// devices so that faults can be injected. This is done when the server is
+// created, before it can be called concurrently, so that injecting a fault
+// only updates the state of the injector. GPU instances and compute instances
+// created later are intercepted when they are created. Functions that are
+// replaced after this point are not intercepted.
+func (s *Server) installFaultInjector(seed int64) {
+	s.faultsOnce.Do(func() {
+		s.faults = &faultInjector{rand: rand.New(rand.NewSource(seed))}
+		s.faults.interceptServer(s)
+		for _, d := range s.Devices {
+			if device, ok := d.(*Device); ok {
+				s.faults.interceptDevice(device)
+			}
+		}
+	})
+}
+
+// faultInjector returns the fault injector of the server. Servers that were
+// not created by New or NewServerWithGPUs are intercepted on first use, which
+// must not happen concurrently with calls to the server.
+func (s *Server) faultInjector() *faultInjector {
+	s.installFaultInjector(0)
+	return s.faults
+}
+
+var returnType = reflect.TypeOf(nvml.SUCCESS)
+
+// handleTypes maps the handle types that are intercepted themselves to the
+// prefix of the server methods forwarding to their methods
+var handleTypes = map[reflect.Type]string{
+	reflect.TypeOf((*nvml.Device)(nil)).Elem():          "Device",
+	reflect.TypeOf((*nvml.GpuInstance)(nil)).Elem():     "GpuInstance",
+	reflect.TypeOf((*nvml.ComputeInstance)(nil)).Elem(): "ComputeInstance",
+}
+
+// interceptServer intercepts the server methods. Methods forwarding to a
+// method of their handle argument are left alone so that each call is only
+// checked once, by the handle.
+func (fi *faultInjector) interceptServer(s *Server) {
+	fi.intercept(&s.Interface, "", nil, func(method string, t reflect.Type) bool {
+		return !isForwardingMethod(method, t)
+	})
+}
+
+// interceptDevice intercepts the methods of a device and of its existing GPU
+// instances and compute instances, and records the fault injector on the
+// device so that instances created later are intercepted too
+func (fi *faultInjector) interceptDevice(d *Device) {
+	d.RLock()
+	defer d.RUnlock()
+	d.faults.Store(fi)
+	fi.intercept(&d.Device, "Device", d, nil)
+	for gi := range d.GpuInstances {
+		fi.interceptGpuInstance(gi)
+	}
+}
+
+// interceptGpuInstance intercepts the methods of a GPU instance and its
+// compute instances. Faults are matched against the parent device.
+func (fi *faultInjector) interceptGpuInstance(gi *GpuInstance) {
+	gi.RLock()
+	defer gi.RUnlock()
+	fi.intercept(&gi.GpuInstance, "GpuInstance", gi.Info.Device, nil)
+	for ci := range gi.ComputeInstances {
+		fi.interceptComputeInstance(ci)
+	}
+}
+
+// interceptComputeInstance intercepts the methods of a compute instance,
+// matched against the parent device, and of its MIG device.
+func (fi *faultInjector) interceptComputeInstance(ci *ComputeInstance) {
+	fi.intercept(&ci.ComputeInstance, "ComputeInstance", ci.Info.Device, nil)
+	fi.intercept(&ci.MigDevice.Device, "Device", ci.MigDevice, nil)
+}
+
+// interceptFaults intercepts the methods of a GPU instance or compute
+// instance created on the device if faults are injected into its server
+func (d *Device) interceptFaults(instance interface{}) {
+	fi := d.faults.Load()
+	if fi == nil {
+		return
+	}
+	switch instance := instance.(type) {
+	case *GpuInstance:
+		fi.interceptGpuInstance(instance)
+	case *ComputeInstance:
+		fi.interceptComputeInstance(instance)
+	}
+}
+
+// isForwardingMethod checks whether a server method of the specified type
+// forwards to a method of an intercepted handle passed as its first argument,
+// e.g. DeviceGetTemperature(device, sensor) to device.GetTemperature(sensor).
+func isForwardingMethod(method string, t reflect.Type) bool {
+	if t.NumIn() == 0 {
+		return false
+	}
+	prefix, ok := handleTypes[t.In(0)]
+	if !ok {
+		return false
+	}
+	for _, name := range []string{strings.TrimPrefix(method, prefix), method} {
+		m, ok := t.In(0).MethodByName(name)
+		if !ok || m.Type.NumIn() != t.NumIn()-1 || m.Type.NumOut() != t.NumOut() {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// intercept wraps the non-nil *Func fields of the specified moq mock that
+// are selected by the filter, or all of them if the filter is nil. Each mock
+// is intercepted at most once. The prefix is the one used by server methods
+// forwarding to the methods of the mock, which also match these methods. The
+// device identifies the device that faults are matched against; for server
+// methods the device is taken from the first argument, if any.
+func (fi *faultInjector) intercept(mock interface{}, prefix string, device nvml.Device, filter func(string, reflect.Type) bool) {
+	fi.Lock()
+	if fi.intercepted[mock] {
+		fi.Unlock()
+		return
+	}
+	if fi.intercepted == nil {
+		fi.intercepted = make(map[interface{}]bool)
+	}
+	fi.intercepted[mock] = true
+	fi.Unlock()
+
+	interceptMockFuncs(mock, func(method string, original reflect.Value) func([]reflect.Value) []reflect.Value {
+		if filter != nil && !filter(method, original.Type()) {
+			return nil
+		}
+		methods := []string{method}
+		if prefix != "" {
+			methods = append(methods, prefix+method)
+		}
+		return func(args []reflect.Value) []reflect.Value {
+			target := device
+			if target == nil && len(args) > 0 {
+				target, _ = args[0].Interface().(nvml.Device)
+			}
+			if ret, faulted := fi.check(methods, target); faulted {
+				return faultResults(original.Type(), ret)
+			}
+			return original.Call(args)
+		}
+	})
+}
+
+// interceptMockFuncs replaces each non-nil *Func field of the specified moq
+// mock with the function returned by wrap for the original function. Fields
+// for which wrap returns nil are left unchanged.
+func interceptMockFuncs(mock interface{}, wrap func(method string, original reflect.Value) func([]reflect.Value) []reflect.Value) {
+	v := reflect.ValueOf(mock).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		name := v.Type().Field(i).Name
+		if field.Kind() != reflect.Func || field.IsNil() || !strings.HasSuffix(name, "Func") {
+			continue
+		}
+		original := reflect.ValueOf(field.Interface())
+		wrapped := wrap(strings.TrimSuffix(name, "Func"), original)
+		if wrapped == nil {
+			continue
+		}
+		field.Set(reflect.MakeFunc(field.Type(), wrapped))
+	}
+}
+
+// check returns the return code of the first fault that applies to a call of
+// a method known by any of the specified names.
+func (fi *faultInjector) check(methods []string, device nvml.Device) (nvml.Return, bool) {
+	fi.Lock()
+	defer fi.Unlock()
+	for _, f := range fi.faults {
+		if !f.matches(methods, device) {
+			continue
+		}
+		f.calls++
+		if f.calls <= f.After {
+			continue
+		}
+		if f.Times > 0 && f.fired >= f.Times {
+			continue
+		}
+		if fi.rand.Float64() >= f.Probability {
+			continue
+		}
+		f.fired++
+		return f.Return, true
+	}
+	return nvml.SUCCESS, false
+}
+
+func (f *activeFault) matches(methods []string, device nvml.Device) bool {
+	if f.Method != "" && !containsString(methods, f.Method) {
+		return false
+	}
+	if len(f.Devices) == 0 && f.UUID == "" {
+		return true
+	}
+
+	var d *Device
+	switch device := device.(type) {
+	case *Device:
+		d = device
+	case *MigDevice:
+		if f.UUID != "" && f.UUID == device.UUID {
+			return true
+		}
+		d = device.parent()
+	default:
+		return false
+	}
+
+	if f.UUID != "" && f.UUID != d.UUID {
+		return false
+	}
+	if len(f.Devices) == 0 {
+		return true
+	}
+	for _, index := range f.Devices {
+		if index == d.Index {
+			return true
+		}
+	}
+	return false
+}
+
+// faultResults returns the results of a faulted call: the fault's return code
+// for every nvml.Return result and zero values for all others.
+func faultResults(t reflect.Type, ret nvml.Return) []reflect.Value {
+	results := make([]reflect.Value, t.NumOut())
+	for i := range results {
+		if t.Out(i) == returnType {
+			results[i] = reflect.ValueOf(ret)
+		} else {
+			results[i] = reflect.Zero(t.Out(i))
+		}
+	}
+	return results
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
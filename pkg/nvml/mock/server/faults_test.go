@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestFaultAfterCalls(t *testing.T) {
+	s, err := New(
+		WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		WithFaults(Fault{Method: "GetUUID", Devices: []int{1}, Return: nvml.ERROR_GPU_IS_LOST, After: 2}),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, ret := s.Devices[1].GetUUID()
+		require.Equal(t, nvml.SUCCESS, ret)
+	}
+	uuid, ret := s.Devices[1].GetUUID()
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+	require.Empty(t, uuid)
+
+	// Other devices and methods are unaffected
+	_, ret = s.Devices[0].GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = s.Devices[1].GetName()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	s.ClearFaults()
+	_, ret = s.Devices[1].GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+}
+
+func TestFaultRuntimeInjection(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...))
+	require.NoError(t, err)
+
+	uuid := s.Devices[0].(*Device).UUID
+	remove := s.InjectFault(Fault{UUID: uuid, Return: nvml.ERROR_GPU_IS_LOST, Times: 1})
+
+	// Server methods taking a device are matched by their device argument
+	_, ret := s.DeviceGetMaxMigDeviceCount(s.Devices[0])
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+	_, ret = s.DeviceGetMaxMigDeviceCount(s.Devices[0])
+	require.Equal(t, nvml.SUCCESS, ret)
+	remove()
+
+	remove = s.InjectFault(Fault{Method: "DeviceGetCount", Return: nvml.ERROR_UNKNOWN})
+	_, ret = s.DeviceGetCount()
+	require.Equal(t, nvml.ERROR_UNKNOWN, ret)
+	remove()
+	count, ret := s.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, count)
+}
+
+func TestFaultProbability(t *testing.T) {
+	run := func() []nvml.Return {
+		s, err := New(
+			WithGPUs(gpus.H100_SXM5_80GB),
+			WithFaults(Fault{Method: "GetName", Return: nvml.ERROR_TIMEOUT, Probability: 0.5}),
+			WithFaultSeed(42),
+		)
+		require.NoError(t, err)
+		var rets []nvml.Return
+		for i := 0; i < 100; i++ {
+			_, ret := s.Devices[0].GetName()
+			rets = append(rets, ret)
+		}
+		return rets
+	}
+
+	rets := run()
+	require.Equal(t, rets, run())
+	require.Contains(t, rets, nvml.SUCCESS)
+	require.Contains(t, rets, nvml.ERROR_TIMEOUT)
+}
+
+func TestFaultInvalidProbability(t *testing.T) {
+	_, err := New(
+		WithGPUs(gpus.H100_SXM5_80GB),
+		WithFaults(Fault{Method: "GetName", Return: nvml.ERROR_TIMEOUT, Probability: 1.5}),
+	)
+	require.Error(t, err)
+}
+
+func TestFaultConcurrentInjection(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	// Injecting faults while the server is in use only updates the injector
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for _, d := range s.Devices {
+		wg.Add(1)
+		go func(d nvml.Device) {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				_, _ = d.GetName()
+				_, _ = s.DeviceGetCount()
+			}
+		}(d)
+	}
+	for i := 0; i < 100; i++ {
+		remove := s.InjectFault(Fault{Method: "GetName", Return: nvml.ERROR_TIMEOUT})
+		remove()
+	}
+	close(stop)
+	wg.Wait()
+
+	remove := s.InjectFault(Fault{Method: "GetName", Return: nvml.ERROR_TIMEOUT})
+	defer remove()
+	_, ret := s.Devices[0].GetName()
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+}
+
+func TestFaultServerCallsCountedOnce(t *testing.T) {
+	s, err := New(
+		WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		WithFaults(Fault{Devices: []int{0}, Return: nvml.ERROR_GPU_IS_LOST, After: 1}),
+	)
+	require.NoError(t, err)
+
+	// A server call forwarding to the device is a single call
+	_, ret := s.DeviceGetMaxMigDeviceCount(s.Devices[0])
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = s.DeviceGetMaxMigDeviceCount(s.Devices[0])
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+
+	// Faults on either name apply to calls through the server and the device
+	s.ClearFaults()
+	s.InjectFault(Fault{Method: "DeviceIsMigDeviceHandle", Return: nvml.ERROR_TIMEOUT, After: 1})
+	_, ret = s.Devices[1].IsMigDeviceHandle()
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = s.DeviceIsMigDeviceHandle(s.Devices[1])
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+}
+
+func TestFaultMigDevice(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...))
+	require.NoError(t, err)
+	device := s.Devices[1]
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// Faults on the device apply to its GPU instances, including those
+	// created before the fault was injected
+	remove := s.InjectFault(Fault{Method: "GetInfo", Devices: []int{1}, Return: nvml.ERROR_UNKNOWN})
+	_, ret = gi.GetInfo()
+	require.Equal(t, nvml.ERROR_UNKNOWN, ret)
+	remove()
+
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ci, ret := gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	migDevice := ci.(*ComputeInstance).MigDevice
+
+	// Faults on a MIG device are matched by its UUID and do not affect the
+	// parent device
+	s.InjectFault(Fault{Method: "IsMigDeviceHandle", UUID: migDevice.UUID, Return: nvml.ERROR_GPU_IS_LOST, After: 1})
+	_, ret = s.DeviceIsMigDeviceHandle(migDevice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = migDevice.IsMigDeviceHandle()
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+	_, ret = device.IsMigDeviceHandle()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	s.InjectFault(Fault{Method: "ComputeInstanceGetInfo", Devices: []int{1}, Return: nvml.ERROR_UNKNOWN})
+	_, ret = ci.GetInfo()
+	require.Equal(t, nvml.ERROR_UNKNOWN, ret)
+}
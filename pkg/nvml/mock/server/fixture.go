@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+// FixtureVersion is the current version of the fixture file format
+const FixtureVersion = "v1"
+
+// Fixture is a declarative description of a mock server. Fixtures are read
+// from YAML or JSON documents (JSON being a subset of YAML).
+type Fixture struct {
+	Version           string       `yaml:"version"`
+	DriverVersion     string       `yaml:"driverVersion,omitempty"`
+	NvmlVersion       string       `yaml:"nvmlVersion,omitempty"`
+	CudaDriverVersion int          `yaml:"cudaDriverVersion,omitempty"`
+	GPUs              []GPUFixture `yaml:"gpus"`
+	// Topology optionally holds the common ancestor of each pair of devices,
+	// indexed by device index.
+	Topology [][]nvml.GpuTopologyLevel `yaml:"topology,omitempty"`
+}
+
+// GPUFixture describes one or more identical GPUs. A GPU is either selected
+// from the gpus catalog by Model (e.g. "H100_SXM5_80GB"), or defined inline
+// by Config using the same camelCase keys as the rest of the fixture (e.g.
+// memoryMB), including within its MIG profiles (e.g. sliceCount).
+type GPUFixture struct {
+	Model        string               `yaml:"model,omitempty"`
+	Config       *gpus.Config         `yaml:"config,omitempty"`
+	Count        int                  `yaml:"count,omitempty"`
+	UUID         string               `yaml:"uuid,omitempty"`
+	PciBusID     string               `yaml:"pciBusId,omitempty"`
+	MigMode      int                  `yaml:"migMode,omitempty"`
+	GpuInstances []GpuInstanceFixture `yaml:"gpuInstances,omitempty"`
+}
+
+// GpuInstanceFixture describes a GPU instance to create on a device
+type GpuInstanceFixture struct {
+	Profile          ProfileID                  `yaml:"profile"`
+	Placement        *nvml.GpuInstancePlacement `yaml:"placement,omitempty"`
+	ComputeInstances []ComputeInstanceFixture   `yaml:"computeInstances,omitempty"`
+}
+
+// ComputeInstanceFixture describes a compute instance to create in a GPU instance
+type ComputeInstanceFixture struct {
+	Profile   ProfileID                      `yaml:"profile"`
+	Placement *nvml.ComputeInstancePlacement `yaml:"placement,omitempty"`
+}
+
+// ProfileID is a GPU or compute instance profile ID. In a fixture it is
+// either the numeric NVML ID or the profile name without its prefix, e.g.
+// "1_SLICE" for GPU_INSTANCE_PROFILE_1_SLICE.
+type ProfileID struct {
+	ID   int
+	Name string
+}
+
+// UnmarshalYAML accepts both numeric and named profile IDs
+func (p *ProfileID) UnmarshalYAML(value *yaml.Node) error {
+	if id, err := strconv.Atoi(value.Value); err == nil {
+		p.ID = id
+		return nil
+	}
+	if value.Value == "" {
+		return fmt.Errorf("line %d: empty profile", value.Line)
+	}
+	p.ID = -1
+	p.Name = value.Value
+	return nil
+}
+
+// MarshalYAML writes named profile IDs by name and all others by number
+func (p ProfileID) MarshalYAML() (interface{}, error) {
+	if p.Name != "" {
+		return p.Name, nil
+	}
+	return p.ID, nil
+}
+
+// resolve returns the numeric profile ID, looking up named profiles in ids
+func (p ProfileID) resolve(ids map[string]int) (int, error) {
+	if p.Name == "" {
+		return p.ID, nil
+	}
+	id, exists := ids[p.Name]
+	if !exists {
+		return 0, fmt.Errorf("unknown profile %q", p.Name)
+	}
+	return id, nil
+}
+
+var gpuInstanceProfileIDs = map[string]int{
+	"1_SLICE":        nvml.GPU_INSTANCE_PROFILE_1_SLICE,
+	"2_SLICE":        nvml.GPU_INSTANCE_PROFILE_2_SLICE,
+	"3_SLICE":        nvml.GPU_INSTANCE_PROFILE_3_SLICE,
+	"4_SLICE":        nvml.GPU_INSTANCE_PROFILE_4_SLICE,
+	"7_SLICE":        nvml.GPU_INSTANCE_PROFILE_7_SLICE,
+	"8_SLICE":        nvml.GPU_INSTANCE_PROFILE_8_SLICE,
+	"6_SLICE":        nvml.GPU_INSTANCE_PROFILE_6_SLICE,
+	"1_SLICE_REV1":   nvml.GPU_INSTANCE_PROFILE_1_SLICE_REV1,
+	"2_SLICE_REV1":   nvml.GPU_INSTANCE_PROFILE_2_SLICE_REV1,
+	"1_SLICE_REV2":   nvml.GPU_INSTANCE_PROFILE_1_SLICE_REV2,
+	"1_SLICE_GFX":    nvml.GPU_INSTANCE_PROFILE_1_SLICE_GFX,
+	"2_SLICE_GFX":    nvml.GPU_INSTANCE_PROFILE_2_SLICE_GFX,
+	"4_SLICE_GFX":    nvml.GPU_INSTANCE_PROFILE_4_SLICE_GFX,
+	"1_SLICE_NO_ME":  nvml.GPU_INSTANCE_PROFILE_1_SLICE_NO_ME,
+	"2_SLICE_NO_ME":  nvml.GPU_INSTANCE_PROFILE_2_SLICE_NO_ME,
+	"1_SLICE_ALL_ME": nvml.GPU_INSTANCE_PROFILE_1_SLICE_ALL_ME,
+	"2_SLICE_ALL_ME": nvml.GPU_INSTANCE_PROFILE_2_SLICE_ALL_ME,
+	"3_SLICE_GFX":    nvml.GPU_INSTANCE_PROFILE_3_SLICE_GFX,
+}
+
+var computeInstanceProfileIDs = map[string]int{
+	"1_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE,
+	"2_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE,
+	"3_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_3_SLICE,
+	"4_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_4_SLICE,
+	"7_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_7_SLICE,
+	"8_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_8_SLICE,
+	"6_SLICE":      nvml.COMPUTE_INSTANCE_PROFILE_6_SLICE,
+	"1_SLICE_REV1": nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE_REV1,
+	"7_SLICE_NVL":  nvml.COMPUTE_INSTANCE_PROFILE_7_SLICE_NVL,
+}
+
+// LoadFixture reads and validates a fixture from the specified file
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading fixture: %w", err)
+	}
+	fixture, err := ParseFixture(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// ParseFixture parses and validates a YAML or JSON fixture
+func ParseFixture(data []byte) (*Fixture, error) {
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return nil, err
+	}
+	if err := fixture.validate(); err != nil {
+		return nil, err
+	}
+	return &fixture, nil
+}
+
+func (f *Fixture) validate() error {
+	if f.Version != FixtureVersion {
+		return fmt.Errorf("unsupported fixture version %q (expected %q)", f.Version, FixtureVersion)
+	}
+	devices := 0
+	for i, gpu := range f.GPUs {
+		devices += gpu.Count
+		if gpu.Count == 0 {
+			devices++
+		}
+		if (gpu.Model == "") == (gpu.Config == nil) {
+			return fmt.Errorf("gpus[%d]: exactly one of model or config must be set", i)
+		}
+		if gpu.Model != "" {
+			if _, exists := gpus.Lookup(gpu.Model); !exists {
+				return fmt.Errorf("gpus[%d]: unknown model %q (known models: %v)", i, gpu.Model, gpus.Names())
+			}
+		}
+		if gpu.Count < 0 {
+			return fmt.Errorf("gpus[%d]: invalid count %d", i, gpu.Count)
+		}
+		if gpu.Count > 1 && (gpu.UUID != "" || gpu.PciBusID != "") {
+			return fmt.Errorf("gpus[%d]: uuid and pciBusId require a count of 1", i)
+		}
+		if len(gpu.GpuInstances) > 0 && gpu.MigMode != nvml.DEVICE_MIG_ENABLE {
+			return fmt.Errorf("gpus[%d]: gpuInstances require migMode %d", i, nvml.DEVICE_MIG_ENABLE)
+		}
+	}
+	if f.Topology != nil {
+		if len(f.Topology) != devices {
+			return fmt.Errorf("topology: expected %d rows, got %d", devices, len(f.Topology))
+		}
+		for i, row := range f.Topology {
+			if len(row) != devices {
+				return fmt.Errorf("topology[%d]: expected %d columns, got %d", i, devices, len(row))
+			}
+		}
+	}
+	return nil
+}
+
+// deviceFixture holds the per-device state applied after a device is created
+type deviceFixture struct {
+	UUID         string
+	PciBusID     string
+	MigMode      int
+	GpuInstances []GpuInstanceFixture
+}
+
+// WithFixture configures the server from the specified fixture. Options
+// following WithFixture override the values set by the fixture. The driver,
+// NVML and CUDA versions of the fixture are only applied if they were not set
+// by an earlier option.
+func WithFixture(f *Fixture) Option {
+	return func(o *options) error {
+		if err := f.validate(); err != nil {
+			return err
+		}
+		o.gpus = nil
+		o.fixtures = nil
+		for _, gpu := range f.GPUs {
+			config := gpu.Config
+			if config == nil {
+				c, _ := gpus.Lookup(gpu.Model)
+				config = &c
+			}
+			count := gpu.Count
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				o.gpus = append(o.gpus, *config)
+				o.fixtures = append(o.fixtures, &deviceFixture{
+					UUID:         gpu.UUID,
+					PciBusID:     gpu.PciBusID,
+					MigMode:      gpu.MigMode,
+					GpuInstances: gpu.GpuInstances,
+				})
+			}
+		}
+		o.topologyLevels = f.Topology
+		if o.DriverVersion == "" {
+			o.DriverVersion = f.DriverVersion
+		}
+		if o.NvmlVersion == "" {
+			o.NvmlVersion = f.NvmlVersion
+		}
+		if o.CudaDriverVersion == 0 {
+			o.CudaDriverVersion = f.CudaDriverVersion
+		}
+		return nil
+	}
+}
+
+// WithConfigFile configures the server from the fixture in the specified file
+func WithConfigFile(path string) Option {
+	return func(o *options) error {
+		f, err := LoadFixture(path)
+		if err != nil {
+			return err
+		}
+		return WithFixture(f)(o)
+	}
+}
+
+// NewFromFile creates a new server from the fixture in the specified file
+func NewFromFile(path string, opts ...Option) (*Server, error) {
+	return New(append([]Option{WithConfigFile(path)}, opts...)...)
+}
+
+// apply sets the fixture state on the device, creating any GPU and compute
+// instances through the regular mock functions.
+func (f *deviceFixture) apply(d *Device) error {
+	if f.UUID != "" {
+		d.UUID = f.UUID
+	}
+	if f.PciBusID != "" {
+		d.PciBusID = f.PciBusID
+	}
+	d.MigMode = f.MigMode
+
+	for i, gif := range f.GpuInstances {
+		if err := gif.create(d); err != nil {
+			return fmt.Errorf("device %d: gpuInstances[%d]: %w", d.Index, i, err)
+		}
+	}
+	return nil
+}
+
+func (gif *GpuInstanceFixture) create(d *Device) error {
+	id, err := gif.Profile.resolve(gpuInstanceProfileIDs)
+	if err != nil {
+		return err
+	}
+	info, ret := d.GetGpuInstanceProfileInfo(id)
+	if ret != nvml.SUCCESS {
+		return fmt.Errorf("error getting GPU instance profile %d: %v", id, ret)
+	}
+
+	var gi nvml.GpuInstance
+	if gif.Placement != nil {
+		gi, ret = d.CreateGpuInstanceWithPlacement(&info, gif.Placement)
+	} else {
+		gi, ret = d.CreateGpuInstance(&info)
+	}
+	if ret != nvml.SUCCESS {
+		return fmt.Errorf("error creating GPU instance with profile %d: %v", id, ret)
+	}
+
+	for i, cif := range gif.ComputeInstances {
+		id, err := cif.Profile.resolve(computeInstanceProfileIDs)
+		if err != nil {
+			return fmt.Errorf("computeInstances[%d]: %w", i, err)
+		}
+		info, ret := gi.GetComputeInstanceProfileInfo(id, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+		if ret != nvml.SUCCESS {
+			return fmt.Errorf("computeInstances[%d]: error getting compute instance profile %d: %v", i, id, ret)
+		}
+		if cif.Placement != nil {
+			_, ret = gi.CreateComputeInstanceWithPlacement(&info, cif.Placement)
+		} else {
+			_, ret = gi.CreateComputeInstance(&info)
+		}
+		if ret != nvml.SUCCESS {
+			return fmt.Errorf("computeInstances[%d]: error creating compute instance with profile %d: %v", i, id, ret)
+		}
+	}
+	return nil
+}
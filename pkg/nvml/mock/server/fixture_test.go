@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+const testFixture = `
+version: v1
+driverVersion: "550.54.15"
+nvmlVersion: "12.550.54.15"
+cudaDriverVersion: 12040
+gpus:
+- model: H100_SXM5_80GB
+  uuid: GPU-11111111-2222-3333-4444-555555555555
+  pciBusId: "0000:1b:00.0"
+  migMode: 1
+  gpuInstances:
+  - profile: 3_SLICE
+    placement: {start: 4, size: 3}
+    computeInstances:
+    - profile: 3_SLICE
+  - profile: 0
+    computeInstances:
+    - profile: 1_SLICE
+- model: A100_SXM4_40GB
+  count: 2
+- config:
+    name: Custom GPU
+    architecture: 7
+    memoryMB: 1024
+    cudaMajor: 8
+`
+
+func writeFixture(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "fixture.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestNewFromFile(t *testing.T) {
+	s, err := NewFromFile(writeFixture(t, testFixture))
+	require.NoError(t, err)
+
+	version, ret := s.SystemGetDriverVersion()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "550.54.15", version)
+
+	count, ret := s.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 4, count)
+
+	device, ret := s.DeviceGetHandleByUUID("GPU-11111111-2222-3333-4444-555555555555")
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, s.Devices[0], device)
+
+	device, ret = s.DeviceGetHandleByPciBusId("0000:1b:00.0")
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, s.Devices[0], device)
+
+	current, _, ret := device.GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_ENABLE, current)
+
+	profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gis, ret := device.GetGpuInstances(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, gis, 1)
+
+	info, ret := gis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GpuInstancePlacement{Start: 4, Size: 3}, info.Placement)
+	require.Len(t, gis[0].(*GpuInstance).ComputeInstances, 1)
+
+	name, ret := s.Devices[1].GetName()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "Mock NVIDIA A100-SXM4-40GB", name)
+
+	name, ret = s.Devices[3].GetName()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "Custom GPU", name)
+
+	memory, ret := s.Devices[3].GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1024*1024*1024), memory.Total)
+
+	major, _, ret := s.Devices[3].GetCudaComputeCapability()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 8, major)
+}
+
+func TestInlineMIGProfiles(t *testing.T) {
+	const inline = `
+version: v1
+gpus:
+- config:
+    name: Custom MIG GPU
+    migProfiles:
+      gpuInstanceProfiles:
+        0:
+          id: 0
+          isP2pSupported: 1
+          sliceCount: 1
+          instanceCount: 7
+          multiprocessorCount: 16
+          memorySizeMB: 4864
+      computeInstanceProfiles:
+        0:
+          0:
+            id: 0
+            sliceCount: 1
+            instanceCount: 1
+            sharedCopyEngineCount: 1
+      gpuInstancePlacements:
+        0:
+        - {start: 0, size: 1}
+      computeInstancePlacements:
+        0:
+          0:
+          - {start: 0, size: 1}
+`
+	fixture, err := ParseFixture([]byte(inline))
+	require.NoError(t, err)
+
+	profiles := fixture.GPUs[0].Config.MIGProfiles
+	require.Equal(t, nvml.GpuInstanceProfileInfo{
+		Id:                  0,
+		IsP2pSupported:      1,
+		SliceCount:          1,
+		InstanceCount:       7,
+		MultiprocessorCount: 16,
+		MemorySizeMB:        4864,
+	}, profiles.GpuInstanceProfiles[0])
+	require.Equal(t, nvml.ComputeInstanceProfileInfo{
+		SliceCount:            1,
+		InstanceCount:         1,
+		SharedCopyEngineCount: 1,
+	}, profiles.ComputeInstanceProfiles[0][0])
+	require.Equal(t, []nvml.GpuInstancePlacement{{Start: 0, Size: 1}}, profiles.GpuInstancePlacements[0])
+	require.Equal(t, []nvml.ComputeInstancePlacement{{Start: 0, Size: 1}}, profiles.ComputeInstancePlacements[0][0])
+
+	// The profiles are written back with the same camelCase keys
+	data, err := yaml.Marshal(fixture)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "sliceCount: 1")
+	require.Contains(t, string(data), "memorySizeMB: 4864")
+	require.NotContains(t, string(data), "slicecount")
+
+	roundTripped, err := ParseFixture(data)
+	require.NoError(t, err)
+	require.Equal(t, fixture, roundTripped)
+}
+
+func TestWithFixtureKeepsVersions(t *testing.T) {
+	fixture, err := ParseFixture([]byte(testFixture))
+	require.NoError(t, err)
+
+	s, err := New(
+		WithDriverVersion("560.28.03"),
+		WithCUDADriverVersion(12060),
+		WithFixture(fixture),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "560.28.03", s.DriverVersion)
+	require.Equal(t, "12.550.54.15", s.NvmlVersion)
+	require.Equal(t, 12060, s.CudaDriverVersion)
+}
+
+func TestNewFromFileJSON(t *testing.T) {
+	s, err := NewFromFile(
+		writeFixture(t, `{"version": "v1", "gpus": [{"model": "B200_SXM5_180GB", "count": 8}]}`),
+		WithDriverVersion("560.28.03"),
+	)
+	require.NoError(t, err)
+	require.Len(t, s.Devices, 8)
+	require.Equal(t, "560.28.03", s.DriverVersion)
+}
+
+func TestParseFixtureErrors(t *testing.T) {
+	testCases := []struct {
+		description string
+		fixture     string
+	}{
+		{"missing version", `gpus: [{model: H100_SXM5_80GB}]`},
+		{"unknown version", `{version: v0, gpus: [{model: H100_SXM5_80GB}]}`},
+		{"unknown model", `{version: v1, gpus: [{model: H1000}]}`},
+		{"model and config", `{version: v1, gpus: [{model: H100_SXM5_80GB, config: {name: foo}}]}`},
+		{"uuid with count", `{version: v1, gpus: [{model: H100_SXM5_80GB, count: 2, uuid: GPU-0}]}`},
+		{"instances without MIG", `{version: v1, gpus: [{model: H100_SXM5_80GB, gpuInstances: [{profile: 0}]}]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := ParseFixture([]byte(tc.fixture))
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewFromFile(writeFixture(t, `{version: v1, gpus: [{model: H100_SXM5_80GB, migMode: 1, gpuInstances: [{profile: FOO}]}]}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,358 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// GpmActivity describes the activity of a device or GPU instance from which
+// GPM counters are derived. Utilizations are in percent and bandwidths in
+// MiB/s. Metrics without a generator are reported as zero.
+type GpmActivity struct {
+	// GraphicsUtil defaults to SMUtil if not set
+	GraphicsUtil Generator
+	SMUtil       Generator
+	SMOccupancy  Generator
+	IntegerUtil  Generator
+	// TensorUtil is reported as both the any and the HMMA tensor utilization
+	TensorUtil Generator
+	DramBwUtil Generator
+	FP64Util   Generator
+	FP32Util   Generator
+	FP16Util   Generator
+	PCIeTx     Generator
+	PCIeRx     Generator
+	// NVLinkTx and NVLinkRx are the totals over all NVLinks of the device
+	NVLinkTx Generator
+	NVLinkRx Generator
+}
+
+// gpmCounter identifies a counter accumulated in GPM samples
+type gpmCounter int
+
+const (
+	gpmGraphics gpmCounter = iota
+	gpmSM
+	gpmSMOccupancy
+	gpmInteger
+	gpmTensor
+	gpmDram
+	gpmFP64
+	gpmFP32
+	gpmFP16
+	gpmPCIeTx
+	gpmPCIeRx
+	gpmNVLinkTx
+	gpmNVLinkRx
+	gpmCounterCount
+)
+
+// gpmCounters holds the integral of each activity over time
+type gpmCounters [gpmCounterCount]float64
+
+// gpmMetricCounters maps GPM metrics to the counters they are computed from
+var gpmMetricCounters = map[nvml.GpmMetricId]gpmCounter{
+	nvml.GPM_METRIC_GRAPHICS_UTIL:           gpmGraphics,
+	nvml.GPM_METRIC_SM_UTIL:                 gpmSM,
+	nvml.GPM_METRIC_SM_OCCUPANCY:            gpmSMOccupancy,
+	nvml.GPM_METRIC_INTEGER_UTIL:            gpmInteger,
+	nvml.GPM_METRIC_ANY_TENSOR_UTIL:         gpmTensor,
+	nvml.GPM_METRIC_HMMA_TENSOR_UTIL:        gpmTensor,
+	nvml.GPM_METRIC_DRAM_BW_UTIL:            gpmDram,
+	nvml.GPM_METRIC_FP64_UTIL:               gpmFP64,
+	nvml.GPM_METRIC_FP32_UTIL:               gpmFP32,
+	nvml.GPM_METRIC_FP16_UTIL:               gpmFP16,
+	nvml.GPM_METRIC_PCIE_TX_PER_SEC:         gpmPCIeTx,
+	nvml.GPM_METRIC_PCIE_RX_PER_SEC:         gpmPCIeRx,
+	nvml.GPM_METRIC_NVLINK_TOTAL_TX_PER_SEC: gpmNVLinkTx,
+	nvml.GPM_METRIC_NVLINK_TOTAL_RX_PER_SEC: gpmNVLinkRx,
+}
+
+// generators returns the generators of the activity indexed by counter
+func (a *GpmActivity) generators() [gpmCounterCount]Generator {
+	var generators [gpmCounterCount]Generator
+	if a == nil {
+		return generators
+	}
+	generators[gpmGraphics] = a.GraphicsUtil
+	if a.GraphicsUtil == nil {
+		generators[gpmGraphics] = a.SMUtil
+	}
+	generators[gpmSM] = a.SMUtil
+	generators[gpmSMOccupancy] = a.SMOccupancy
+	generators[gpmInteger] = a.IntegerUtil
+	generators[gpmTensor] = a.TensorUtil
+	generators[gpmDram] = a.DramBwUtil
+	generators[gpmFP64] = a.FP64Util
+	generators[gpmFP32] = a.FP32Util
+	generators[gpmFP16] = a.FP16Util
+	generators[gpmPCIeTx] = a.PCIeTx
+	generators[gpmPCIeRx] = a.PCIeRx
+	generators[gpmNVLinkTx] = a.NVLinkTx
+	generators[gpmNVLinkRx] = a.NVLinkRx
+	return generators
+}
+
+// gpmState holds the counters of a device or GPU instance integrated up to offset
+type gpmState struct {
+	offset   time.Duration
+	counters gpmCounters
+}
+
+// advance integrates the activity up to the elapsed time and returns the counters
+func (s *gpmState) advance(a *GpmActivity, elapsed time.Duration) gpmCounters {
+	if elapsed < s.offset {
+		*s = gpmState{}
+	}
+	generators := a.generators()
+	for s.offset < elapsed {
+		step := energyIntegrationStep
+		if s.offset+step > elapsed {
+			step = elapsed - s.offset
+		}
+		for c, g := range generators {
+			if g == nil {
+				continue
+			}
+			s.counters[c] += (g.Value(s.offset) + g.Value(s.offset+step)) / 2 * step.Seconds()
+		}
+		s.offset += step
+	}
+	return s.counters
+}
+
+// GpmSample provides a reusable GPM sample implementation
+type GpmSample struct {
+	mock.GpmSample
+	sync.Mutex
+	device        *Device
+	gpuInstanceID uint32
+	timestamp     time.Duration
+	counters      gpmCounters
+	freed         bool
+}
+
+var _ nvml.GpmSample = (*GpmSample)(nil)
+
+// WithGpmActivity sets the GPM activity of all devices
+func WithGpmActivity(a *GpmActivity) Option {
+	return func(o *options) error {
+		o.gpmActivity = a
+		return nil
+	}
+}
+
+// WithDeviceGpmActivity sets the GPM activity of the device with the specified index
+func WithDeviceGpmActivity(index int, a *GpmActivity) Option {
+	return func(o *options) error {
+		if o.deviceGpmActivity == nil {
+			o.deviceGpmActivity = make(map[int]*GpmActivity)
+		}
+		o.deviceGpmActivity[index] = a
+		return nil
+	}
+}
+
+// gpmSupported returns whether the device supports GPM queries
+func (d *Device) gpmSupported() bool {
+	return d.Config.Architecture >= nvml.DEVICE_ARCH_HOPPER
+}
+
+// record records the current counters of the device, or of one of its GPU
+// instances, in the sample
+func (s *GpmSample) record(d *Device, gi *GpuInstance) nvml.Return {
+	s.Lock()
+	defer s.Unlock()
+	if s.freed {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	elapsed := d.elapsed()
+	s.device, s.gpuInstanceID, s.timestamp = d, noInstanceID, elapsed
+	if gi == nil {
+		s.counters = d.gpm.advance(d.GpmActivity, elapsed)
+		return nvml.SUCCESS
+	}
+
+	gi.Lock()
+	defer gi.Unlock()
+	activity := gi.GpmActivity
+	if activity == nil {
+		activity = d.GpmActivity
+	}
+	s.gpuInstanceID = gi.Info.Id
+	s.counters = gi.gpm.advance(activity, elapsed)
+	return nvml.SUCCESS
+}
+
+// SetMockFuncs configures all the mock function implementations for the GPM sample
+func (s *GpmSample) SetMockFuncs() {
+	s.FreeFunc = func() nvml.Return {
+		s.Lock()
+		defer s.Unlock()
+		if s.freed {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		s.freed = true
+		return nvml.SUCCESS
+	}
+
+	s.GetFunc = func(device nvml.Device) nvml.Return {
+		return device.GpmSampleGet(s)
+	}
+
+	s.MigGetFunc = func(device nvml.Device, gpuInstanceId int) nvml.Return {
+		return device.GpmMigSampleGet(gpuInstanceId, s)
+	}
+}
+
+// setGpmFuncs configures the GPM queries of a device
+func (d *Device) setGpmFuncs() {
+	d.GpmQueryDeviceSupportFunc = func() (nvml.GpmSupport, nvml.Return) {
+		support := nvml.GpmSupport{Version: nvml.GPM_SUPPORT_VERSION}
+		if d.gpmSupported() {
+			support.IsSupportedDevice = 1
+		}
+		return support, nvml.SUCCESS
+	}
+
+	d.GpmSampleGetFunc = func(sample nvml.GpmSample) nvml.Return {
+		s, ok := sample.(*GpmSample)
+		if !ok {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		if !d.gpmSupported() {
+			return nvml.ERROR_NOT_SUPPORTED
+		}
+		return s.record(d, nil)
+	}
+
+	d.GpmMigSampleGetFunc = func(gpuInstanceId int, sample nvml.GpmSample) nvml.Return {
+		s, ok := sample.(*GpmSample)
+		if !ok {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		if !d.gpmSupported() {
+			return nvml.ERROR_NOT_SUPPORTED
+		}
+		d.RLock()
+		var gi *GpuInstance
+		for g := range d.GpuInstances {
+			if int(g.Info.Id) == gpuInstanceId {
+				gi = g
+			}
+		}
+		d.RUnlock()
+		if gi == nil {
+			return nvml.ERROR_NOT_FOUND
+		}
+		return s.record(d, gi)
+	}
+}
+
+// gpmMetricsGet computes the metrics requested in m from its two samples
+func (s *Server) gpmMetricsGet(m *nvml.GpmMetricsGetType) nvml.Return {
+	sample1, ok1 := m.Sample1.(*GpmSample)
+	sample2, ok2 := m.Sample2.(*GpmSample)
+	if !ok1 || !ok2 || m.NumMetrics > uint32(len(m.Metrics)) {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	sample1.Lock()
+	defer sample1.Unlock()
+	if sample2 != sample1 {
+		sample2.Lock()
+		defer sample2.Unlock()
+	}
+	if sample1.freed || sample2.freed || sample1.device == nil || sample1.device != sample2.device || sample1.gpuInstanceID != sample2.gpuInstanceID {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	interval := (sample2.timestamp - sample1.timestamp).Seconds()
+	if interval <= 0 {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	var nvlinks int
+	if s.Topology != nil {
+		nvlinks = len(s.Topology.nvlinks(sample1.device.Index))
+	}
+	rate := func(c gpmCounter) float64 {
+		return (sample2.counters[c] - sample1.counters[c]) / interval
+	}
+
+	for i := range m.Metrics[:m.NumMetrics] {
+		metric := &m.Metrics[i]
+		id := nvml.GpmMetricId(metric.MetricId)
+		metric.Value, metric.NvmlReturn = 0, uint32(nvml.SUCCESS)
+		if c, exists := gpmMetricCounters[id]; exists {
+			metric.Value = rate(c)
+			continue
+		}
+		if id < nvml.GPM_METRIC_NVLINK_L0_RX_PER_SEC || id > nvml.GPM_METRIC_NVLINK_L17_TX_PER_SEC {
+			metric.NvmlReturn = uint32(nvml.ERROR_NOT_SUPPORTED)
+			continue
+		}
+		// Per-link bandwidth is the total split evenly across the NVLinks of the device
+		link := int(id-nvml.GPM_METRIC_NVLINK_L0_RX_PER_SEC) / 2
+		if s.Topology == nil {
+			metric.NvmlReturn = uint32(nvml.ERROR_NOT_SUPPORTED)
+			continue
+		}
+		if link >= nvlinks {
+			continue
+		}
+		c := gpmNVLinkRx
+		if (id-nvml.GPM_METRIC_NVLINK_L0_RX_PER_SEC)%2 == 1 {
+			c = gpmNVLinkTx
+		}
+		metric.Value = rate(c) / float64(nvlinks)
+	}
+	return nvml.SUCCESS
+}
+
+// setGpmFuncs configures the server-level GPM functions
+func (s *Server) setGpmFuncs() {
+	s.GpmSampleAllocFunc = func() (nvml.GpmSample, nvml.Return) {
+		sample := &GpmSample{}
+		sample.SetMockFuncs()
+		return sample, nvml.SUCCESS
+	}
+
+	s.GpmSampleFreeFunc = func(sample nvml.GpmSample) nvml.Return {
+		return sample.Free()
+	}
+
+	s.GpmSampleGetFunc = func(d nvml.Device, sample nvml.GpmSample) nvml.Return {
+		return d.GpmSampleGet(sample)
+	}
+
+	s.GpmMigSampleGetFunc = func(d nvml.Device, gpuInstanceId int, sample nvml.GpmSample) nvml.Return {
+		return d.GpmMigSampleGet(gpuInstanceId, sample)
+	}
+
+	s.GpmQueryDeviceSupportFunc = func(d nvml.Device) (nvml.GpmSupport, nvml.Return) {
+		return d.GpmQueryDeviceSupport()
+	}
+
+	s.GpmMetricsGetFunc = s.gpmMetricsGet
+}
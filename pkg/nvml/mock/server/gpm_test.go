@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+// getGpmMetrics computes the specified metrics from two samples
+func getGpmMetrics(t *testing.T, s *Server, sample1, sample2 nvml.GpmSample, ids ...nvml.GpmMetricId) []nvml.GpmMetric {
+	m := nvml.GpmMetricsGetType{
+		NumMetrics: uint32(len(ids)),
+		Sample1:    sample1,
+		Sample2:    sample2,
+	}
+	for i, id := range ids {
+		m.Metrics[i].MetricId = uint32(id)
+	}
+	require.Equal(t, nvml.SUCCESS, s.GpmMetricsGet(&m))
+	return m.Metrics[:len(ids)]
+}
+
+// TestGpmMetrics follows the flow of examples/gpm-metrics
+func TestGpmMetrics(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	s, err := New(
+		WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		WithClock(clock.Now),
+		WithTopology(&Topology{NVSwitches: 4, NVLinksPerDevice: 18}),
+		WithGpmActivity(&GpmActivity{
+			SMUtil:      Constant(80),
+			SMOccupancy: Constant(40),
+			TensorUtil:  Sine{Min: 0, Max: 100, Period: 2 * time.Second},
+			NVLinkTx:    Constant(180000),
+		}),
+	)
+	require.NoError(t, err)
+	device := s.Devices[0]
+
+	support, ret := device.GpmQueryDeviceSupport()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(1), support.IsSupportedDevice)
+
+	sample1, ret := s.GpmSampleAlloc()
+	require.Equal(t, nvml.SUCCESS, ret)
+	defer func() {
+		require.Equal(t, nvml.SUCCESS, sample1.Free())
+	}()
+	sample2, ret := s.GpmSampleAlloc()
+	require.Equal(t, nvml.SUCCESS, ret)
+	defer func() {
+		require.Equal(t, nvml.SUCCESS, sample2.Free())
+	}()
+
+	require.Equal(t, nvml.SUCCESS, device.GpmSampleGet(sample1))
+	clock.now = clock.now.Add(4 * time.Second)
+	require.Equal(t, nvml.SUCCESS, device.GpmSampleGet(sample2))
+
+	metrics := getGpmMetrics(t, s, sample1, sample2,
+		nvml.GPM_METRIC_GRAPHICS_UTIL,
+		nvml.GPM_METRIC_SM_OCCUPANCY,
+		nvml.GPM_METRIC_ANY_TENSOR_UTIL,
+		nvml.GPM_METRIC_DRAM_BW_UTIL,
+		nvml.GPM_METRIC_NVLINK_TOTAL_TX_PER_SEC,
+		nvml.GPM_METRIC_NVLINK_L3_TX_PER_SEC,
+		nvml.GPM_METRIC_NVLINK_L3_RX_PER_SEC,
+		nvml.GPM_METRIC_NVDEC_0_UTIL,
+	)
+	for _, metric := range metrics[:7] {
+		require.Equal(t, uint32(nvml.SUCCESS), metric.NvmlReturn)
+	}
+	require.Equal(t, 80.0, metrics[0].Value)
+	require.Equal(t, 40.0, metrics[1].Value)
+	require.InDelta(t, 50.0, metrics[2].Value, 0.5)
+	require.Equal(t, 0.0, metrics[3].Value)
+	require.Equal(t, 180000.0, metrics[4].Value)
+	require.Equal(t, 10000.0, metrics[5].Value)
+	require.Equal(t, 0.0, metrics[6].Value)
+	require.Equal(t, uint32(nvml.ERROR_NOT_SUPPORTED), metrics[7].NvmlReturn)
+
+	// Samples must be taken in order on the same device
+	m := nvml.GpmMetricsGetType{NumMetrics: 1, Sample1: sample2, Sample2: sample1}
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, s.GpmMetricsGet(&m))
+	require.Equal(t, nvml.SUCCESS, s.Devices[1].GpmSampleGet(sample1))
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, s.GpmMetricsGet(&m))
+}
+
+func TestGpmMigMetrics(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	s, err := New(
+		WithGPUs(gpus.H100_SXM5_80GB),
+		WithClock(clock.Now),
+		WithGpmActivity(&GpmActivity{SMUtil: Constant(30)}),
+	)
+	require.NoError(t, err)
+	device := s.Devices[0]
+
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi0, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi1, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi1.(*GpuInstance).GpmActivity = &GpmActivity{SMUtil: Constant(90)}
+
+	sample := func(gi nvml.GpuInstance) nvml.GpmSample {
+		info, ret := gi.GetInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		sample, ret := s.GpmSampleAlloc()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.SUCCESS, s.GpmMigSampleGet(device, int(info.Id), sample))
+		return sample
+	}
+
+	gi0Sample1, gi1Sample1 := sample(gi0), sample(gi1)
+	clock.now = clock.now.Add(time.Second)
+	gi0Sample2, gi1Sample2 := sample(gi0), sample(gi1)
+
+	require.Equal(t, 30.0, getGpmMetrics(t, s, gi0Sample1, gi0Sample2, nvml.GPM_METRIC_SM_UTIL)[0].Value)
+	require.Equal(t, 90.0, getGpmMetrics(t, s, gi1Sample1, gi1Sample2, nvml.GPM_METRIC_SM_UTIL)[0].Value)
+
+	m := nvml.GpmMetricsGetType{NumMetrics: 1, Sample1: gi0Sample1, Sample2: gi1Sample2}
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, s.GpmMetricsGet(&m))
+	require.Equal(t, nvml.ERROR_NOT_FOUND, s.GpmMigSampleGet(device, 7, gi0Sample1))
+}
+
+func TestGpmNotSupported(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB))
+	require.NoError(t, err)
+
+	support, ret := s.GpmQueryDeviceSupport(s.Devices[0])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(0), support.IsSupportedDevice)
+
+	sample, ret := s.GpmSampleAlloc()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, s.GpmSampleGet(s.Devices[0], sample))
+	require.Equal(t, nvml.SUCCESS, s.GpmSampleFree(sample))
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, s.GpmSampleFree(sample))
+}
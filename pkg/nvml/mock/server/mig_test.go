@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestGpuInstanceRequiresMigMode(t *testing.T) {
+	device := NewDeviceFromConfig(gpus.A100_SXM4_40GB, 0)
+
+	profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	_, ret = device.CreateGpuInstance(&profile)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	_, ret = device.GetGpuInstanceRemainingCapacity(&profile)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	_, ret = device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	gi, ret := device.CreateGpuInstance(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// MIG mode cannot be disabled while GPU instances exist
+	_, ret = device.SetMigMode(nvml.DEVICE_MIG_DISABLE)
+	require.Equal(t, nvml.ERROR_IN_USE, ret)
+
+	require.Equal(t, nvml.SUCCESS, gi.Destroy())
+	_, ret = device.SetMigMode(nvml.DEVICE_MIG_DISABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+}
+
+func TestGpuInstanceCapacity(t *testing.T) {
+	device := NewDeviceFromConfig(gpus.A100_SXM4_40GB, 0)
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	oneSlice, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	threeSlice, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	sevenSlice, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_7_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	capacity, ret := device.GetGpuInstanceRemainingCapacity(&oneSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 7, capacity)
+
+	// A 3-slice instance occupies the memory slices 4-7
+	gi, ret := device.CreateGpuInstanceWithPlacement(&threeSlice, &nvml.GpuInstancePlacement{Start: 4, Size: 4})
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	capacity, ret = device.GetGpuInstanceRemainingCapacity(&oneSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 4, capacity)
+
+	capacity, ret = device.GetGpuInstanceRemainingCapacity(&sevenSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 0, capacity)
+
+	_, ret = device.CreateGpuInstance(&sevenSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	// Overlapping and invalid placements are rejected
+	_, ret = device.CreateGpuInstanceWithPlacement(&oneSlice, &nvml.GpuInstancePlacement{Start: 5, Size: 1})
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+	_, ret = device.CreateGpuInstanceWithPlacement(&oneSlice, &nvml.GpuInstancePlacement{Start: 0, Size: 2})
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	// The remaining slices are allocated in order until they are exhausted
+	for i := 0; i < 4; i++ {
+		gi, ret := device.CreateGpuInstance(&oneSlice)
+		require.Equal(t, nvml.SUCCESS, ret)
+		info, ret := gi.GetInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.GpuInstancePlacement{Start: uint32(i), Size: 1}, info.Placement)
+	}
+	_, ret = device.CreateGpuInstance(&oneSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	// Destroying an instance releases its slices
+	require.Equal(t, nvml.SUCCESS, gi.Destroy())
+	capacity, ret = device.GetGpuInstanceRemainingCapacity(&oneSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 3, capacity)
+}
+
+func TestGpuInstanceDestroyInUse(t *testing.T) {
+	device := NewDeviceFromConfig(gpus.H100_SXM5_80GB, 0)
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_2_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	info, ret := gi.GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	found, ret := device.GetGpuInstanceById(int(info.Id))
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, gi, found)
+
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ci, ret := gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	require.Equal(t, nvml.ERROR_IN_USE, gi.Destroy())
+	require.Equal(t, nvml.SUCCESS, ci.Destroy())
+	require.Equal(t, nvml.SUCCESS, gi.Destroy())
+
+	_, ret = device.GetGpuInstanceById(int(info.Id))
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+}
+
+func TestComputeInstanceCapacity(t *testing.T) {
+	device := NewDeviceFromConfig(gpus.H100_SXM5_80GB, 0)
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_2_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	oneSlice, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	twoSlice, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// Profiles that are not valid for the GPU instance are rejected
+	sevenSlice := nvml.ComputeInstanceProfileInfo{Id: nvml.COMPUTE_INSTANCE_PROFILE_7_SLICE, SliceCount: 7, InstanceCount: 1}
+	_, ret = gi.CreateComputeInstance(&sevenSlice)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	capacity, ret := gi.GetComputeInstanceRemainingCapacity(&oneSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, capacity)
+
+	// A 2-slice compute instance uses all the slices of the GPU instance
+	ci, ret := gi.CreateComputeInstance(&twoSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = gi.CreateComputeInstance(&twoSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+	_, ret = gi.CreateComputeInstance(&oneSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+	capacity, ret = gi.GetComputeInstanceRemainingCapacity(&oneSlice)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 0, capacity)
+
+	// Destroying the compute instance releases its slices
+	require.Equal(t, nvml.SUCCESS, ci.Destroy())
+	for i := 0; i < 2; i++ {
+		_, ret = gi.CreateComputeInstance(&oneSlice)
+		require.Equal(t, nvml.SUCCESS, ret)
+	}
+	_, ret = gi.CreateComputeInstance(&oneSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+}
+
+func TestComputeInstancePlacement(t *testing.T) {
+	device := NewDeviceFromConfig(gpus.H100_SXM5_80GB, 0)
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&profile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	oneSlice, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	twoSlice, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	ci, ret := gi.CreateComputeInstanceWithPlacement(&oneSlice, &nvml.ComputeInstancePlacement{Start: 1, Size: 1})
+	require.Equal(t, nvml.SUCCESS, ret)
+	info, ret := ci.GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.ComputeInstancePlacement{Start: 1, Size: 1}, info.Placement)
+
+	// Overlapping and invalid placements are rejected
+	_, ret = gi.CreateComputeInstanceWithPlacement(&oneSlice, &nvml.ComputeInstancePlacement{Start: 1, Size: 1})
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+	_, ret = gi.CreateComputeInstanceWithPlacement(&oneSlice, &nvml.ComputeInstancePlacement{Start: 3, Size: 1})
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+	_, ret = gi.CreateComputeInstanceWithPlacement(&oneSlice, nil)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	// The only 2-slice placement overlaps the existing compute instance
+	_, ret = gi.CreateComputeInstance(&twoSlice)
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	// The remaining slices are allocated in order
+	for _, start := range []uint32{0, 2} {
+		ci, ret := gi.CreateComputeInstance(&oneSlice)
+		require.Equal(t, nvml.SUCCESS, ret)
+		info, ret := ci.GetInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.ComputeInstancePlacement{Start: start, Size: 1}, info.Placement)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// MigDevice provides a reusable MIG device implementation. A MIG device is
+// the device handle through which a compute instance is accessed.
+type MigDevice struct {
+	mock.Device
+	UUID            string
+	ComputeInstance *ComputeInstance
+}
+
+var _ nvml.Device = (*MigDevice)(nil)
+
+// NewMigDeviceFromComputeInstance creates a new MIG device for the provided compute instance
+func NewMigDeviceFromComputeInstance(ci *ComputeInstance) *MigDevice {
+	md := &MigDevice{
+		UUID:            "MIG-" + uuid.New().String(),
+		ComputeInstance: ci,
+	}
+	md.SetMockFuncs()
+	return md
+}
+
+// parent returns the GPU device that the MIG device belongs to
+func (md *MigDevice) parent() *Device {
+	return md.ComputeInstance.Info.Device.(*Device)
+}
+
+// gpuInstance returns the GPU instance that the MIG device belongs to
+func (md *MigDevice) gpuInstance() *GpuInstance {
+	return md.ComputeInstance.Info.GpuInstance.(*GpuInstance)
+}
+
+// SetMockFuncs configures all the mock function implementations for the MIG device
+func (md *MigDevice) SetMockFuncs() {
+	md.setProcessFuncs()
+
+	md.IsMigDeviceHandleFunc = func() (bool, nvml.Return) {
+		return true, nvml.SUCCESS
+	}
+
+	md.GetDeviceHandleFromMigDeviceHandleFunc = func() (nvml.Device, nvml.Return) {
+		return md.parent(), nvml.SUCCESS
+	}
+
+	md.GetGpuInstanceIdFunc = func() (int, nvml.Return) {
+		return int(md.gpuInstance().Info.Id), nvml.SUCCESS
+	}
+
+	md.GetComputeInstanceIdFunc = func() (int, nvml.Return) {
+		return int(md.ComputeInstance.Info.Id), nvml.SUCCESS
+	}
+
+	md.GetUUIDFunc = func() (string, nvml.Return) {
+		return md.UUID, nvml.SUCCESS
+	}
+
+	md.GetNameFunc = func() (string, nvml.Return) {
+		d := md.parent()
+		profile := d.Config.MIGProfiles.GpuInstanceProfiles[int(md.gpuInstance().Info.ProfileId)]
+		return fmt.Sprintf("%s MIG %dg.%dgb", d.Config.Name, profile.SliceCount, (profile.MemorySizeMB+GBtoMB-1)/GBtoMB), nvml.SUCCESS
+	}
+
+	md.GetMemoryInfoFunc = func() (nvml.Memory, nvml.Return) {
+		profile := md.parent().Config.MIGProfiles.GpuInstanceProfiles[int(md.gpuInstance().Info.ProfileId)]
+		memory := nvml.Memory{Total: profile.MemorySizeMB * 1024 * 1024, Used: md.usedMemory()}
+		memory.Free = memory.Total - memory.Used
+		return memory, nvml.SUCCESS
+	}
+
+	md.GetMinorNumberFunc = func() (int, nvml.Return) {
+		return md.parent().GetMinorNumber()
+	}
+
+	md.GetArchitectureFunc = func() (nvml.DeviceArchitecture, nvml.Return) {
+		return md.parent().GetArchitecture()
+	}
+
+	md.GetBrandFunc = func() (nvml.BrandType, nvml.Return) {
+		return md.parent().GetBrand()
+	}
+
+	md.GetCudaComputeCapabilityFunc = func() (int, int, nvml.Return) {
+		return md.parent().GetCudaComputeCapability()
+	}
+
+	md.GetIndexFunc = func() (int, nvml.Return) {
+		return md.parent().GetIndex()
+	}
+
+	md.GetPciInfoFunc = func() (nvml.PciInfo, nvml.Return) {
+		return md.parent().GetPciInfo()
+	}
+
+	md.GetMaxMigDeviceCountFunc = func() (int, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+
+	md.GetMigDeviceHandleByIndexFunc = func(int) (nvml.Device, nvml.Return) {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+}
+
+// setMigDeviceFuncs configures the MIG device queries of a GPU device
+func (d *Device) setMigDeviceFuncs() {
+	d.IsMigDeviceHandleFunc = func() (bool, nvml.Return) {
+		return false, nvml.SUCCESS
+	}
+
+	d.GetDeviceHandleFromMigDeviceHandleFunc = func() (nvml.Device, nvml.Return) {
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.GetGpuInstanceIdFunc = func() (int, nvml.Return) {
+		return 0, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.GetComputeInstanceIdFunc = func() (int, nvml.Return) {
+		return 0, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.GetMaxMigDeviceCountFunc = func() (int, nvml.Return) {
+		count := 0
+		for _, profile := range d.Config.MIGProfiles.GpuInstanceProfiles {
+			if int(profile.InstanceCount) > count {
+				count = int(profile.InstanceCount)
+			}
+		}
+		if count == 0 {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return count, nvml.SUCCESS
+	}
+
+	d.GetMigDeviceHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if d.MigMode != nvml.DEVICE_MIG_ENABLE {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		migDevices := d.migDevices()
+		if index < 0 || index >= len(migDevices) {
+			return nil, nvml.ERROR_NOT_FOUND
+		}
+		return migDevices[index], nvml.SUCCESS
+	}
+}
+
+// migDevices returns the MIG devices of all compute instances on the device,
+// ordered by GPU instance ID and compute instance ID. The caller must hold
+// the device lock.
+func (d *Device) migDevices() []*MigDevice {
+	var migDevices []*MigDevice
+	for gi := range d.GpuInstances {
+		gi.RLock()
+		for ci := range gi.ComputeInstances {
+			migDevices = append(migDevices, ci.MigDevice)
+		}
+		gi.RUnlock()
+	}
+	sort.Slice(migDevices, func(i, j int) bool {
+		a, b := migDevices[i].ComputeInstance.Info, migDevices[j].ComputeInstance.Info
+		giA, giB := a.GpuInstance.(*GpuInstance).Info.Id, b.GpuInstance.(*GpuInstance).Info.Id
+		if giA != giB {
+			return giA < giB
+		}
+		return a.Id < b.Id
+	})
+	return migDevices
+}
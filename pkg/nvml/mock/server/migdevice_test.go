@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestMigDevices(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB))
+	require.NoError(t, err)
+	device := s.Devices[0]
+
+	count, ret := device.GetMaxMigDeviceCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 7, count)
+
+	isMig, ret := s.DeviceIsMigDeviceHandle(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.False(t, isMig)
+
+	_, ret = device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	_, ret = device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	_, ret = device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ci0, ret := gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ci1, ret := gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	for i, ci := range []nvml.ComputeInstance{ci0, ci1} {
+		migDevice, ret := device.GetMigDeviceHandleByIndex(i)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, ci.(*ComputeInstance).MigDevice, migDevice)
+
+		isMig, ret := s.DeviceIsMigDeviceHandle(migDevice)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.True(t, isMig)
+
+		giId, ret := migDevice.GetGpuInstanceId()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, 0, giId)
+
+		ciId, ret := migDevice.GetComputeInstanceId()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, i, ciId)
+
+		parent, ret := s.DeviceGetDeviceHandleFromMigDeviceHandle(migDevice)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, device, parent)
+
+		uuid, ret := migDevice.GetUUID()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.True(t, strings.HasPrefix(uuid, "MIG-"))
+
+		found, ret := s.DeviceGetHandleByUUID(uuid)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, migDevice, found)
+
+		name, ret := migDevice.GetName()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, "Mock NVIDIA A100-SXM4-40GB MIG 3g.20gb", name)
+
+		memory, ret := migDevice.GetMemoryInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, giProfile.MemorySizeMB*1024*1024, memory.Total)
+
+		// The index and PCI info are those of the parent device
+		index, ret := migDevice.GetIndex()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, 0, index)
+
+		pciInfo, ret := migDevice.GetPciInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		parentPciInfo, ret := device.GetPciInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, parentPciInfo, pciInfo)
+
+		// MIG devices cannot be nested
+		_, ret = migDevice.GetMaxMigDeviceCount()
+		require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+		_, ret = migDevice.GetMigDeviceHandleByIndex(0)
+		require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	}
+
+	_, ret = device.GetGpuInstanceId()
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	require.Equal(t, nvml.SUCCESS, ci0.Destroy())
+	migDevice, ret := device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, ci1.(*ComputeInstance).MigDevice, migDevice)
+}
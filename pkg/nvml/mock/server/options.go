@@ -5,6 +5,7 @@ import "github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
 func WithGPUs(gpus ...gpus.Config) Option {
 	return func(o *options) error {
 		o.gpus = gpus
+		o.fixtures = nil
 		return nil
 	}
 }
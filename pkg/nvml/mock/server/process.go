@@ -0,0 +1,371 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// AccountingBufferSize is the number of processes for which accounting
+// statistics are kept
+const AccountingBufferSize = 4000
+
+// ProcessType identifies the kind of GPU context that a process has. Types can
+// be combined for processes with both a compute and a graphics context.
+type ProcessType int
+
+// Process types
+const (
+	ProcessTypeCompute ProcessType = 1 << iota
+	ProcessTypeGraphics
+	ProcessTypeMPSCompute
+)
+
+// Process describes a simulated process running on a device
+type Process struct {
+	Pid  uint32
+	Name string
+	// Type defaults to ProcessTypeCompute
+	Type          ProcessType
+	UsedGpuMemory uint64
+	// GpuInstanceId and ComputeInstanceId are set when the process is started
+	GpuInstanceId     uint32
+	ComputeInstanceId uint32
+	// SmUtil, MemUtil, EncUtil and DecUtil are the utilization percentages
+	// reported for the process
+	SmUtil  uint32
+	MemUtil uint32
+	EncUtil uint32
+	DecUtil uint32
+
+	startTime time.Time
+}
+
+// accountingRecord holds the accounting statistics of a process
+type accountingRecord struct {
+	stats     nvml.AccountingStats
+	startTime time.Time
+}
+
+// StartProcess adds a process to the process table of the device. The
+// process's memory is accounted for in the memory info of the device.
+func (d *Device) StartProcess(p Process) nvml.Return {
+	p.GpuInstanceId = noInstanceID
+	p.ComputeInstanceId = noInstanceID
+	return d.startProcess(p)
+}
+
+// startProcess adds a process with the specified GPU and compute instance IDs
+// to the process table of the device
+func (d *Device) startProcess(p Process) nvml.Return {
+	if p.Type == 0 {
+		p.Type = ProcessTypeCompute
+	}
+	return d.store.do(func() nvml.Return {
+		return d.addProcess(p)
+	})
+}
+
+// addProcess adds a process to the process table of the device
+func (d *Device) addProcess(p Process) nvml.Return {
+	d.Lock()
+	defer d.Unlock()
+	if _, exists := d.Processes[p.Pid]; exists {
+		return nvml.ERROR_IN_USE
+	}
+	if p.UsedGpuMemory > d.MemoryInfo.Total-d.MemoryInfo.Used-d.usedMemory() {
+		return nvml.ERROR_MEMORY
+	}
+	p.startTime = d.telemetry.now()
+	d.Processes[p.Pid] = &p
+
+	if d.AccountingMode == nvml.FEATURE_ENABLED {
+		if len(d.accounting) >= AccountingBufferSize {
+			d.dropOldestAccountingRecord()
+		}
+		d.accounting[p.Pid] = &accountingRecord{
+			stats: nvml.AccountingStats{
+				GpuUtilization:    p.SmUtil,
+				MemoryUtilization: p.MemUtil,
+				MaxMemoryUsage:    p.UsedGpuMemory,
+				StartTime:         uint64(p.startTime.UnixMicro()),
+				IsRunning:         1,
+			},
+			startTime: p.startTime,
+		}
+	}
+	return nvml.SUCCESS
+}
+
+// StopProcess removes a process from the process table of the device. The
+// accounting statistics of the process are kept until they are cleared.
+func (d *Device) StopProcess(pid uint32) nvml.Return {
+	return d.store.do(func() nvml.Return {
+		return d.removeProcess(pid)
+	})
+}
+
+// removeProcess removes a process from the process table of the device
+func (d *Device) removeProcess(pid uint32) nvml.Return {
+	d.Lock()
+	defer d.Unlock()
+	if _, exists := d.Processes[pid]; !exists {
+		return nvml.ERROR_NOT_FOUND
+	}
+	delete(d.Processes, pid)
+
+	if record, exists := d.accounting[pid]; exists && record.stats.IsRunning == 1 {
+		record.stats.IsRunning = 0
+		record.stats.Time = uint64(d.telemetry.now().Sub(record.startTime).Milliseconds())
+	}
+	return nvml.SUCCESS
+}
+
+// StartProcess adds a process to the process table of the parent device,
+// attributing it to the GPU and compute instance of the MIG device
+func (md *MigDevice) StartProcess(p Process) nvml.Return {
+	p.GpuInstanceId = md.gpuInstance().Info.Id
+	p.ComputeInstanceId = md.ComputeInstance.Info.Id
+	return md.parent().startProcess(p)
+}
+
+// StopProcess removes a process from the process table of the parent device
+func (md *MigDevice) StopProcess(pid uint32) nvml.Return {
+	return md.parent().StopProcess(pid)
+}
+
+// usedMemory returns the memory used by all processes on the device. The
+// caller must hold the device lock.
+func (d *Device) usedMemory() uint64 {
+	var used uint64
+	for _, p := range d.Processes {
+		used += p.UsedGpuMemory
+	}
+	return used
+}
+
+// usedMemory returns the memory used by the processes on the MIG device
+func (md *MigDevice) usedMemory() uint64 {
+	d := md.parent()
+	d.RLock()
+	defer d.RUnlock()
+	var used uint64
+	for _, p := range d.processes(ProcessTypeCompute|ProcessTypeGraphics|ProcessTypeMPSCompute, md) {
+		used += p.UsedGpuMemory
+	}
+	return used
+}
+
+// processes returns the processes of the specified type ordered by PID. If
+// md is not nil, only the processes running on the MIG device are returned.
+// The caller must hold the device lock.
+func (d *Device) processes(t ProcessType, md *MigDevice) []*Process {
+	var processes []*Process
+	for _, p := range d.Processes {
+		if p.Type&t == 0 {
+			continue
+		}
+		if md != nil && (p.GpuInstanceId != md.gpuInstance().Info.Id || p.ComputeInstanceId != md.ComputeInstance.Info.Id) {
+			continue
+		}
+		processes = append(processes, p)
+	}
+	sort.Slice(processes, func(i, j int) bool { return processes[i].Pid < processes[j].Pid })
+	return processes
+}
+
+// processInfos returns the process infos of the specified processes
+func processInfos(processes []*Process) []nvml.ProcessInfo {
+	infos := make([]nvml.ProcessInfo, len(processes))
+	for i, p := range processes {
+		infos[i] = nvml.ProcessInfo{
+			Pid:               p.Pid,
+			UsedGpuMemory:     p.UsedGpuMemory,
+			GpuInstanceId:     p.GpuInstanceId,
+			ComputeInstanceId: p.ComputeInstanceId,
+		}
+	}
+	return infos
+}
+
+// dropOldestAccountingRecord removes the accounting record of the process
+// that was started first. The caller must hold the device lock.
+func (d *Device) dropOldestAccountingRecord() {
+	var oldest uint32
+	var found bool
+	for pid, record := range d.accounting {
+		if !found || record.startTime.Before(d.accounting[oldest].startTime) {
+			oldest, found = pid, true
+		}
+	}
+	delete(d.accounting, oldest)
+}
+
+// setProcessFuncs configures the process and accounting queries of a device
+func (d *Device) setProcessFuncs() {
+	d.GetComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeCompute, nil)), nvml.SUCCESS
+	}
+
+	d.GetGraphicsRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeGraphics, nil)), nvml.SUCCESS
+	}
+
+	d.GetMPSComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeMPSCompute, nil)), nvml.SUCCESS
+	}
+
+	d.GetProcessUtilizationFunc = func(lastSeenTimeStamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		timestamp := uint64(d.telemetry.now().UnixMicro())
+		if timestamp <= lastSeenTimeStamp {
+			return nil, nvml.ERROR_NOT_FOUND
+		}
+		var samples []nvml.ProcessUtilizationSample
+		for _, p := range d.processes(ProcessTypeCompute|ProcessTypeGraphics|ProcessTypeMPSCompute, nil) {
+			samples = append(samples, nvml.ProcessUtilizationSample{
+				Pid:       p.Pid,
+				TimeStamp: timestamp,
+				SmUtil:    p.SmUtil,
+				MemUtil:   p.MemUtil,
+				EncUtil:   p.EncUtil,
+				DecUtil:   p.DecUtil,
+			})
+		}
+		if len(samples) == 0 {
+			return nil, nvml.ERROR_NOT_FOUND
+		}
+		return samples, nvml.SUCCESS
+	}
+
+	d.GetAccountingModeFunc = func() (nvml.EnableState, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		return d.AccountingMode, nvml.SUCCESS
+	}
+
+	d.SetAccountingModeFunc = func(mode nvml.EnableState) nvml.Return {
+		if mode != nvml.FEATURE_ENABLED && mode != nvml.FEATURE_DISABLED {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		d.Lock()
+		defer d.Unlock()
+		d.AccountingMode = mode
+		if mode == nvml.FEATURE_DISABLED {
+			d.accounting = make(map[uint32]*accountingRecord)
+		}
+		return nvml.SUCCESS
+	}
+
+	d.GetAccountingBufferSizeFunc = func() (int, nvml.Return) {
+		return AccountingBufferSize, nvml.SUCCESS
+	}
+
+	d.GetAccountingPidsFunc = func() ([]int, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if d.AccountingMode != nvml.FEATURE_ENABLED {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var pids []int
+		for pid := range d.accounting {
+			pids = append(pids, int(pid))
+		}
+		sort.Ints(pids)
+		return pids, nvml.SUCCESS
+	}
+
+	d.GetAccountingStatsFunc = func(pid uint32) (nvml.AccountingStats, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if d.AccountingMode != nvml.FEATURE_ENABLED {
+			return nvml.AccountingStats{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		record, exists := d.accounting[pid]
+		if !exists {
+			return nvml.AccountingStats{}, nvml.ERROR_NOT_FOUND
+		}
+		return record.stats, nvml.SUCCESS
+	}
+
+	d.ClearAccountingPidsFunc = func() nvml.Return {
+		d.Lock()
+		defer d.Unlock()
+		if d.AccountingMode != nvml.FEATURE_ENABLED {
+			return nvml.ERROR_NOT_SUPPORTED
+		}
+		for pid, record := range d.accounting {
+			if record.stats.IsRunning == 0 {
+				delete(d.accounting, pid)
+			}
+		}
+		return nvml.SUCCESS
+	}
+}
+
+// setProcessFuncs configures the process queries of a MIG device to return
+// the processes running on it
+func (md *MigDevice) setProcessFuncs() {
+	md.GetComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d := md.parent()
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeCompute, md)), nvml.SUCCESS
+	}
+
+	md.GetGraphicsRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d := md.parent()
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeGraphics, md)), nvml.SUCCESS
+	}
+
+	md.GetMPSComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		d := md.parent()
+		d.RLock()
+		defer d.RUnlock()
+		return processInfos(d.processes(ProcessTypeMPSCompute, md)), nvml.SUCCESS
+	}
+}
+
+// systemGetProcessName returns the name of a process running on any device
+// of the server
+func (s *Server) systemGetProcessName(pid int) (string, nvml.Return) {
+	for _, d := range s.Devices {
+		device, ok := d.(*Device)
+		if !ok {
+			continue
+		}
+		device.RLock()
+		p, exists := device.Processes[uint32(pid)]
+		device.RUnlock()
+		if exists {
+			return p.Name, nvml.SUCCESS
+		}
+	}
+	return "", nvml.ERROR_NOT_FOUND
+}
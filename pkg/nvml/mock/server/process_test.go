@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+// TestComputeProcesses follows the flow of examples/compute-processes
+func TestComputeProcesses(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...))
+	require.NoError(t, err)
+
+	require.Equal(t, nvml.SUCCESS, s.Devices[1].(*Device).StartProcess(Process{Pid: 1234, Name: "python", UsedGpuMemory: 1 << 30}))
+	require.Equal(t, nvml.SUCCESS, s.Devices[1].(*Device).StartProcess(Process{Pid: 42, Name: "Xorg", Type: ProcessTypeGraphics, UsedGpuMemory: 1 << 20}))
+	require.Equal(t, nvml.SUCCESS, s.Devices[1].(*Device).StartProcess(Process{Pid: 100, Name: "nvidia-cuda-mps-server", Type: ProcessTypeMPSCompute, UsedGpuMemory: 1 << 20}))
+
+	var lib nvml.Interface = s
+	require.Equal(t, nvml.SUCCESS, lib.Init())
+	count, ret := lib.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	var processes [][]nvml.ProcessInfo
+	for di := 0; di < count; di++ {
+		device, ret := lib.DeviceGetHandleByIndex(di)
+		require.Equal(t, nvml.SUCCESS, ret)
+		processInfos, ret := device.GetComputeRunningProcesses()
+		require.Equal(t, nvml.SUCCESS, ret)
+		processes = append(processes, processInfos)
+	}
+	require.Equal(t, nvml.SUCCESS, lib.Shutdown())
+
+	require.Empty(t, processes[0])
+	require.Equal(t, []nvml.ProcessInfo{{Pid: 1234, UsedGpuMemory: 1 << 30, GpuInstanceId: 0xFFFFFFFF, ComputeInstanceId: 0xFFFFFFFF}}, processes[1])
+
+	graphics, ret := s.DeviceGetGraphicsRunningProcesses(s.Devices[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, graphics, 1)
+	require.Equal(t, uint32(42), graphics[0].Pid)
+
+	mps, ret := s.DeviceGetMPSComputeRunningProcesses(s.Devices[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, mps, 1)
+	require.Equal(t, uint32(100), mps[0].Pid)
+
+	name, ret := s.SystemGetProcessName(1234)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "python", name)
+	_, ret = s.SystemGetProcessName(1)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+
+	memory, ret := s.Devices[1].GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1<<30+2<<20), memory.Used)
+	require.Equal(t, memory.Total-memory.Used, memory.Free)
+
+	require.Equal(t, nvml.ERROR_IN_USE, s.Devices[1].(*Device).StartProcess(Process{Pid: 1234}))
+	require.Equal(t, nvml.ERROR_MEMORY, s.Devices[0].(*Device).StartProcess(Process{Pid: 1, UsedGpuMemory: memory.Total + 1}))
+
+	require.Equal(t, nvml.SUCCESS, s.Devices[1].(*Device).StopProcess(1234))
+	require.Equal(t, nvml.ERROR_NOT_FOUND, s.Devices[1].(*Device).StopProcess(1234))
+	processInfos, ret := s.Devices[1].GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, processInfos)
+}
+
+func TestProcessUtilization(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	s, err := New(WithGPUs(gpus.H100_SXM5_80GB), WithClock(clock.Now))
+	require.NoError(t, err)
+	device := s.Devices[0].(*Device)
+
+	_, ret := device.GetProcessUtilization(0)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+
+	require.Equal(t, nvml.SUCCESS, device.StartProcess(Process{Pid: 7, SmUtil: 80, MemUtil: 30}))
+	samples, ret := s.DeviceGetProcessUtilization(device, 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, samples, 1)
+	require.Equal(t, uint32(7), samples[0].Pid)
+	require.Equal(t, uint32(80), samples[0].SmUtil)
+	require.Equal(t, uint32(30), samples[0].MemUtil)
+
+	_, ret = device.GetProcessUtilization(samples[0].TimeStamp)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+}
+
+func TestAccounting(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	s, err := New(WithGPUs(gpus.H100_SXM5_80GB), WithClock(clock.Now))
+	require.NoError(t, err)
+	device := s.Devices[0].(*Device)
+
+	_, ret := s.DeviceGetAccountingPids(device)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	require.Equal(t, nvml.SUCCESS, s.DeviceSetAccountingMode(device, nvml.FEATURE_ENABLED))
+	mode, ret := s.DeviceGetAccountingMode(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.FEATURE_ENABLED, mode)
+
+	require.Equal(t, nvml.SUCCESS, device.StartProcess(Process{Pid: 20, SmUtil: 50, UsedGpuMemory: 1 << 30}))
+	require.Equal(t, nvml.SUCCESS, device.StartProcess(Process{Pid: 10, SmUtil: 90}))
+
+	pids, ret := s.DeviceGetAccountingPids(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []int{10, 20}, pids)
+
+	clock.now = clock.now.Add(3 * time.Second)
+	require.Equal(t, nvml.SUCCESS, device.StopProcess(20))
+
+	stats, ret := s.DeviceGetAccountingStats(device, 20)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(0), stats.IsRunning)
+	require.Equal(t, uint32(50), stats.GpuUtilization)
+	require.Equal(t, uint64(1<<30), stats.MaxMemoryUsage)
+	require.Equal(t, uint64(3000), stats.Time)
+	require.Equal(t, uint64(time.Unix(1700000000, 0).UnixMicro()), stats.StartTime)
+
+	stats, ret = device.GetAccountingStats(10)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(1), stats.IsRunning)
+
+	_, ret = device.GetAccountingStats(30)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+
+	require.Equal(t, nvml.SUCCESS, s.DeviceClearAccountingPids(device))
+	pids, ret = device.GetAccountingPids()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []int{10}, pids)
+}
+
+func TestMigDeviceProcesses(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB))
+	require.NoError(t, err)
+	device := s.Devices[0].(*Device)
+
+	_, ret := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	giProfile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	for i := 0; i < 2; i++ {
+		gi, ret := device.CreateGpuInstance(&giProfile)
+		require.Equal(t, nvml.SUCCESS, ret)
+		ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+		require.Equal(t, nvml.SUCCESS, ret)
+		_, ret = gi.CreateComputeInstance(&ciProfile)
+		require.Equal(t, nvml.SUCCESS, ret)
+	}
+
+	migDevice, ret := device.GetMigDeviceHandleByIndex(1)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.SUCCESS, migDevice.(*MigDevice).StartProcess(Process{Pid: 5, UsedGpuMemory: 1 << 20}))
+
+	gi, ret := migDevice.GetGpuInstanceId()
+	require.Equal(t, nvml.SUCCESS, ret)
+	ci, ret := migDevice.GetComputeInstanceId()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	processInfos, ret := migDevice.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.ProcessInfo{{Pid: 5, UsedGpuMemory: 1 << 20, GpuInstanceId: uint32(gi), ComputeInstanceId: uint32(ci)}}, processInfos)
+
+	memory, ret := migDevice.GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1<<20), memory.Used)
+
+	other, ret := device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	processInfos, ret = other.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, processInfos)
+
+	processInfos, ret = device.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, processInfos, 1)
+}
@@ -19,6 +19,8 @@ package server
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -41,14 +43,41 @@ func New(opts ...Option) (*Server, error) {
 		}
 	}
 	// TODO: Check defaults and validity
-	return o.build(), nil
+	return o.build()
 }
 
 // NewServerFromConfig creates a new server from the provided configuration
-func (o *options) build() *Server {
+func (o *options) build() (*Server, error) {
 	devices := make([]nvml.Device, len(o.gpus))
 	for i, gpu := range o.gpus {
-		devices[i] = NewDeviceFromConfig(gpu, i)
+		device := NewDeviceFromConfig(gpu, i)
+		if o.now != nil {
+			device.telemetry.now = o.now
+			device.telemetry.start = o.now()
+		}
+		for metric, g := range o.telemetry {
+			device.Telemetry[metric] = g
+		}
+		for metric, g := range o.deviceTelemetry[i] {
+			device.Telemetry[metric] = g
+		}
+		if i < len(o.fixtures) && o.fixtures[i] != nil {
+			if err := o.fixtures[i].apply(device); err != nil {
+				return nil, err
+			}
+		}
+		device.GpmActivity = o.gpmActivity
+		if a, exists := o.deviceGpmActivity[i]; exists {
+			device.GpmActivity = a
+		}
+		vgpuTypes := o.vgpuTypes
+		if types, exists := o.deviceVgpuTypes[i]; exists {
+			vgpuTypes = types
+		}
+		if err := device.SetVgpuTypes(vgpuTypes...); err != nil {
+			return nil, err
+		}
+		devices[i] = device
 	}
 
 	server := &Server{
@@ -56,9 +85,38 @@ func (o *options) build() *Server {
 		DriverVersion:     o.DriverVersion,
 		NvmlVersion:       o.NvmlVersion,
 		CudaDriverVersion: o.CudaDriverVersion,
+		TopologyLevels:    o.topologyLevels,
 	}
 	server.SetMockFuncs()
-	return server
+
+	if o.topology != nil {
+		if err := server.SetTopology(o.topology); err != nil {
+			return nil, err
+		}
+		if o.topologyLevels != nil {
+			server.TopologyLevels = o.topologyLevels
+		}
+	}
+
+	if len(o.units) > 0 {
+		if err := server.SetUnits(o.units...); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.stateFile != "" {
+		store, err := openStateStore(server, o.stateFile)
+		if err != nil {
+			return nil, err
+		}
+		server.store = store
+	}
+
+	server.installFaultInjector(o.faultSeed)
+	for _, f := range o.faults {
+		server.InjectFault(f)
+	}
+	return server, nil
 }
 
 // GBtoMB is a conversion constant from GB to MB (1 GB = 1024 MB)
@@ -67,6 +125,20 @@ const GBtoMB = 1024
 // options contains the minimal configuration needed for a server
 type options struct {
 	gpus              []gpus.Config
+	fixtures          []*deviceFixture
+	topologyLevels    [][]nvml.GpuTopologyLevel
+	topology          *Topology
+	faults            []Fault
+	stateFile         string
+	faultSeed         int64
+	telemetry         map[Metric]Generator
+	deviceTelemetry   map[int]map[Metric]Generator
+	vgpuTypes         []VgpuType
+	deviceVgpuTypes   map[int][]VgpuType
+	gpmActivity       *GpmActivity
+	deviceGpmActivity map[int]*GpmActivity
+	units             []UnitConfig
+	now               func() time.Time
 	DriverVersion     string
 	NvmlVersion       string
 	CudaDriverVersion int
@@ -80,6 +152,18 @@ type Server struct {
 	DriverVersion     string
 	NvmlVersion       string
 	CudaDriverVersion int
+	// TopologyLevels holds the common ancestor of each pair of devices,
+	// indexed by device index. Topology queries are not supported if nil.
+	TopologyLevels [][]nvml.GpuTopologyLevel
+	// Topology describes the NVLink, PCIe and NUMA topology of the server.
+	// Topology queries other than common ancestors are not supported if nil.
+	Topology *Topology
+	// Units holds the S-class units that the devices are grouped into
+	Units []nvml.Unit
+
+	faults     *faultInjector
+	faultsOnce sync.Once
+	store      *stateStore
 }
 
 // Device provides a reusable device implementation
@@ -95,6 +179,28 @@ type Device struct {
 	GpuInstances       map[*GpuInstance]struct{}
 	GpuInstanceCounter uint32
 	MemoryInfo         nvml.Memory
+	// Telemetry holds the generators of the simulated metrics of the device
+	Telemetry map[Metric]Generator
+	// SupportedEventTypes is the mask of event types that can be registered
+	SupportedEventTypes uint64
+	// Processes holds the simulated processes running on the device by PID
+	Processes      map[uint32]*Process
+	AccountingMode nvml.EnableState
+	// VgpuTypes holds the vGPU types supported by the device by type ID
+	VgpuTypes map[uint32]*VgpuTypeId
+	// VgpuInstances holds the active vGPU instances by instance ID
+	VgpuInstances         map[uint32]*VgpuInstance
+	VgpuHeterogeneousMode bool
+	// GpmActivity describes the activity reported through GPM metrics
+	GpmActivity *GpmActivity
+
+	telemetry          telemetryState
+	eventSets          map[*EventSet]uint64
+	accounting         map[uint32]*accountingRecord
+	store              *stateStore
+	faults             atomic.Pointer[faultInjector]
+	vgpuPlacementSlots uint32
+	gpm                gpmState
 }
 
 // GpuInstance provides a reusable GPU instance implementation
@@ -105,12 +211,18 @@ type GpuInstance struct {
 	ComputeInstances       map[*ComputeInstance]struct{}
 	ComputeInstanceCounter uint32
 	MIGProfiles            gpus.MIGProfileConfig
+	// GpmActivity describes the activity reported through GPM metrics for
+	// the GPU instance. The activity of the device is used if nil.
+	GpmActivity *GpmActivity
+
+	gpm gpmState
 }
 
 // ComputeInstance provides a reusable compute instance implementation
 type ComputeInstance struct {
 	mock.ComputeInstance
-	Info nvml.ComputeInstanceInfo
+	Info      nvml.ComputeInstanceInfo
+	MigDevice *MigDevice
 }
 
 // CudaComputeCapability represents CUDA compute capability
@@ -138,20 +250,30 @@ func NewServerWithGPUs(driverVersion, nvmlVersion string, cudaDriverVersion int,
 		CudaDriverVersion: cudaDriverVersion,
 	}
 	server.SetMockFuncs()
+	server.installFaultInjector(0)
 	return server
 }
 
 // NewDeviceFromConfig creates a new device from the provided GPU configuration
 func NewDeviceFromConfig(config gpus.Config, index int) *Device {
 	device := &Device{
-		Config:             config,
-		UUID:               "GPU-" + uuid.New().String(),
-		PciBusID:           fmt.Sprintf("0000:%02x:00.0", index),
-		Minor:              index,
-		Index:              index,
-		GpuInstances:       make(map[*GpuInstance]struct{}),
-		GpuInstanceCounter: 0,
-		MemoryInfo:         nvml.Memory{Total: config.MemoryMB * 1024 * 1024, Free: 0, Used: 0},
+		Config:              config,
+		UUID:                "GPU-" + uuid.New().String(),
+		PciBusID:            fmt.Sprintf("0000:%02x:00.0", index),
+		Minor:               index,
+		Index:               index,
+		GpuInstances:        make(map[*GpuInstance]struct{}),
+		GpuInstanceCounter:  0,
+		MemoryInfo:          nvml.Memory{Total: config.MemoryMB * 1024 * 1024, Free: 0, Used: 0},
+		Telemetry:           make(map[Metric]Generator),
+		SupportedEventTypes: DefaultSupportedEventTypes,
+		telemetry:           telemetryState{now: time.Now, start: time.Now()},
+		Processes:           make(map[uint32]*Process),
+		AccountingMode:      nvml.FEATURE_DISABLED,
+		eventSets:           make(map[*EventSet]uint64),
+		accounting:          make(map[uint32]*accountingRecord),
+		VgpuTypes:           make(map[uint32]*VgpuTypeId),
+		VgpuInstances:       make(map[uint32]*VgpuInstance),
 	}
 	device.SetMockFuncs()
 	return device
@@ -174,6 +296,7 @@ func NewComputeInstanceFromInfo(info nvml.ComputeInstanceInfo) *ComputeInstance
 	ci := &ComputeInstance{
 		Info: info,
 	}
+	ci.MigDevice = NewMigDeviceFromComputeInstance(ci)
 	ci.SetMockFuncs()
 	return ci
 }
@@ -193,6 +316,9 @@ func (s *Server) SetMockFuncs() {
 	}
 
 	s.ShutdownFunc = func() nvml.Return {
+		if err := s.store.Close(); err != nil {
+			return nvml.ERROR_UNKNOWN
+		}
 		return nvml.SUCCESS
 	}
 
@@ -221,9 +347,18 @@ func (s *Server) SetMockFuncs() {
 
 	s.DeviceGetHandleByUUIDFunc = func(uuid string) (nvml.Device, nvml.Return) {
 		for _, d := range s.Devices {
-			if uuid == d.(*Device).UUID {
+			device := d.(*Device)
+			if uuid == device.UUID {
 				return d, nvml.SUCCESS
 			}
+			device.RLock()
+			migDevices := device.migDevices()
+			device.RUnlock()
+			for _, md := range migDevices {
+				if uuid == md.UUID {
+					return md, nvml.SUCCESS
+				}
+			}
 		}
 		return nil, nvml.ERROR_INVALID_ARGUMENT
 	}
@@ -236,10 +371,132 @@ func (s *Server) SetMockFuncs() {
 		}
 		return nil, nvml.ERROR_INVALID_ARGUMENT
 	}
+
+	s.DeviceGetMaxMigDeviceCountFunc = func(d nvml.Device) (int, nvml.Return) {
+		return d.GetMaxMigDeviceCount()
+	}
+
+	s.DeviceGetMigDeviceHandleByIndexFunc = func(d nvml.Device, index int) (nvml.Device, nvml.Return) {
+		return d.GetMigDeviceHandleByIndex(index)
+	}
+
+	s.DeviceIsMigDeviceHandleFunc = func(d nvml.Device) (bool, nvml.Return) {
+		return d.IsMigDeviceHandle()
+	}
+
+	s.DeviceGetGpuInstanceIdFunc = func(d nvml.Device) (int, nvml.Return) {
+		return d.GetGpuInstanceId()
+	}
+
+	s.DeviceGetComputeInstanceIdFunc = func(d nvml.Device) (int, nvml.Return) {
+		return d.GetComputeInstanceId()
+	}
+
+	s.DeviceGetDeviceHandleFromMigDeviceHandleFunc = func(d nvml.Device) (nvml.Device, nvml.Return) {
+		return d.GetDeviceHandleFromMigDeviceHandle()
+	}
+
+	s.DeviceGetTemperatureFunc = func(d nvml.Device, sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+		return d.GetTemperature(sensor)
+	}
+
+	s.DeviceGetPowerUsageFunc = func(d nvml.Device) (uint32, nvml.Return) {
+		return d.GetPowerUsage()
+	}
+
+	s.DeviceGetUtilizationRatesFunc = func(d nvml.Device) (nvml.Utilization, nvml.Return) {
+		return d.GetUtilizationRates()
+	}
+
+	s.DeviceGetClockInfoFunc = func(d nvml.Device, clockType nvml.ClockType) (uint32, nvml.Return) {
+		return d.GetClockInfo(clockType)
+	}
+
+	s.DeviceGetTotalEnergyConsumptionFunc = func(d nvml.Device) (uint64, nvml.Return) {
+		return d.GetTotalEnergyConsumption()
+	}
+
+	s.DeviceGetSamplesFunc = func(d nvml.Device, samplingType nvml.SamplingType, lastSeenTimeStamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+		return d.GetSamples(samplingType, lastSeenTimeStamp)
+	}
+
+	s.EventSetCreateFunc = func() (nvml.EventSet, nvml.Return) {
+		return NewEventSet(), nvml.SUCCESS
+	}
+
+	s.EventSetWaitFunc = func(set nvml.EventSet, timeoutms uint32) (nvml.EventData, nvml.Return) {
+		return set.Wait(timeoutms)
+	}
+
+	s.EventSetFreeFunc = func(set nvml.EventSet) nvml.Return {
+		return set.Free()
+	}
+
+	s.DeviceRegisterEventsFunc = func(d nvml.Device, eventTypes uint64, set nvml.EventSet) nvml.Return {
+		return d.RegisterEvents(eventTypes, set)
+	}
+
+	s.DeviceGetSupportedEventTypesFunc = func(d nvml.Device) (uint64, nvml.Return) {
+		return d.GetSupportedEventTypes()
+	}
+
+	s.DeviceGetComputeRunningProcessesFunc = func(d nvml.Device) ([]nvml.ProcessInfo, nvml.Return) {
+		return d.GetComputeRunningProcesses()
+	}
+
+	s.DeviceGetGraphicsRunningProcessesFunc = func(d nvml.Device) ([]nvml.ProcessInfo, nvml.Return) {
+		return d.GetGraphicsRunningProcesses()
+	}
+
+	s.DeviceGetMPSComputeRunningProcessesFunc = func(d nvml.Device) ([]nvml.ProcessInfo, nvml.Return) {
+		return d.GetMPSComputeRunningProcesses()
+	}
+
+	s.DeviceGetProcessUtilizationFunc = func(d nvml.Device, lastSeenTimeStamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+		return d.GetProcessUtilization(lastSeenTimeStamp)
+	}
+
+	s.DeviceGetAccountingModeFunc = func(d nvml.Device) (nvml.EnableState, nvml.Return) {
+		return d.GetAccountingMode()
+	}
+
+	s.DeviceSetAccountingModeFunc = func(d nvml.Device, mode nvml.EnableState) nvml.Return {
+		return d.SetAccountingMode(mode)
+	}
+
+	s.DeviceGetAccountingBufferSizeFunc = func(d nvml.Device) (int, nvml.Return) {
+		return d.GetAccountingBufferSize()
+	}
+
+	s.DeviceGetAccountingPidsFunc = func(d nvml.Device) ([]int, nvml.Return) {
+		return d.GetAccountingPids()
+	}
+
+	s.DeviceGetAccountingStatsFunc = func(d nvml.Device, pid uint32) (nvml.AccountingStats, nvml.Return) {
+		return d.GetAccountingStats(pid)
+	}
+
+	s.DeviceClearAccountingPidsFunc = func(d nvml.Device) nvml.Return {
+		return d.ClearAccountingPids()
+	}
+
+	s.SystemGetProcessNameFunc = s.systemGetProcessName
+
+	s.setTopologyFuncs()
+	s.setVgpuFuncs()
+	s.setGpmFuncs()
+	s.setUnitFuncs()
 }
 
 // SetMockFuncs configures all the mock function implementations for the device
 func (d *Device) SetMockFuncs() {
+	d.setMigDeviceFuncs()
+	d.setTelemetryFuncs()
+	d.setEventFuncs()
+	d.setProcessFuncs()
+	d.setVgpuFuncs()
+	d.setGpmFuncs()
+
 	d.GetMinorNumberFunc = func() (int, nvml.Return) {
 		return d.Minor, nvml.SUCCESS
 	}
@@ -269,19 +526,31 @@ func (d *Device) SetMockFuncs() {
 	}
 
 	d.GetMemoryInfoFunc = func() (nvml.Memory, nvml.Return) {
-		return d.MemoryInfo, nvml.SUCCESS
+		d.RLock()
+		defer d.RUnlock()
+		memory := d.MemoryInfo
+		memory.Used += d.usedMemory()
+		memory.Free = memory.Total - memory.Used
+		return memory, nvml.SUCCESS
 	}
 
 	d.GetPciInfoFunc = func() (nvml.PciInfo, nvml.Return) {
 		p := nvml.PciInfo{
 			PciDeviceId: d.Config.PciDeviceId,
 		}
+		// The bus ID has the form domain:bus:device.function
+		_, _ = fmt.Sscanf(d.PciBusID, "%x:%x:%x.", &p.Domain, &p.Bus, &p.Device)
+		stringToInt8(d.PciBusID, p.BusId[:])
+		stringToInt8(d.PciBusID, p.BusIdLegacy[:])
 		return p, nvml.SUCCESS
 	}
 
 	d.SetMigModeFunc = func(mode int) (nvml.Return, nvml.Return) {
 		d.Lock()
 		defer d.Unlock()
+		if mode != nvml.DEVICE_MIG_ENABLE && len(d.GpuInstances) > 0 {
+			return nvml.ERROR_IN_USE, nvml.ERROR_IN_USE
+		}
 		d.MigMode = mode
 		return nvml.SUCCESS, nvml.SUCCESS
 	}
@@ -308,33 +577,55 @@ func (d *Device) SetMockFuncs() {
 		return d.Config.MIGProfiles.GpuInstancePlacements[int(info.Id)], nvml.SUCCESS
 	}
 
+	d.GetGpuInstanceRemainingCapacityFunc = func(info *nvml.GpuInstanceProfileInfo) (int, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if ret := d.checkGpuInstanceProfile(info); ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		return len(d.freeGpuInstancePlacements(info)), nvml.SUCCESS
+	}
+
 	d.CreateGpuInstanceFunc = func(info *nvml.GpuInstanceProfileInfo) (nvml.GpuInstance, nvml.Return) {
 		d.Lock()
 		defer d.Unlock()
-		giInfo := nvml.GpuInstanceInfo{
-			Device:    d,
-			Id:        d.GpuInstanceCounter,
-			ProfileId: info.Id,
+		if ret := d.checkGpuInstanceProfile(info); ret != nvml.SUCCESS {
+			return nil, ret
+		}
+		free := d.freeGpuInstancePlacements(info)
+		if len(free) == 0 {
+			return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
 		}
-		d.GpuInstanceCounter++
-		gi := NewGpuInstanceFromInfo(giInfo, d.Config.MIGProfiles)
-		d.GpuInstances[gi] = struct{}{}
-		return gi, nvml.SUCCESS
+		return d.createGpuInstance(info, free[0]), nvml.SUCCESS
 	}
 
 	d.CreateGpuInstanceWithPlacementFunc = func(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (nvml.GpuInstance, nvml.Return) {
 		d.Lock()
 		defer d.Unlock()
-		giInfo := nvml.GpuInstanceInfo{
-			Device:    d,
-			Id:        d.GpuInstanceCounter,
-			ProfileId: info.Id,
-			Placement: *placement,
+		if ret := d.checkGpuInstanceProfile(info); ret != nvml.SUCCESS {
+			return nil, ret
 		}
-		d.GpuInstanceCounter++
-		gi := NewGpuInstanceFromInfo(giInfo, d.Config.MIGProfiles)
-		d.GpuInstances[gi] = struct{}{}
-		return gi, nvml.SUCCESS
+		if placement == nil || !d.isGpuInstancePlacement(info, *placement) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if d.gpuInstanceCount(info) >= int(info.InstanceCount) || d.isGpuInstancePlacementUsed(*placement) {
+			return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+		}
+		return d.createGpuInstance(info, *placement), nvml.SUCCESS
+	}
+
+	d.GetGpuInstanceByIdFunc = func(id int) (nvml.GpuInstance, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if d.MigMode != nvml.DEVICE_MIG_ENABLE {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		for gi := range d.GpuInstances {
+			if gi.Info.Id == uint32(id) {
+				return gi, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
 	}
 
 	d.GetGpuInstancesFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
@@ -350,6 +641,102 @@ func (d *Device) SetMockFuncs() {
 	}
 }
 
+// checkGpuInstanceProfile checks that a GPU instance with the specified
+// profile can be created on the device. The caller must hold the device lock.
+func (d *Device) checkGpuInstanceProfile(info *nvml.GpuInstanceProfileInfo) nvml.Return {
+	if info == nil {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	if d.MigMode != nvml.DEVICE_MIG_ENABLE {
+		return nvml.ERROR_NOT_SUPPORTED
+	}
+	if _, exists := d.Config.MIGProfiles.GpuInstanceProfiles[int(info.Id)]; !exists {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	return nvml.SUCCESS
+}
+
+// gpuInstanceCount returns the number of existing GPU instances with the
+// specified profile. The caller must hold the device lock.
+func (d *Device) gpuInstanceCount(info *nvml.GpuInstanceProfileInfo) int {
+	count := 0
+	for gi := range d.GpuInstances {
+		if gi.Info.ProfileId == info.Id {
+			count++
+		}
+	}
+	return count
+}
+
+// isGpuInstancePlacement checks whether placement is a possible placement for
+// the specified profile.
+func (d *Device) isGpuInstancePlacement(info *nvml.GpuInstanceProfileInfo, placement nvml.GpuInstancePlacement) bool {
+	for _, p := range d.Config.MIGProfiles.GpuInstancePlacements[int(info.Id)] {
+		if p == placement {
+			return true
+		}
+	}
+	return false
+}
+
+// isGpuInstancePlacementUsed checks whether placement overlaps the placement
+// of an existing GPU instance. The caller must hold the device lock.
+func (d *Device) isGpuInstancePlacementUsed(placement nvml.GpuInstancePlacement) bool {
+	for gi := range d.GpuInstances {
+		used := gi.Info.Placement
+		if placement.Start < used.Start+used.Size && used.Start < placement.Start+placement.Size {
+			return true
+		}
+	}
+	return false
+}
+
+// freeGpuInstancePlacements returns the placements at which additional GPU
+// instances of the specified profile can be created together. The placements
+// are allocated in order, and their number is limited by the instance count
+// of the profile. The caller must hold the device lock.
+func (d *Device) freeGpuInstancePlacements(info *nvml.GpuInstanceProfileInfo) []nvml.GpuInstancePlacement {
+	remaining := int(info.InstanceCount) - d.gpuInstanceCount(info)
+
+	var free []nvml.GpuInstancePlacement
+	for _, p := range d.Config.MIGProfiles.GpuInstancePlacements[int(info.Id)] {
+		if len(free) >= remaining {
+			break
+		}
+		if d.isGpuInstancePlacementUsed(p) {
+			continue
+		}
+		overlaps := false
+		for _, f := range free {
+			if p.Start < f.Start+f.Size && f.Start < p.Start+p.Size {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			free = append(free, p)
+		}
+	}
+	return free
+}
+
+// createGpuInstance creates a GPU instance at the specified placement. The
+// caller must hold the device lock.
+func (d *Device) createGpuInstance(info *nvml.GpuInstanceProfileInfo, placement nvml.GpuInstancePlacement) *GpuInstance {
+	giInfo := nvml.GpuInstanceInfo{
+		Device:    d,
+		Id:        d.GpuInstanceCounter,
+		ProfileId: info.Id,
+		Placement: placement,
+	}
+	d.GpuInstanceCounter++
+	gi := NewGpuInstanceFromInfo(giInfo, d.Config.MIGProfiles)
+	d.store.track(gi)
+	d.interceptFaults(gi)
+	d.GpuInstances[gi] = struct{}{}
+	return gi
+}
+
 // SetMockFuncs configures all the mock function implementations for the GPU instance
 func (gi *GpuInstance) SetMockFuncs() {
 	gi.GetInfoFunc = func() (nvml.GpuInstanceInfo, nvml.Return) {
@@ -387,19 +774,53 @@ func (gi *GpuInstance) SetMockFuncs() {
 		return placements[int(info.Id)], nvml.SUCCESS
 	}
 
+	gi.GetComputeInstanceRemainingCapacityFunc = func(info *nvml.ComputeInstanceProfileInfo) (int, nvml.Return) {
+		gi.RLock()
+		defer gi.RUnlock()
+		if ret := gi.checkComputeInstanceProfile(info); ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		return gi.computeInstanceRemainingCapacity(info), nvml.SUCCESS
+	}
+
 	gi.CreateComputeInstanceFunc = func(info *nvml.ComputeInstanceProfileInfo) (nvml.ComputeInstance, nvml.Return) {
 		gi.Lock()
 		defer gi.Unlock()
-		ciInfo := nvml.ComputeInstanceInfo{
-			Device:      gi.Info.Device,
-			GpuInstance: gi,
-			Id:          gi.ComputeInstanceCounter,
-			ProfileId:   info.Id,
+		if ret := gi.checkComputeInstanceProfile(info); ret != nvml.SUCCESS {
+			return nil, ret
 		}
-		gi.ComputeInstanceCounter++
-		ci := NewComputeInstanceFromInfo(ciInfo)
-		gi.ComputeInstances[ci] = struct{}{}
-		return ci, nvml.SUCCESS
+		if gi.computeInstanceRemainingCapacity(info) == 0 {
+			return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+		}
+		var placement nvml.ComputeInstancePlacement
+		if placements := gi.computeInstancePlacements(info); len(placements) > 0 {
+			free := false
+			for _, p := range placements {
+				if !gi.isComputeInstancePlacementUsed(p) {
+					placement, free = p, true
+					break
+				}
+			}
+			if !free {
+				return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+			}
+		}
+		return gi.createComputeInstance(info, placement), nvml.SUCCESS
+	}
+
+	gi.CreateComputeInstanceWithPlacementFunc = func(info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (nvml.ComputeInstance, nvml.Return) {
+		gi.Lock()
+		defer gi.Unlock()
+		if ret := gi.checkComputeInstanceProfile(info); ret != nvml.SUCCESS {
+			return nil, ret
+		}
+		if placement == nil || !gi.isComputeInstancePlacement(info, *placement) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if gi.computeInstanceRemainingCapacity(info) == 0 || gi.isComputeInstancePlacementUsed(*placement) {
+			return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+		}
+		return gi.createComputeInstance(info, *placement), nvml.SUCCESS
 	}
 
 	gi.GetComputeInstancesFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstance, nvml.Return) {
@@ -418,11 +839,105 @@ func (gi *GpuInstance) SetMockFuncs() {
 		d := gi.Info.Device.(*Device)
 		d.Lock()
 		defer d.Unlock()
+		gi.RLock()
+		defer gi.RUnlock()
+		if len(gi.ComputeInstances) > 0 {
+			return nvml.ERROR_IN_USE
+		}
 		delete(d.GpuInstances, gi)
 		return nvml.SUCCESS
 	}
 }
 
+// checkComputeInstanceProfile checks that info is a compute instance profile
+// of the GPU instance
+func (gi *GpuInstance) checkComputeInstanceProfile(info *nvml.ComputeInstanceProfileInfo) nvml.Return {
+	if info == nil {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	if _, exists := gi.MIGProfiles.ComputeInstanceProfiles[int(gi.Info.ProfileId)][int(info.Id)]; !exists {
+		return nvml.ERROR_INVALID_ARGUMENT
+	}
+	return nvml.SUCCESS
+}
+
+// computeInstancePlacements returns the possible placements of the specified
+// compute instance profile in the GPU instance.
+func (gi *GpuInstance) computeInstancePlacements(info *nvml.ComputeInstanceProfileInfo) []nvml.ComputeInstancePlacement {
+	return gi.MIGProfiles.ComputeInstancePlacements[int(gi.Info.ProfileId)][int(info.Id)]
+}
+
+// isComputeInstancePlacement checks whether placement is a possible placement
+// for the specified profile.
+func (gi *GpuInstance) isComputeInstancePlacement(info *nvml.ComputeInstanceProfileInfo, placement nvml.ComputeInstancePlacement) bool {
+	for _, p := range gi.computeInstancePlacements(info) {
+		if p == placement {
+			return true
+		}
+	}
+	return false
+}
+
+// isComputeInstancePlacementUsed checks whether placement overlaps the
+// placement of an existing compute instance. Compute instances created without
+// a placement occupy none. The caller must hold the GPU instance lock.
+func (gi *GpuInstance) isComputeInstancePlacementUsed(placement nvml.ComputeInstancePlacement) bool {
+	for ci := range gi.ComputeInstances {
+		used := ci.Info.Placement
+		if placement.Start < used.Start+used.Size && used.Start < placement.Start+placement.Size {
+			return true
+		}
+	}
+	return false
+}
+
+// createComputeInstance creates a compute instance at the specified placement.
+// The caller must hold the GPU instance lock.
+func (gi *GpuInstance) createComputeInstance(info *nvml.ComputeInstanceProfileInfo, placement nvml.ComputeInstancePlacement) *ComputeInstance {
+	ciInfo := nvml.ComputeInstanceInfo{
+		Device:      gi.Info.Device,
+		GpuInstance: gi,
+		Id:          gi.ComputeInstanceCounter,
+		ProfileId:   info.Id,
+		Placement:   placement,
+	}
+	gi.ComputeInstanceCounter++
+	ci := NewComputeInstanceFromInfo(ciInfo)
+	d := gi.Info.Device.(*Device)
+	d.store.track(ci)
+	d.interceptFaults(ci)
+	gi.ComputeInstances[ci] = struct{}{}
+	return ci
+}
+
+// computeInstanceRemainingCapacity returns the number of additional compute
+// instances of the specified profile that can be created in the GPU instance.
+// This is limited both by the instance count of the profile and by the slices
+// of the GPU instance not used by existing compute instances. The caller must
+// hold the GPU instance lock.
+func (gi *GpuInstance) computeInstanceRemainingCapacity(info *nvml.ComputeInstanceProfileInfo) int {
+	count := 0
+	usedSlices := 0
+	for ci := range gi.ComputeInstances {
+		if ci.Info.ProfileId == info.Id {
+			count++
+		}
+		usedSlices += int(gi.MIGProfiles.ComputeInstanceProfiles[int(gi.Info.ProfileId)][int(ci.Info.ProfileId)].SliceCount)
+	}
+
+	remaining := int(info.InstanceCount) - count
+	if info.SliceCount > 0 {
+		giSlices := int(gi.MIGProfiles.GpuInstanceProfiles[int(gi.Info.ProfileId)].SliceCount)
+		if fit := (giSlices - usedSlices) / int(info.SliceCount); fit < remaining {
+			remaining = fit
+		}
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 // SetMockFuncs configures all the mock function implementations for the compute instance
 func (ci *ComputeInstance) SetMockFuncs() {
 	ci.GetInfoFunc = func() (nvml.ComputeInstanceInfo, nvml.Return) {
@@ -437,3 +952,25 @@ func (ci *ComputeInstance) SetMockFuncs() {
 		return nvml.SUCCESS
 	}
 }
+
+// int8ToString converts a NUL-terminated C char array into a string
+func int8ToString(s []int8) string {
+	var b []byte
+	for _, c := range s {
+		if c == 0 {
+			break
+		}
+		b = append(b, byte(c))
+	}
+	return string(b)
+}
+
+// stringToInt8 copies s into out as a NUL-terminated C char array
+func stringToInt8(s string, out []int8) {
+	for i := range out {
+		out[i] = 0
+	}
+	for i := 0; i < len(s) && i < len(out)-1; i++ {
+		out[i] = int8(s[i])
+	}
+}
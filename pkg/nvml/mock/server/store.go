@@ -0,0 +1,510 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// WithStateFile shares the state of the server with other servers, possibly
+// in other processes, that use the same state file. The shared state consists
+// of the device UUIDs, MIG modes, GPU and compute instances, process tables
+// and accounting data.
+//
+// The first server to use an empty state file initializes it from its own
+// state. All servers sharing a state file must be created with the same GPUs.
+func WithStateFile(path string) Option {
+	return func(o *options) error {
+		o.stateFile = path
+		return nil
+	}
+}
+
+// stateStore synchronizes the state of a server with a state file. Every call
+// into the server or one of its devices, GPU instances or compute instances
+// holds an exclusive lock on the file, loading the state from the file before
+// the call and saving it after the call if it has changed.
+//
+// The mutex guards all fields. Calls that are in progress in the process at
+// the same time, whether nested or concurrent, share a single hold of the
+// file lock: depth counts them, the state is loaded when the first one starts
+// and saved when the last one returns.
+type stateStore struct {
+	sync.Mutex
+	server  *Server
+	path    string
+	file    *os.File
+	depth   int
+	last    []byte
+	closing bool
+}
+
+// storeState is the state of a server as persisted in a state file
+type storeState struct {
+	Devices []deviceState `json:"devices"`
+}
+
+type deviceState struct {
+	Name               string             `json:"name"`
+	UUID               string             `json:"uuid"`
+	MigMode            int                `json:"migMode"`
+	GpuInstanceCounter uint32             `json:"gpuInstanceCounter"`
+	GpuInstances       []gpuInstanceState `json:"gpuInstances,omitempty"`
+	Processes          []processState     `json:"processes,omitempty"`
+	AccountingMode     nvml.EnableState   `json:"accountingMode"`
+	Accounting         []accountingState  `json:"accounting,omitempty"`
+}
+
+type gpuInstanceState struct {
+	Id                     uint32                    `json:"id"`
+	ProfileId              uint32                    `json:"profileId"`
+	Placement              nvml.GpuInstancePlacement `json:"placement"`
+	ComputeInstanceCounter uint32                    `json:"computeInstanceCounter"`
+	ComputeInstances       []computeInstanceState    `json:"computeInstances,omitempty"`
+}
+
+type computeInstanceState struct {
+	Id        uint32                        `json:"id"`
+	ProfileId uint32                        `json:"profileId"`
+	Placement nvml.ComputeInstancePlacement `json:"placement"`
+	UUID      string                        `json:"uuid"`
+}
+
+type processState struct {
+	Process
+	StartTime time.Time `json:"startTime"`
+}
+
+type accountingState struct {
+	Pid       uint32               `json:"pid"`
+	Stats     nvml.AccountingStats `json:"stats"`
+	StartTime time.Time            `json:"startTime"`
+}
+
+// openStateStore opens the state file of a server and synchronizes the
+// server with it
+func openStateStore(s *Server, path string) (*stateStore, error) {
+	st := &stateStore{
+		server: s,
+		path:   path,
+	}
+	if err := st.open(); err != nil {
+		return nil, err
+	}
+
+	st.intercept(&s.Interface)
+	for _, d := range s.Devices {
+		device, ok := d.(*Device)
+		if !ok {
+			continue
+		}
+		device.store = st
+		st.intercept(&device.Device)
+		for gi := range device.GpuInstances {
+			st.track(gi)
+			for ci := range gi.ComputeInstances {
+				st.track(ci)
+			}
+		}
+	}
+
+	if err := st.enter(); err != nil {
+		st.file.Close()
+		return nil, err
+	}
+	if err := st.exit(); err != nil {
+		st.file.Close()
+		return nil, err
+	}
+	return st, nil
+}
+
+// open opens the state file. The caller must hold the mutex unless the store
+// is not shared yet.
+func (st *stateStore) open() error {
+	file, err := os.OpenFile(st.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("error opening state file: %w", err)
+	}
+	st.file = file
+	return nil
+}
+
+// Close closes the state file. If calls are in progress, the file is closed
+// once the last of them returns. The file is reopened by the next call, so
+// that a server that is initialized again after a shutdown keeps sharing its
+// state. It is a no-op if st is nil.
+func (st *stateStore) Close() error {
+	if st == nil {
+		return nil
+	}
+	st.Lock()
+	defer st.Unlock()
+	if st.depth > 0 {
+		st.closing = true
+		return nil
+	}
+	return st.close()
+}
+
+// close closes the state file if it is open. The caller must hold the mutex.
+func (st *stateStore) close() error {
+	st.closing = false
+	if st.file == nil {
+		return nil
+	}
+	err := st.file.Close()
+	st.file = nil
+	if err != nil {
+		return fmt.Errorf("error closing state file: %w", err)
+	}
+	return nil
+}
+
+// intercept synchronizes all calls of the specified moq mock with the state
+// file. Event set functions are not synchronized since waiting for an event
+// would block all other servers.
+func (st *stateStore) intercept(mock interface{}) {
+	interceptMockFuncs(mock, func(method string, original reflect.Value) func([]reflect.Value) []reflect.Value {
+		if strings.HasPrefix(method, "EventSet") {
+			return original.Call
+		}
+		return func(args []reflect.Value) []reflect.Value {
+			var results []reflect.Value
+			ret := st.do(func() nvml.Return {
+				results = original.Call(args)
+				return nvml.SUCCESS
+			})
+			if ret != nvml.SUCCESS {
+				return faultResults(original.Type(), ret)
+			}
+			return results
+		}
+	})
+}
+
+// track synchronizes the calls of a newly created GPU or compute instance
+// with the state file. It is a no-op if st is nil.
+func (st *stateStore) track(instance interface{}) {
+	if st == nil {
+		return
+	}
+	switch instance := instance.(type) {
+	case *GpuInstance:
+		st.intercept(&instance.GpuInstance)
+	case *ComputeInstance:
+		st.intercept(&instance.ComputeInstance)
+		st.intercept(&instance.MigDevice.Device)
+	}
+}
+
+// do calls f while holding the lock on the state file. Nested calls only
+// synchronize with the state file once. If st is nil, f is called directly.
+func (st *stateStore) do(f func() nvml.Return) (ret nvml.Return) {
+	if st == nil {
+		return f()
+	}
+	if err := st.enter(); err != nil {
+		return nvml.ERROR_UNKNOWN
+	}
+	defer func() {
+		if err := st.exit(); err != nil {
+			ret = nvml.ERROR_UNKNOWN
+		}
+	}()
+	return f()
+}
+
+// enter locks the state file and loads it, unless the calling server already
+// holds the lock
+func (st *stateStore) enter() error {
+	st.Lock()
+	defer st.Unlock()
+	if st.depth == 0 {
+		if st.file == nil {
+			if err := st.open(); err != nil {
+				return err
+			}
+		}
+		if err := lockFile(st.file); err != nil {
+			return fmt.Errorf("error locking state file: %w", err)
+		}
+		if err := st.load(); err != nil {
+			_ = unlockFile(st.file)
+			return err
+		}
+	}
+	st.depth++
+	return nil
+}
+
+// exit saves and unlocks the state file once the outermost call returns
+func (st *stateStore) exit() error {
+	st.Lock()
+	defer st.Unlock()
+	st.depth--
+	if st.depth > 0 {
+		return nil
+	}
+	err := st.save()
+	if unlockErr := unlockFile(st.file); unlockErr != nil && err == nil {
+		err = fmt.Errorf("error unlocking state file: %w", unlockErr)
+	}
+	if st.closing {
+		if closeErr := st.close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// load applies the contents of the state file to the server if they have
+// changed since the last load or save. The caller must hold the file lock.
+func (st *stateStore) load() error {
+	if _, err := st.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error reading state file: %w", err)
+	}
+	data, err := io.ReadAll(st.file)
+	if err != nil {
+		return fmt.Errorf("error reading state file: %w", err)
+	}
+	if len(data) == 0 || bytes.Equal(data, st.last) {
+		return nil
+	}
+
+	var state storeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("error parsing state file: %w", err)
+	}
+	if err := st.server.applyState(&state, st.track); err != nil {
+		return err
+	}
+	st.last = data
+	return nil
+}
+
+// save writes the state of the server to the state file if it has changed.
+// The caller must hold the file lock.
+func (st *stateStore) save() error {
+	data, err := json.MarshalIndent(st.server.state(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding state: %w", err)
+	}
+	if bytes.Equal(data, st.last) {
+		return nil
+	}
+	if err := st.file.Truncate(0); err != nil {
+		return fmt.Errorf("error writing state file: %w", err)
+	}
+	if _, err := st.file.WriteAt(data, 0); err != nil {
+		return fmt.Errorf("error writing state file: %w", err)
+	}
+	st.last = data
+	return nil
+}
+
+// state returns the shared state of the server
+func (s *Server) state() *storeState {
+	state := &storeState{}
+	for _, d := range s.Devices {
+		device, ok := d.(*Device)
+		if !ok {
+			continue
+		}
+		state.Devices = append(state.Devices, device.state())
+	}
+	return state
+}
+
+func (d *Device) state() deviceState {
+	d.RLock()
+	defer d.RUnlock()
+	state := deviceState{
+		Name:               d.Config.Name,
+		UUID:               d.UUID,
+		MigMode:            d.MigMode,
+		GpuInstanceCounter: d.GpuInstanceCounter,
+		AccountingMode:     d.AccountingMode,
+	}
+
+	for _, gi := range d.gpuInstances() {
+		gi.RLock()
+		gis := gpuInstanceState{
+			Id:                     gi.Info.Id,
+			ProfileId:              gi.Info.ProfileId,
+			Placement:              gi.Info.Placement,
+			ComputeInstanceCounter: gi.ComputeInstanceCounter,
+		}
+		for _, ci := range gi.computeInstances() {
+			gis.ComputeInstances = append(gis.ComputeInstances, computeInstanceState{
+				Id:        ci.Info.Id,
+				ProfileId: ci.Info.ProfileId,
+				Placement: ci.Info.Placement,
+				UUID:      ci.MigDevice.UUID,
+			})
+		}
+		gi.RUnlock()
+		state.GpuInstances = append(state.GpuInstances, gis)
+	}
+
+	for _, p := range d.processes(ProcessTypeCompute|ProcessTypeGraphics|ProcessTypeMPSCompute, nil) {
+		state.Processes = append(state.Processes, processState{Process: *p, StartTime: p.startTime})
+	}
+	for _, pid := range d.accountingPids() {
+		record := d.accounting[pid]
+		state.Accounting = append(state.Accounting, accountingState{Pid: pid, Stats: record.stats, StartTime: record.startTime})
+	}
+	return state
+}
+
+// applyState replaces the shared state of the server. GPU and compute
+// instances that exist in both states are kept, so that handles to them
+// remain valid. New instances are passed to track.
+func (s *Server) applyState(state *storeState, track func(interface{})) error {
+	if len(state.Devices) != len(s.Devices) {
+		return fmt.Errorf("state file has %d devices, expected %d", len(state.Devices), len(s.Devices))
+	}
+	for i, d := range s.Devices {
+		device, ok := d.(*Device)
+		if !ok {
+			continue
+		}
+		if err := device.applyState(&state.Devices[i], track); err != nil {
+			return fmt.Errorf("device %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (d *Device) applyState(state *deviceState, track func(interface{})) error {
+	d.Lock()
+	defer d.Unlock()
+	if state.Name != d.Config.Name {
+		return fmt.Errorf("state file has device %q, expected %q", state.Name, d.Config.Name)
+	}
+	d.UUID = state.UUID
+	d.MigMode = state.MigMode
+	d.GpuInstanceCounter = state.GpuInstanceCounter
+
+	existing := make(map[uint32]*GpuInstance)
+	for gi := range d.GpuInstances {
+		existing[gi.Info.Id] = gi
+	}
+	gpuInstances := make(map[*GpuInstance]struct{})
+	for _, gis := range state.GpuInstances {
+		gi, exists := existing[gis.Id]
+		if !exists || gi.Info.ProfileId != gis.ProfileId || gi.Info.Placement != gis.Placement {
+			gi = NewGpuInstanceFromInfo(nvml.GpuInstanceInfo{
+				Device:    d,
+				Id:        gis.Id,
+				ProfileId: gis.ProfileId,
+				Placement: gis.Placement,
+			}, d.Config.MIGProfiles)
+			track(gi)
+		}
+		gi.applyState(&gis, track)
+		d.interceptFaults(gi)
+		gpuInstances[gi] = struct{}{}
+	}
+	d.GpuInstances = gpuInstances
+
+	d.Processes = make(map[uint32]*Process)
+	for _, ps := range state.Processes {
+		p := ps.Process
+		p.startTime = ps.StartTime
+		d.Processes[p.Pid] = &p
+	}
+	d.AccountingMode = state.AccountingMode
+	d.accounting = make(map[uint32]*accountingRecord)
+	for _, as := range state.Accounting {
+		d.accounting[as.Pid] = &accountingRecord{stats: as.Stats, startTime: as.StartTime}
+	}
+	return nil
+}
+
+func (gi *GpuInstance) applyState(state *gpuInstanceState, track func(interface{})) {
+	gi.Lock()
+	defer gi.Unlock()
+	gi.ComputeInstanceCounter = state.ComputeInstanceCounter
+
+	existing := make(map[uint32]*ComputeInstance)
+	for ci := range gi.ComputeInstances {
+		existing[ci.Info.Id] = ci
+	}
+	computeInstances := make(map[*ComputeInstance]struct{})
+	for _, cis := range state.ComputeInstances {
+		ci, exists := existing[cis.Id]
+		if !exists || ci.Info.ProfileId != cis.ProfileId || ci.Info.Placement != cis.Placement {
+			ci = NewComputeInstanceFromInfo(nvml.ComputeInstanceInfo{
+				Device:      gi.Info.Device,
+				GpuInstance: gi,
+				Id:          cis.Id,
+				ProfileId:   cis.ProfileId,
+				Placement:   cis.Placement,
+			})
+			track(ci)
+			gi.Info.Device.(*Device).interceptFaults(ci)
+		}
+		ci.MigDevice.UUID = cis.UUID
+		computeInstances[ci] = struct{}{}
+	}
+	gi.ComputeInstances = computeInstances
+}
+
+// gpuInstances returns the GPU instances of the device ordered by ID. The
+// caller must hold the device lock.
+func (d *Device) gpuInstances() []*GpuInstance {
+	var gis []*GpuInstance
+	for gi := range d.GpuInstances {
+		gis = append(gis, gi)
+	}
+	sort.Slice(gis, func(i, j int) bool { return gis[i].Info.Id < gis[j].Info.Id })
+	return gis
+}
+
+// computeInstances returns the compute instances of the GPU instance ordered
+// by ID. The caller must hold the GPU instance lock.
+func (gi *GpuInstance) computeInstances() []*ComputeInstance {
+	var cis []*ComputeInstance
+	for ci := range gi.ComputeInstances {
+		cis = append(cis, ci)
+	}
+	sort.Slice(cis, func(i, j int) bool { return cis[i].Info.Id < cis[j].Info.Id })
+	return cis
+}
+
+// accountingPids returns the PIDs with accounting records in ascending order.
+// The caller must hold the device lock.
+func (d *Device) accountingPids() []uint32 {
+	var pids []uint32
+	for pid := range d.accounting {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids
+}
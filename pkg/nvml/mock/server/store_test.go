@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	s1, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithStateFile(path))
+	require.NoError(t, err)
+	s2, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithStateFile(path))
+	require.NoError(t, err)
+
+	// The second server adopts the devices of the first
+	uuid, ret := s1.Devices[1].GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	device, ret := s2.DeviceGetHandleByUUID(uuid)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, s2.Devices[1], device)
+
+	// MIG changes made through one server are visible through the other
+	_, ret = s1.Devices[0].SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	mode, _, ret := s2.Devices[0].GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_ENABLE, mode)
+
+	giProfile, ret := s2.Devices[0].GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := s2.Devices[0].CreateGpuInstance(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	gis, ret := s1.Devices[0].GetGpuInstances(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, gis, 1)
+	info, ret := gis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GpuInstancePlacement{Start: 0, Size: 4}, info.Placement)
+
+	migDevice, ret := s1.Devices[0].GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	migUUID, ret := migDevice.GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	handle, ret := s2.DeviceGetHandleByUUID(migUUID)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.IsType(t, &MigDevice{}, handle)
+
+	// The placement taken through one server is not available to the other
+	_, ret = s1.Devices[0].CreateGpuInstanceWithPlacement(&giProfile, &nvml.GpuInstancePlacement{Start: 0, Size: 4})
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	// Process tables are shared
+	require.Equal(t, nvml.SUCCESS, s1.Devices[1].(*Device).StartProcess(Process{Pid: 99, Name: "trainer", UsedGpuMemory: 1 << 30}))
+	name, ret := s2.SystemGetProcessName(99)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "trainer", name)
+	memory, ret := s2.Devices[1].GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1<<30), memory.Used)
+
+	// Handles held by the first server remain valid after it reloads the state
+	ret = gi.Destroy()
+	require.Equal(t, nvml.ERROR_IN_USE, ret)
+	cis, ret := gis[0].GetComputeInstances(&ciProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, cis, 1)
+	require.Equal(t, nvml.SUCCESS, cis[0].Destroy())
+	require.Equal(t, nvml.SUCCESS, gi.Destroy())
+	gis, ret = s1.Devices[0].GetGpuInstances(&giProfile)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, gis)
+
+	// A new server sees the current state
+	s3, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithStateFile(path))
+	require.NoError(t, err)
+	processes, ret := s3.Devices[1].GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, processes, 1)
+}
+
+func TestStateFileConcurrentCreation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	var servers []*Server
+	for i := 0; i < 4; i++ {
+		s, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithStateFile(path))
+		require.NoError(t, err)
+		servers = append(servers, s)
+	}
+	_, ret := servers[0].Devices[0].SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// Seven 1-slice GPU instances fit on an A100; each server tries to create four
+	var wg sync.WaitGroup
+	results := make(chan nvml.Return, 16)
+	for _, s := range servers {
+		wg.Add(1)
+		go func(device nvml.Device) {
+			defer wg.Done()
+			profile, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+			require.Equal(t, nvml.SUCCESS, ret)
+			for i := 0; i < 4; i++ {
+				_, ret := device.CreateGpuInstance(&profile)
+				results <- ret
+			}
+		}(s.Devices[0])
+	}
+	wg.Wait()
+	close(results)
+
+	created := 0
+	for ret := range results {
+		if ret == nvml.SUCCESS {
+			created++
+		} else {
+			require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+		}
+	}
+	require.Equal(t, 7, created)
+}
+
+func TestStateFileMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	_, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithStateFile(path))
+	require.NoError(t, err)
+
+	_, err = New(WithGPUs(gpus.A100_SXM4_40GB), WithStateFile(path))
+	require.Error(t, err)
+	_, err = New(WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...), WithStateFile(path))
+	require.Error(t, err)
+}
+
+func TestStateFileClosedOnShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithStateFile(path))
+	require.NoError(t, err)
+	require.NotNil(t, s.store.file)
+
+	require.Equal(t, nvml.SUCCESS, s.Init())
+	require.Equal(t, nvml.SUCCESS, s.Shutdown())
+	require.Nil(t, s.store.file)
+
+	// The state file is reopened when the server is used again
+	require.Equal(t, nvml.SUCCESS, s.Init())
+	_, ret := s.Devices[0].SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.SUCCESS, s.Shutdown())
+	require.Nil(t, s.store.file)
+
+	other, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithStateFile(path))
+	require.NoError(t, err)
+	mode, _, ret := other.Devices[0].GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_ENABLE, mode)
+	require.NoError(t, other.store.Close())
+}
+
+func TestStateFileConcurrentCalls(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithStateFile(path))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(device nvml.Device) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, ret := s.DeviceGetMaxMigDeviceCount(device)
+				require.Equal(t, nvml.SUCCESS, ret)
+			}
+		}(s.Devices[i%2])
+	}
+	wg.Wait()
+
+	s.store.Lock()
+	defer s.store.Unlock()
+	require.Equal(t, 0, s.store.depth)
+}
@@ -0,0 +1,392 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Metric identifies a simulated device metric
+type Metric int
+
+// Simulated device metrics and their units
+const (
+	MetricTemperature       Metric = iota // degrees C
+	MetricPowerUsage                      // milliwatts
+	MetricGpuUtilization                  // percent
+	MetricMemoryUtilization               // percent
+	MetricGraphicsClock                   // MHz
+	MetricSMClock                         // MHz
+	MetricMemoryClock                     // MHz
+	MetricVideoClock                      // MHz
+)
+
+const (
+	// SampleInterval is the interval at which simulated samples are taken
+	SampleInterval = time.Second / 6
+	// SampleBufferSize is the maximum number of samples returned by GetSamples
+	SampleBufferSize = 120
+	// energyIntegrationStep is the step used to integrate power into energy
+	energyIntegrationStep = 100 * time.Millisecond
+)
+
+// Generator produces the value of a metric as a function of the time elapsed
+// since the creation of the device.
+type Generator interface {
+	Value(elapsed time.Duration) float64
+}
+
+// Constant is a generator that always produces the same value
+type Constant float64
+
+// Value returns the constant value
+func (c Constant) Value(time.Duration) float64 {
+	return float64(c)
+}
+
+// Sine is a generator that oscillates between Min and Max with the specified Period
+type Sine struct {
+	Min    float64
+	Max    float64
+	Period time.Duration
+	Phase  time.Duration
+}
+
+// Value returns the value of the sine wave at the elapsed time
+func (s Sine) Value(elapsed time.Duration) float64 {
+	if s.Period == 0 {
+		return s.Min
+	}
+	x := 2 * math.Pi * float64(elapsed+s.Phase) / float64(s.Period)
+	return s.Min + (s.Max-s.Min)*(1+math.Sin(x))/2
+}
+
+// RandomWalk is a generator that moves by up to Step in either direction every
+// Interval, staying within [Min, Max]. The walk is reproducible for a given Seed.
+type RandomWalk struct {
+	sync.Mutex
+	Start    float64
+	Step     float64
+	Min      float64
+	Max      float64
+	Interval time.Duration
+	Seed     int64
+
+	rand   *rand.Rand
+	values []float64
+}
+
+// Value returns the value of the walk at the elapsed time
+func (w *RandomWalk) Value(elapsed time.Duration) float64 {
+	w.Lock()
+	defer w.Unlock()
+	interval := w.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	if w.rand == nil {
+		w.rand = rand.New(rand.NewSource(w.Seed))
+		w.values = []float64{w.Start}
+	}
+	n := int(elapsed / interval)
+	for len(w.values) <= n {
+		v := w.values[len(w.values)-1] + (2*w.rand.Float64()-1)*w.Step
+		v = math.Max(w.Min, math.Min(w.Max, v))
+		w.values = append(w.values, v)
+	}
+	return w.values[n]
+}
+
+// Replay is a generator that replays recorded values. Values between two
+// recorded points are linearly interpolated. After the last point the replay
+// either holds the last value or, if Loop is set, starts over.
+type Replay struct {
+	Points []ReplayPoint
+	Loop   bool
+}
+
+// ReplayPoint is a recorded value at an offset from the start of a replay
+type ReplayPoint struct {
+	Offset time.Duration
+	Value  float64
+}
+
+// LoadReplay reads a replay from a CSV file
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening replay: %w", err)
+	}
+	defer f.Close()
+	return NewReplayFromCSV(f)
+}
+
+// NewReplayFromCSV reads a replay from CSV records of the form
+// "seconds,value", where seconds is the offset from the start of the replay.
+// Records whose first field is not a number (e.g. a header) are skipped.
+func NewReplayFromCSV(r io.Reader) (*Replay, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading replay: %w", err)
+	}
+	replay := &Replay{}
+	for i, record := range records {
+		if len(record) != 2 {
+			return nil, fmt.Errorf("line %d: expected 2 fields, got %d", i+1, len(record))
+		}
+		seconds, err := strconv.ParseFloat(record[0], 64)
+		if err != nil {
+			continue
+		}
+		value, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid value: %w", i+1, err)
+		}
+		replay.Points = append(replay.Points, ReplayPoint{
+			Offset: time.Duration(seconds * float64(time.Second)),
+			Value:  value,
+		})
+	}
+	if len(replay.Points) == 0 {
+		return nil, fmt.Errorf("replay contains no values")
+	}
+	sort.Slice(replay.Points, func(i, j int) bool { return replay.Points[i].Offset < replay.Points[j].Offset })
+	return replay, nil
+}
+
+// Value returns the replayed value at the elapsed time
+func (r *Replay) Value(elapsed time.Duration) float64 {
+	points := r.Points
+	if len(points) == 0 {
+		return 0
+	}
+	last := points[len(points)-1]
+	if r.Loop && last.Offset > 0 {
+		elapsed %= last.Offset
+	}
+	i := sort.Search(len(points), func(i int) bool { return points[i].Offset > elapsed })
+	switch {
+	case i == 0:
+		return points[0].Value
+	case i == len(points):
+		return last.Value
+	}
+	p0, p1 := points[i-1], points[i]
+	f := float64(elapsed-p0.Offset) / float64(p1.Offset-p0.Offset)
+	return p0.Value + f*(p1.Value-p0.Value)
+}
+
+// WithTelemetry binds a generator to a metric on all devices
+func WithTelemetry(metric Metric, g Generator) Option {
+	return func(o *options) error {
+		if o.telemetry == nil {
+			o.telemetry = make(map[Metric]Generator)
+		}
+		o.telemetry[metric] = g
+		return nil
+	}
+}
+
+// WithDeviceTelemetry binds a generator to a metric on the device with the specified index
+func WithDeviceTelemetry(index int, metric Metric, g Generator) Option {
+	return func(o *options) error {
+		if o.deviceTelemetry == nil {
+			o.deviceTelemetry = make(map[int]map[Metric]Generator)
+		}
+		if o.deviceTelemetry[index] == nil {
+			o.deviceTelemetry[index] = make(map[Metric]Generator)
+		}
+		o.deviceTelemetry[index][metric] = g
+		return nil
+	}
+}
+
+// WithClock sets the clock used to evaluate telemetry generators
+func WithClock(now func() time.Time) Option {
+	return func(o *options) error {
+		o.now = now
+		return nil
+	}
+}
+
+// telemetryState holds the time base of the simulated telemetry of a device
+type telemetryState struct {
+	now          func() time.Time
+	start        time.Time
+	energy       float64
+	energyOffset time.Duration
+}
+
+// elapsed returns the time elapsed since the start of the telemetry
+func (d *Device) elapsed() time.Duration {
+	return d.telemetry.now().Sub(d.telemetry.start)
+}
+
+// metric returns the current value of a metric and whether it is simulated
+func (d *Device) metric(m Metric) (float64, bool) {
+	g, exists := d.Telemetry[m]
+	if !exists {
+		return 0, false
+	}
+	return g.Value(d.elapsed()), true
+}
+
+// totalEnergy integrates the simulated power usage up to the current time and
+// returns the consumed energy in millijoules. The caller must hold the device lock.
+func (d *Device) totalEnergy() (uint64, bool) {
+	g, exists := d.Telemetry[MetricPowerUsage]
+	if !exists {
+		return 0, false
+	}
+	elapsed := d.elapsed()
+	if elapsed < d.telemetry.energyOffset {
+		d.telemetry.energy, d.telemetry.energyOffset = 0, 0
+	}
+	for d.telemetry.energyOffset < elapsed {
+		step := energyIntegrationStep
+		if d.telemetry.energyOffset+step > elapsed {
+			step = elapsed - d.telemetry.energyOffset
+		}
+		p0 := g.Value(d.telemetry.energyOffset)
+		p1 := g.Value(d.telemetry.energyOffset + step)
+		d.telemetry.energy += (p0 + p1) / 2 * step.Seconds()
+		d.telemetry.energyOffset += step
+	}
+	return uint64(d.telemetry.energy), true
+}
+
+// samplingMetrics maps sampling types to the metrics they sample
+var samplingMetrics = map[nvml.SamplingType]Metric{
+	nvml.TOTAL_POWER_SAMPLES:        MetricPowerUsage,
+	nvml.GPU_UTILIZATION_SAMPLES:    MetricGpuUtilization,
+	nvml.MEMORY_UTILIZATION_SAMPLES: MetricMemoryUtilization,
+	nvml.PROCESSOR_CLK_SAMPLES:      MetricGraphicsClock,
+	nvml.MEMORY_CLK_SAMPLES:         MetricMemoryClock,
+}
+
+// clockMetrics maps clock types to the metrics that simulate them
+var clockMetrics = map[nvml.ClockType]Metric{
+	nvml.CLOCK_GRAPHICS: MetricGraphicsClock,
+	nvml.CLOCK_SM:       MetricSMClock,
+	nvml.CLOCK_MEM:      MetricMemoryClock,
+	nvml.CLOCK_VIDEO:    MetricVideoClock,
+}
+
+// samples returns the samples of a metric taken after lastSeenTimeStamp
+// (in microseconds since the epoch) that are still in the sample buffer.
+func (d *Device) samples(m Metric, lastSeenTimeStamp uint64) []nvml.Sample {
+	g := d.Telemetry[m]
+	elapsed := d.elapsed()
+	last := int(elapsed / SampleInterval)
+	first := last - SampleBufferSize + 1
+	if first < 0 {
+		first = 0
+	}
+
+	var samples []nvml.Sample
+	for i := first; i <= last; i++ {
+		offset := time.Duration(i) * SampleInterval
+		timestamp := uint64(d.telemetry.start.Add(offset).UnixMicro())
+		if timestamp <= lastSeenTimeStamp {
+			continue
+		}
+		sample := nvml.Sample{TimeStamp: timestamp}
+		binary.LittleEndian.PutUint32(sample.SampleValue[:], uint32(g.Value(offset)))
+		samples = append(samples, sample)
+	}
+	return samples
+}
+
+// setTelemetryFuncs configures the telemetry queries of a device
+func (d *Device) setTelemetryFuncs() {
+	d.GetTemperatureFunc = func(sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+		if sensor != nvml.TEMPERATURE_GPU {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		v, ok := d.metric(MetricTemperature)
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return uint32(v), nvml.SUCCESS
+	}
+
+	d.GetPowerUsageFunc = func() (uint32, nvml.Return) {
+		v, ok := d.metric(MetricPowerUsage)
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return uint32(v), nvml.SUCCESS
+	}
+
+	d.GetUtilizationRatesFunc = func() (nvml.Utilization, nvml.Return) {
+		gpu, gpuOk := d.metric(MetricGpuUtilization)
+		memory, memoryOk := d.metric(MetricMemoryUtilization)
+		if !gpuOk && !memoryOk {
+			return nvml.Utilization{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		return nvml.Utilization{Gpu: uint32(gpu), Memory: uint32(memory)}, nvml.SUCCESS
+	}
+
+	d.GetClockInfoFunc = func(clockType nvml.ClockType) (uint32, nvml.Return) {
+		m, exists := clockMetrics[clockType]
+		if !exists {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		v, ok := d.metric(m)
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return uint32(v), nvml.SUCCESS
+	}
+
+	d.GetTotalEnergyConsumptionFunc = func() (uint64, nvml.Return) {
+		d.Lock()
+		defer d.Unlock()
+		energy, ok := d.totalEnergy()
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return energy, nvml.SUCCESS
+	}
+
+	d.GetSamplesFunc = func(samplingType nvml.SamplingType, lastSeenTimeStamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+		m, exists := samplingMetrics[samplingType]
+		if !exists {
+			return 0, nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		if _, exists := d.Telemetry[m]; !exists {
+			return 0, nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		samples := d.samples(m, lastSeenTimeStamp)
+		if len(samples) == 0 {
+			return 0, nil, nvml.ERROR_NOT_FOUND
+		}
+		return nvml.VALUE_TYPE_UNSIGNED_INT, samples, nvml.SUCCESS
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestTelemetry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	s, err := New(
+		WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		WithClock(clock.Now),
+		WithTelemetry(MetricTemperature, Constant(45)),
+		WithTelemetry(MetricPowerUsage, Constant(300000)),
+		WithTelemetry(MetricGpuUtilization, Sine{Min: 0, Max: 100, Period: 4 * time.Second}),
+		WithDeviceTelemetry(1, MetricTemperature, Constant(85)),
+		WithDeviceTelemetry(1, MetricSMClock, Constant(1980)),
+	)
+	require.NoError(t, err)
+
+	temperature, ret := s.DeviceGetTemperature(s.Devices[0], nvml.TEMPERATURE_GPU)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(45), temperature)
+
+	temperature, ret = s.Devices[1].GetTemperature(nvml.TEMPERATURE_GPU)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(85), temperature)
+
+	smClock, ret := s.Devices[1].GetClockInfo(nvml.CLOCK_SM)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(1980), smClock)
+
+	_, ret = s.Devices[0].GetClockInfo(nvml.CLOCK_SM)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	utilization, ret := s.Devices[0].GetUtilizationRates()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(50), utilization.Gpu)
+}
+
+func TestTelemetryEnergyAndSamples(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	clock := &fakeClock{now: start}
+	s, err := New(
+		WithGPUs(gpus.H100_SXM5_80GB),
+		WithClock(clock.Now),
+		WithTelemetry(MetricPowerUsage, Constant(250000)),
+	)
+	require.NoError(t, err)
+	device := s.Devices[0]
+
+	clock.now = start.Add(10 * time.Second)
+	energy, ret := device.GetTotalEnergyConsumption()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(2500000), energy)
+
+	valueType, samples, ret := device.GetSamples(nvml.TOTAL_POWER_SAMPLES, 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.VALUE_TYPE_UNSIGNED_INT, valueType)
+	require.Len(t, samples, 61)
+	require.Equal(t, uint32(250000), binary.LittleEndian.Uint32(samples[0].SampleValue[:]))
+
+	lastSeen := samples[len(samples)-1].TimeStamp
+	_, _, ret = device.GetSamples(nvml.TOTAL_POWER_SAMPLES, lastSeen)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+
+	clock.now = clock.now.Add(time.Second)
+	_, samples, ret = device.GetSamples(nvml.TOTAL_POWER_SAMPLES, lastSeen)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, samples, 6)
+
+	_, _, ret = device.GetSamples(nvml.GPU_UTILIZATION_SAMPLES, 0)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGenerators(t *testing.T) {
+	walk := &RandomWalk{Start: 50, Step: 5, Min: 0, Max: 100, Interval: time.Second, Seed: 1}
+	other := &RandomWalk{Start: 50, Step: 5, Min: 0, Max: 100, Interval: time.Second, Seed: 1}
+	require.Equal(t, 50.0, walk.Value(0))
+	for i := 0; i < 100; i++ {
+		v := walk.Value(time.Duration(i) * time.Second)
+		require.GreaterOrEqual(t, v, 0.0)
+		require.LessOrEqual(t, v, 100.0)
+	}
+	require.Equal(t, walk.Value(42*time.Second), other.Value(42*time.Second))
+
+	replay, err := NewReplayFromCSV(strings.NewReader("seconds,watts\n0,100\n10,200\n"))
+	require.NoError(t, err)
+	require.Equal(t, 100.0, replay.Value(0))
+	require.Equal(t, 150.0, replay.Value(5*time.Second))
+	require.Equal(t, 200.0, replay.Value(time.Minute))
+
+	replay.Loop = true
+	require.Equal(t, 150.0, replay.Value(15*time.Second))
+}
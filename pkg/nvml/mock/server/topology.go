@@ -0,0 +1,473 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Topology describes how the devices of a server are connected to each other
+// and to the host. Devices are referred to by index.
+type Topology struct {
+	// NUMANodes holds the NUMA nodes of the host, indexed by NUMA node ID
+	NUMANodes []NUMANode
+	// PCIeSwitches holds the PCIe switches that devices share
+	PCIeSwitches []PCIeSwitch
+	// NVSwitches is the number of NVSwitches that connect all devices. The
+	// links of each device are distributed evenly across the NVSwitches.
+	NVSwitches int
+	// NVLinksPerDevice is the number of NVLinks from each device to the
+	// NVSwitch fabric
+	NVLinksPerDevice int
+	// NVLinks holds direct NVLink connections between pairs of devices. Their
+	// links are numbered after the links to the NVSwitch fabric.
+	NVLinks []NVLink
+}
+
+// NUMANode describes a NUMA node of the host. NUMA nodes are also reported
+// as the sockets of the host.
+type NUMANode struct {
+	// CPUs holds the IDs of the CPUs of the NUMA node
+	CPUs []int
+	// Devices holds the indices of the devices attached to the NUMA node
+	Devices []int
+}
+
+// PCIeSwitch describes a PCIe switch hierarchy shared by devices
+type PCIeSwitch struct {
+	// Devices holds the indices of the devices behind the switch
+	Devices []int
+	// Level is the common ancestor reported for the devices. It defaults to
+	// nvml.TOPOLOGY_SINGLE.
+	Level nvml.GpuTopologyLevel
+}
+
+// NVLink describes direct NVLink connections between two devices
+type NVLink struct {
+	Devices [2]int
+	// Links is the number of NVLinks between the devices
+	Links int
+}
+
+// nvlinkRemote is the remote end of an NVLink
+type nvlinkRemote struct {
+	device   int
+	nvswitch int
+}
+
+// WithTopology attaches a topology to the server
+func WithTopology(t *Topology) Option {
+	return func(o *options) error {
+		o.topology = t
+		return nil
+	}
+}
+
+// CPURange returns the IDs of the CPUs from first to last, inclusive
+func CPURange(first, last int) []int {
+	var cpus []int
+	for cpu := first; cpu <= last; cpu++ {
+		cpus = append(cpus, cpu)
+	}
+	return cpus
+}
+
+// SetTopology attaches a topology to the server, replacing its topology
+// levels with the common ancestors derived from the topology.
+func (s *Server) SetTopology(t *Topology) error {
+	if err := t.validate(len(s.Devices)); err != nil {
+		return err
+	}
+	s.Topology = t
+	s.TopologyLevels = t.levels(len(s.Devices))
+	return nil
+}
+
+func (t *Topology) validate(count int) error {
+	checkDevice := func(index int) error {
+		if index < 0 || index >= count {
+			return fmt.Errorf("invalid device index %d for %d devices", index, count)
+		}
+		return nil
+	}
+
+	numaNodes := make(map[int]int)
+	for node, n := range t.NUMANodes {
+		for _, index := range n.Devices {
+			if err := checkDevice(index); err != nil {
+				return err
+			}
+			if other, exists := numaNodes[index]; exists {
+				return fmt.Errorf("device %d is attached to NUMA nodes %d and %d", index, other, node)
+			}
+			numaNodes[index] = node
+		}
+	}
+	for _, sw := range t.PCIeSwitches {
+		for _, index := range sw.Devices {
+			if err := checkDevice(index); err != nil {
+				return err
+			}
+		}
+	}
+	if t.NVLinksPerDevice > 0 && t.NVSwitches <= 0 {
+		return fmt.Errorf("NVLinks to the NVSwitch fabric require at least one NVSwitch")
+	}
+	links := t.NVLinksPerDevice
+	for _, link := range t.NVLinks {
+		for _, index := range link.Devices {
+			if err := checkDevice(index); err != nil {
+				return err
+			}
+		}
+		if link.Devices[0] == link.Devices[1] {
+			return fmt.Errorf("device %d cannot be linked to itself", link.Devices[0])
+		}
+		links += link.Links
+	}
+	if links > nvml.NVLINK_MAX_LINKS {
+		return fmt.Errorf("devices have more than %d NVLinks", nvml.NVLINK_MAX_LINKS)
+	}
+	return nil
+}
+
+// numaNode returns the NUMA node of a device, or -1 if it has none
+func (t *Topology) numaNode(index int) int {
+	for node, n := range t.NUMANodes {
+		for _, i := range n.Devices {
+			if i == index {
+				return node
+			}
+		}
+	}
+	return -1
+}
+
+// levels returns the common ancestor of each pair of devices
+func (t *Topology) levels(count int) [][]nvml.GpuTopologyLevel {
+	levels := make([][]nvml.GpuTopologyLevel, count)
+	for i := range levels {
+		levels[i] = make([]nvml.GpuTopologyLevel, count)
+		for j := range levels[i] {
+			levels[i][j] = t.level(i, j)
+		}
+	}
+	return levels
+}
+
+func (t *Topology) level(i, j int) nvml.GpuTopologyLevel {
+	if i == j {
+		return nvml.TOPOLOGY_INTERNAL
+	}
+	level := nvml.TOPOLOGY_SYSTEM
+	if node := t.numaNode(i); node >= 0 && node == t.numaNode(j) {
+		level = nvml.TOPOLOGY_NODE
+	}
+	for _, sw := range t.PCIeSwitches {
+		if !contains(sw.Devices, i) || !contains(sw.Devices, j) {
+			continue
+		}
+		swLevel := sw.Level
+		if swLevel == 0 {
+			swLevel = nvml.TOPOLOGY_SINGLE
+		}
+		if swLevel < level {
+			level = swLevel
+		}
+	}
+	return level
+}
+
+// nvlinks returns the remote ends of the NVLinks of a device, indexed by link
+func (t *Topology) nvlinks(index int) []nvlinkRemote {
+	var links []nvlinkRemote
+	for link := 0; link < t.NVLinksPerDevice; link++ {
+		links = append(links, nvlinkRemote{device: -1, nvswitch: link % t.NVSwitches})
+	}
+	for _, link := range t.NVLinks {
+		remote := -1
+		switch index {
+		case link.Devices[0]:
+			remote = link.Devices[1]
+		case link.Devices[1]:
+			remote = link.Devices[0]
+		}
+		if remote < 0 {
+			continue
+		}
+		for i := 0; i < link.Links; i++ {
+			links = append(links, nvlinkRemote{device: remote, nvswitch: -1})
+		}
+	}
+	return links
+}
+
+// nvlinkConnected returns whether two devices can reach each other over NVLink
+func (t *Topology) nvlinkConnected(i, j int) bool {
+	if t.NVLinksPerDevice > 0 {
+		return true
+	}
+	for _, link := range t.NVLinks {
+		if contains(link.Devices[:], i) && contains(link.Devices[:], j) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(indices []int, index int) bool {
+	for _, i := range indices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+// affinity returns a bitmask of size words with the specified bits set
+func affinity(bits []int, size int) []uint {
+	mask := make([]uint, size)
+	for _, bit := range bits {
+		word := bit / strconv.IntSize
+		if bit < 0 || word >= size {
+			continue
+		}
+		mask[word] |= 1 << (uint(bit) % strconv.IntSize)
+	}
+	return mask
+}
+
+// nvswitchPciInfo returns the PCI info of an NVSwitch
+func nvswitchPciInfo(index int) nvml.PciInfo {
+	busID := fmt.Sprintf("0000:%02x:00.0", 0xc0+index)
+	p := nvml.PciInfo{Bus: uint32(0xc0 + index)}
+	stringToInt8(busID, p.BusId[:])
+	stringToInt8(busID, p.BusIdLegacy[:])
+	return p
+}
+
+// setTopologyFuncs configures the topology queries of the server
+func (s *Server) setTopologyFuncs() {
+	s.DeviceGetTopologyCommonAncestorFunc = func(d1 nvml.Device, d2 nvml.Device) (nvml.GpuTopologyLevel, nvml.Return) {
+		return d1.GetTopologyCommonAncestor(d2)
+	}
+
+	s.DeviceGetTopologyNearestGpusFunc = func(d nvml.Device, level nvml.GpuTopologyLevel) ([]nvml.Device, nvml.Return) {
+		return d.GetTopologyNearestGpus(level)
+	}
+
+	s.DeviceGetP2PStatusFunc = func(d1 nvml.Device, d2 nvml.Device, index nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, nvml.Return) {
+		return d1.GetP2PStatus(d2, index)
+	}
+
+	s.DeviceGetNvLinkStateFunc = func(d nvml.Device, link int) (nvml.EnableState, nvml.Return) {
+		return d.GetNvLinkState(link)
+	}
+
+	s.DeviceGetNvLinkRemotePciInfoFunc = func(d nvml.Device, link int) (nvml.PciInfo, nvml.Return) {
+		return d.GetNvLinkRemotePciInfo(link)
+	}
+
+	s.DeviceGetNvLinkRemoteDeviceTypeFunc = func(d nvml.Device, link int) (nvml.IntNvLinkDeviceType, nvml.Return) {
+		return d.GetNvLinkRemoteDeviceType(link)
+	}
+
+	s.DeviceGetCpuAffinityFunc = func(d nvml.Device, cpuSetSize int) ([]uint, nvml.Return) {
+		return d.GetCpuAffinity(cpuSetSize)
+	}
+
+	s.DeviceGetCpuAffinityWithinScopeFunc = func(d nvml.Device, cpuSetSize int, scope nvml.AffinityScope) ([]uint, nvml.Return) {
+		return d.GetCpuAffinityWithinScope(cpuSetSize, scope)
+	}
+
+	s.DeviceGetMemoryAffinityFunc = func(d nvml.Device, nodeSetSize int, scope nvml.AffinityScope) ([]uint, nvml.Return) {
+		return d.GetMemoryAffinity(nodeSetSize, scope)
+	}
+
+	s.DeviceGetNumaNodeIdFunc = func(d nvml.Device) (int, nvml.Return) {
+		return d.GetNumaNodeId()
+	}
+
+	s.SystemGetTopologyGpuSetFunc = func(cpu int) ([]nvml.Device, nvml.Return) {
+		if s.Topology == nil {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var devices []nvml.Device
+		for _, n := range s.Topology.NUMANodes {
+			if !contains(n.CPUs, cpu) {
+				continue
+			}
+			for _, index := range n.Devices {
+				devices = append(devices, s.Devices[index])
+			}
+		}
+		return devices, nvml.SUCCESS
+	}
+
+	for _, d := range s.Devices {
+		if device, ok := d.(*Device); ok {
+			s.setDeviceTopologyFuncs(device)
+		}
+	}
+}
+
+// setDeviceTopologyFuncs configures the topology queries of a device to use
+// the topology of the server.
+func (s *Server) setDeviceTopologyFuncs(d *Device) {
+	other := func(device nvml.Device) (*Device, nvml.Return) {
+		o, ok := device.(*Device)
+		if !ok || o.Index >= len(s.Devices) || s.Devices[o.Index] != o {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return o, nvml.SUCCESS
+	}
+
+	d.GetTopologyCommonAncestorFunc = func(device nvml.Device) (nvml.GpuTopologyLevel, nvml.Return) {
+		if s.TopologyLevels == nil {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		o, ok := device.(*Device)
+		if !ok {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if d.Index >= len(s.TopologyLevels) || o.Index >= len(s.TopologyLevels[d.Index]) {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return s.TopologyLevels[d.Index][o.Index], nvml.SUCCESS
+	}
+
+	d.GetTopologyNearestGpusFunc = func(level nvml.GpuTopologyLevel) ([]nvml.Device, nvml.Return) {
+		if s.TopologyLevels == nil || d.Index >= len(s.TopologyLevels) {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var devices []nvml.Device
+		for i, l := range s.TopologyLevels[d.Index] {
+			if i != d.Index && i < len(s.Devices) && l <= level {
+				devices = append(devices, s.Devices[i])
+			}
+		}
+		return devices, nvml.SUCCESS
+	}
+
+	d.GetP2PStatusFunc = func(device nvml.Device, index nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, nvml.Return) {
+		if s.Topology == nil {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		o, ret := other(device)
+		if ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		if s.Topology.nvlinkConnected(d.Index, o.Index) {
+			return nvml.P2P_STATUS_OK, nvml.SUCCESS
+		}
+		switch index {
+		case nvml.P2P_CAPS_INDEX_NVLINK, nvml.P2P_CAPS_INDEX_ATOMICS:
+			return nvml.P2P_STATUS_NOT_SUPPORTED, nvml.SUCCESS
+		case nvml.P2P_CAPS_INDEX_READ, nvml.P2P_CAPS_INDEX_WRITE, nvml.P2P_CAPS_INDEX_PCI:
+			if s.Topology.level(d.Index, o.Index) <= nvml.TOPOLOGY_NODE {
+				return nvml.P2P_STATUS_OK, nvml.SUCCESS
+			}
+			return nvml.P2P_STATUS_CHIPSET_NOT_SUPPORTED, nvml.SUCCESS
+		}
+		return 0, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	nvlink := func(link int) (nvlinkRemote, nvml.Return) {
+		if s.Topology == nil {
+			return nvlinkRemote{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		if link < 0 || link >= nvml.NVLINK_MAX_LINKS {
+			return nvlinkRemote{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+		links := s.Topology.nvlinks(d.Index)
+		if link >= len(links) {
+			return nvlinkRemote{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		return links[link], nvml.SUCCESS
+	}
+
+	d.GetNvLinkStateFunc = func(link int) (nvml.EnableState, nvml.Return) {
+		if _, ret := nvlink(link); ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		return nvml.FEATURE_ENABLED, nvml.SUCCESS
+	}
+
+	d.GetNvLinkRemotePciInfoFunc = func(link int) (nvml.PciInfo, nvml.Return) {
+		remote, ret := nvlink(link)
+		if ret != nvml.SUCCESS {
+			return nvml.PciInfo{}, ret
+		}
+		if remote.device < 0 {
+			return nvswitchPciInfo(remote.nvswitch), nvml.SUCCESS
+		}
+		return s.Devices[remote.device].GetPciInfo()
+	}
+
+	d.GetNvLinkRemoteDeviceTypeFunc = func(link int) (nvml.IntNvLinkDeviceType, nvml.Return) {
+		remote, ret := nvlink(link)
+		if ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		if remote.device < 0 {
+			return nvml.NVLINK_DEVICE_TYPE_SWITCH, nvml.SUCCESS
+		}
+		return nvml.NVLINK_DEVICE_TYPE_GPU, nvml.SUCCESS
+	}
+
+	numaNode := func() (int, nvml.Return) {
+		if s.Topology == nil {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		node := s.Topology.numaNode(d.Index)
+		if node < 0 {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return node, nvml.SUCCESS
+	}
+
+	d.GetNumaNodeIdFunc = numaNode
+
+	d.GetCpuAffinityFunc = func(cpuSetSize int) ([]uint, nvml.Return) {
+		return d.GetCpuAffinityWithinScope(cpuSetSize, nvml.AFFINITY_SCOPE_NODE)
+	}
+
+	d.GetCpuAffinityWithinScopeFunc = func(cpuSetSize int, scope nvml.AffinityScope) ([]uint, nvml.Return) {
+		if cpuSetSize <= 0 {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		node, ret := numaNode()
+		if ret != nvml.SUCCESS {
+			return nil, ret
+		}
+		return affinity(s.Topology.NUMANodes[node].CPUs, cpuSetSize), nvml.SUCCESS
+	}
+
+	d.GetMemoryAffinityFunc = func(nodeSetSize int, scope nvml.AffinityScope) ([]uint, nvml.Return) {
+		if nodeSetSize <= 0 {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		node, ret := numaNode()
+		if ret != nvml.SUCCESS {
+			return nil, ret
+		}
+		return affinity([]int{node}, nodeSetSize), nvml.SUCCESS
+	}
+}
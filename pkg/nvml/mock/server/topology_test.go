@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestTopology(t *testing.T) {
+	s, err := New(
+		WithGPUs(gpus.Multiple(4, gpus.A30_PCIE_24GB)...),
+		WithTopology(&Topology{
+			NUMANodes: []NUMANode{
+				{CPUs: CPURange(0, 7), Devices: []int{0, 1, 2}},
+				{CPUs: CPURange(64, 71), Devices: []int{3}},
+			},
+			PCIeSwitches: []PCIeSwitch{{Devices: []int{0, 1}}},
+			NVLinks:      []NVLink{{Devices: [2]int{0, 1}, Links: 4}},
+		}),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		d1, d2 int
+		level  nvml.GpuTopologyLevel
+	}{
+		{0, 0, nvml.TOPOLOGY_INTERNAL},
+		{0, 1, nvml.TOPOLOGY_SINGLE},
+		{0, 2, nvml.TOPOLOGY_NODE},
+		{2, 3, nvml.TOPOLOGY_SYSTEM},
+	}
+	for _, tc := range testCases {
+		level, ret := s.DeviceGetTopologyCommonAncestor(s.Devices[tc.d1], s.Devices[tc.d2])
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, tc.level, level, "devices %d and %d", tc.d1, tc.d2)
+	}
+
+	nearest, ret := s.DeviceGetTopologyNearestGpus(s.Devices[0], nvml.TOPOLOGY_NODE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.Device{s.Devices[1], s.Devices[2]}, nearest)
+
+	gpuSet, ret := s.SystemGetTopologyGpuSet(65)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.Device{s.Devices[3]}, gpuSet)
+
+	node, ret := s.DeviceGetNumaNodeId(s.Devices[3])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 1, node)
+
+	cpus, ret := s.DeviceGetCpuAffinity(s.Devices[3], 2)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint{0, 0xff}, cpus)
+
+	nodes, ret := s.DeviceGetMemoryAffinity(s.Devices[3], 1, nvml.AFFINITY_SCOPE_NODE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint{0x2}, nodes)
+
+	status, ret := s.DeviceGetP2PStatus(s.Devices[0], s.Devices[1], nvml.P2P_CAPS_INDEX_NVLINK)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_OK, status)
+	status, ret = s.DeviceGetP2PStatus(s.Devices[0], s.Devices[2], nvml.P2P_CAPS_INDEX_NVLINK)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_NOT_SUPPORTED, status)
+	status, ret = s.DeviceGetP2PStatus(s.Devices[0], s.Devices[2], nvml.P2P_CAPS_INDEX_READ)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_OK, status)
+	status, ret = s.DeviceGetP2PStatus(s.Devices[0], s.Devices[3], nvml.P2P_CAPS_INDEX_READ)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_CHIPSET_NOT_SUPPORTED, status)
+
+	state, ret := s.DeviceGetNvLinkState(s.Devices[1], 3)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.FEATURE_ENABLED, state)
+	_, ret = s.DeviceGetNvLinkState(s.Devices[1], 4)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	_, ret = s.DeviceGetNvLinkState(s.Devices[2], 0)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	remote, ret := s.DeviceGetNvLinkRemotePciInfo(s.Devices[1], 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	local, ret := s.Devices[0].GetPciInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, local, remote)
+
+	deviceType, ret := s.DeviceGetNvLinkRemoteDeviceType(s.Devices[1], 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.NVLINK_DEVICE_TYPE_GPU, deviceType)
+}
+
+func TestTopologyNVSwitch(t *testing.T) {
+	s, err := New(
+		WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...),
+		WithTopology(&Topology{NVSwitches: 4, NVLinksPerDevice: 18}),
+	)
+	require.NoError(t, err)
+
+	for link := 0; link < 18; link++ {
+		deviceType, ret := s.Devices[0].GetNvLinkRemoteDeviceType(link)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.NVLINK_DEVICE_TYPE_SWITCH, deviceType)
+	}
+	_, ret := s.Devices[0].GetNvLinkState(18)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	_, ret = s.Devices[0].GetNvLinkState(nvml.NVLINK_MAX_LINKS)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	status, ret := s.Devices[0].GetP2PStatus(s.Devices[1], nvml.P2P_CAPS_INDEX_ATOMICS)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.P2P_STATUS_OK, status)
+
+	_, ret = s.Devices[0].GetNumaNodeId()
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestTopologyErrors(t *testing.T) {
+	testCases := []struct {
+		description string
+		topology    Topology
+	}{
+		{"device out of range", Topology{NUMANodes: []NUMANode{{Devices: []int{2}}}}},
+		{"device on two NUMA nodes", Topology{NUMANodes: []NUMANode{{Devices: []int{0}}, {Devices: []int{0}}}}},
+		{"links without NVSwitch", Topology{NVLinksPerDevice: 4}},
+		{"self link", Topology{NVLinks: []NVLink{{Devices: [2]int{1, 1}, Links: 1}}}},
+		{"too many links", Topology{NVSwitches: 1, NVLinksPerDevice: nvml.NVLINK_MAX_LINKS + 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			topology := tc.topology
+			_, err := New(WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...), WithTopology(&topology))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNoTopology(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.H100_SXM5_80GB)...))
+	require.NoError(t, err)
+
+	_, ret := s.DeviceGetTopologyCommonAncestor(s.Devices[0], s.Devices[1])
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	_, ret = s.DeviceGetP2PStatus(s.Devices[0], s.Devices[1], nvml.P2P_CAPS_INDEX_READ)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	_, ret = s.SystemGetTopologyGpuSet(0)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
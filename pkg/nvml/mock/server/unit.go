@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// Unit temperature sensors as passed to Unit.GetTemperature
+const (
+	UnitTemperatureIntake = iota
+	UnitTemperatureExhaust
+	UnitTemperatureBoard
+	unitTemperatureCount
+)
+
+// unitMaxFans is the maximum number of fans reported for a unit
+const unitMaxFans = len(nvml.UnitFanSpeeds{}.Fans)
+
+// UnitConfig describes an S-class unit
+type UnitConfig struct {
+	Name            string
+	ID              string
+	Serial          string
+	FirmwareVersion string
+	// Devices holds the indices of the devices in the unit
+	Devices []int
+	PSU     UnitPSU
+	// FanSpeeds holds the initial speed of each fan in RPM
+	FanSpeeds []uint32
+	// Temperatures holds the initial temperatures in degrees C, indexed by sensor
+	Temperatures [unitTemperatureCount]uint32
+}
+
+// UnitPSU holds the readings of the power supply of a unit
+type UnitPSU struct {
+	State   string
+	Current uint32 // A
+	Voltage uint32 // V
+	Power   uint32 // W
+}
+
+// Unit provides a reusable S-class unit implementation. The readings can be
+// changed while holding the unit lock.
+type Unit struct {
+	mock.Unit
+	sync.RWMutex
+	Config       UnitConfig
+	Devices      []*Device
+	LedColor     nvml.LedColor
+	LedCause     string
+	PSU          UnitPSU
+	Fans         []nvml.UnitFanInfo
+	Temperatures [unitTemperatureCount]uint32
+}
+
+var _ nvml.Unit = (*Unit)(nil)
+
+// WithUnits groups devices into S-class units
+func WithUnits(units ...UnitConfig) Option {
+	return func(o *options) error {
+		o.units = append(o.units, units...)
+		return nil
+	}
+}
+
+// NewUnitFromConfig creates a new unit containing the specified devices
+func NewUnitFromConfig(config UnitConfig, devices []*Device) *Unit {
+	u := &Unit{
+		Config:       config,
+		Devices:      devices,
+		LedColor:     nvml.LED_COLOR_GREEN,
+		PSU:          config.PSU,
+		Temperatures: config.Temperatures,
+	}
+	for _, speed := range config.FanSpeeds {
+		u.Fans = append(u.Fans, nvml.UnitFanInfo{Speed: speed, State: uint32(nvml.FAN_NORMAL)})
+	}
+	u.SetMockFuncs()
+	return u
+}
+
+// SetUnits groups the devices of the server into units
+func (s *Server) SetUnits(configs ...UnitConfig) error {
+	owner := make(map[int]int)
+	var units []nvml.Unit
+	for i, config := range configs {
+		if len(config.FanSpeeds) > unitMaxFans {
+			return fmt.Errorf("unit %d: more than %d fans", i, unitMaxFans)
+		}
+		var devices []*Device
+		for _, index := range config.Devices {
+			if index < 0 || index >= len(s.Devices) {
+				return fmt.Errorf("unit %d: invalid device index %d", i, index)
+			}
+			if u, exists := owner[index]; exists {
+				return fmt.Errorf("unit %d: device %d already belongs to unit %d", i, index, u)
+			}
+			owner[index] = i
+			devices = append(devices, s.Devices[index].(*Device))
+		}
+		units = append(units, NewUnitFromConfig(config, devices))
+	}
+	s.Units = units
+	return nil
+}
+
+// SetFan sets the speed and state of a fan of the unit
+func (u *Unit) SetFan(fan int, speed uint32, state nvml.FanState) error {
+	u.Lock()
+	defer u.Unlock()
+	if fan < 0 || fan >= len(u.Fans) {
+		return fmt.Errorf("invalid fan %d", fan)
+	}
+	u.Fans[fan] = nvml.UnitFanInfo{Speed: speed, State: uint32(state)}
+	return nil
+}
+
+// SetMockFuncs configures all the mock function implementations for the unit
+func (u *Unit) SetMockFuncs() {
+	u.GetUnitInfoFunc = func() (nvml.UnitInfo, nvml.Return) {
+		var info nvml.UnitInfo
+		stringToInt8(u.Config.Name, info.Name[:])
+		stringToInt8(u.Config.ID, info.Id[:])
+		stringToInt8(u.Config.Serial, info.Serial[:])
+		stringToInt8(u.Config.FirmwareVersion, info.FirmwareVersion[:])
+		return info, nvml.SUCCESS
+	}
+
+	u.GetDevicesFunc = func() ([]nvml.Device, nvml.Return) {
+		devices := make([]nvml.Device, len(u.Devices))
+		for i, d := range u.Devices {
+			devices[i] = d
+		}
+		return devices, nvml.SUCCESS
+	}
+
+	u.GetLedStateFunc = func() (nvml.LedState, nvml.Return) {
+		u.RLock()
+		defer u.RUnlock()
+		state := nvml.LedState{Color: uint32(u.LedColor)}
+		stringToInt8(u.LedCause, state.Cause[:])
+		return state, nvml.SUCCESS
+	}
+
+	u.SetLedStateFunc = func(color nvml.LedColor) nvml.Return {
+		if color != nvml.LED_COLOR_GREEN && color != nvml.LED_COLOR_AMBER {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		u.Lock()
+		defer u.Unlock()
+		u.LedColor, u.LedCause = color, ""
+		return nvml.SUCCESS
+	}
+
+	u.GetPsuInfoFunc = func() (nvml.PSUInfo, nvml.Return) {
+		u.RLock()
+		defer u.RUnlock()
+		info := nvml.PSUInfo{
+			Current: u.PSU.Current,
+			Voltage: u.PSU.Voltage,
+			Power:   u.PSU.Power,
+		}
+		stringToInt8(u.PSU.State, info.State[:])
+		return info, nvml.SUCCESS
+	}
+
+	u.GetFanSpeedInfoFunc = func() (nvml.UnitFanSpeeds, nvml.Return) {
+		u.RLock()
+		defer u.RUnlock()
+		var speeds nvml.UnitFanSpeeds
+		speeds.Count = uint32(copy(speeds.Fans[:], u.Fans))
+		return speeds, nvml.SUCCESS
+	}
+
+	u.GetTemperatureFunc = func(sensor int) (uint32, nvml.Return) {
+		if sensor < 0 || sensor >= unitTemperatureCount {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		u.RLock()
+		defer u.RUnlock()
+		return u.Temperatures[sensor], nvml.SUCCESS
+	}
+}
+
+// setUnitFuncs configures the server-level unit functions
+func (s *Server) setUnitFuncs() {
+	s.UnitGetCountFunc = func() (int, nvml.Return) {
+		return len(s.Units), nvml.SUCCESS
+	}
+
+	s.UnitGetHandleByIndexFunc = func(index int) (nvml.Unit, nvml.Return) {
+		if index < 0 || index >= len(s.Units) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return s.Units[index], nvml.SUCCESS
+	}
+
+	s.UnitGetUnitInfoFunc = func(u nvml.Unit) (nvml.UnitInfo, nvml.Return) {
+		return u.GetUnitInfo()
+	}
+
+	s.UnitGetDevicesFunc = func(u nvml.Unit) ([]nvml.Device, nvml.Return) {
+		return u.GetDevices()
+	}
+
+	s.UnitGetLedStateFunc = func(u nvml.Unit) (nvml.LedState, nvml.Return) {
+		return u.GetLedState()
+	}
+
+	s.UnitSetLedStateFunc = func(u nvml.Unit, color nvml.LedColor) nvml.Return {
+		return u.SetLedState(color)
+	}
+
+	s.UnitGetPsuInfoFunc = func(u nvml.Unit) (nvml.PSUInfo, nvml.Return) {
+		return u.GetPsuInfo()
+	}
+
+	s.UnitGetFanSpeedInfoFunc = func(u nvml.Unit) (nvml.UnitFanSpeeds, nvml.Return) {
+		return u.GetFanSpeedInfo()
+	}
+
+	s.UnitGetTemperatureFunc = func(u nvml.Unit, sensor int) (uint32, nvml.Return) {
+		return u.GetTemperature(sensor)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+func TestUnits(t *testing.T) {
+	s, err := New(
+		WithGPUs(gpus.Multiple(4, gpus.A100_PCIE_40GB)...),
+		WithUnits(
+			UnitConfig{
+				Name:            "S-Class Unit",
+				ID:              "unit-0",
+				Serial:          "0324111111111",
+				FirmwareVersion: "6.1",
+				Devices:         []int{0, 1},
+				PSU:             UnitPSU{State: "Normal", Current: 12, Voltage: 220, Power: 2640},
+				FanSpeeds:       []uint32{4800, 4900},
+				Temperatures:    [3]uint32{25, 40, 35},
+			},
+			UnitConfig{Name: "S-Class Unit", ID: "unit-1", Devices: []int{2, 3}},
+		),
+	)
+	require.NoError(t, err)
+
+	count, ret := s.UnitGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, count)
+	_, ret = s.UnitGetHandleByIndex(2)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	unit, ret := s.UnitGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	info, ret := s.UnitGetUnitInfo(unit)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "unit-0", int8ToString(info.Id[:]))
+	require.Equal(t, "6.1", int8ToString(info.FirmwareVersion[:]))
+
+	devices, ret := unit.GetDevices()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.Device{s.Devices[0], s.Devices[1]}, devices)
+
+	psu, ret := unit.GetPsuInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "Normal", int8ToString(psu.State[:]))
+	require.Equal(t, uint32(2640), psu.Power)
+
+	temperature, ret := s.UnitGetTemperature(unit, UnitTemperatureExhaust)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(40), temperature)
+	_, ret = unit.GetTemperature(3)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	require.NoError(t, unit.(*Unit).SetFan(1, 0, nvml.FAN_FAILED))
+	fans, ret := unit.GetFanSpeedInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(2), fans.Count)
+	require.Equal(t, nvml.UnitFanInfo{Speed: 4800, State: uint32(nvml.FAN_NORMAL)}, fans.Fans[0])
+	require.Equal(t, nvml.UnitFanInfo{Speed: 0, State: uint32(nvml.FAN_FAILED)}, fans.Fans[1])
+
+	state, ret := unit.GetLedState()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(nvml.LED_COLOR_GREEN), state.Color)
+	require.Equal(t, nvml.SUCCESS, s.UnitSetLedState(unit, nvml.LED_COLOR_AMBER))
+	state, ret = unit.GetLedState()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(nvml.LED_COLOR_AMBER), state.Color)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, unit.SetLedState(2))
+}
+
+func TestUnitsErrors(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_PCIE_40GB))
+	require.NoError(t, err)
+	count, ret := s.UnitGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 0, count)
+
+	_, err = New(WithGPUs(gpus.A100_PCIE_40GB), WithUnits(UnitConfig{Devices: []int{1}}))
+	require.Error(t, err)
+	_, err = New(WithGPUs(gpus.A100_PCIE_40GB), WithUnits(UnitConfig{Devices: []int{0}}, UnitConfig{Devices: []int{0}}))
+	require.Error(t, err)
+	_, err = New(WithGPUs(gpus.A100_PCIE_40GB), WithUnits(UnitConfig{FanSpeeds: make([]uint32, 25)}))
+	require.Error(t, err)
+}
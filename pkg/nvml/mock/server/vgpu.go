@@ -0,0 +1,807 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// vgpuBAR1SizeMB is the BAR1 size reported for all vGPU types
+const vgpuBAR1SizeMB = 256
+
+// vgpuInstanceCounter provides the IDs of vGPU instances, which are unique
+// across devices
+var vgpuInstanceCounter atomic.Uint32
+
+// VgpuType describes a vGPU type that can be created on a device
+type VgpuType struct {
+	ID   uint32
+	Name string
+	// Class is the vGPU class, e.g. "Compute", "Quadro" or "NVS"
+	Class string
+	// License is the license string, e.g. "NVIDIA-Virtual-Compute-Server,9.0"
+	License           string
+	FramebufferMB     uint64
+	MaxInstances      int
+	MaxInstancesPerVm int
+	NumDisplayHeads   int
+	MaxResolutionX    uint32
+	MaxResolutionY    uint32
+	FrameRateLimit    uint32
+	// PlacementSize is the number of placement slots occupied by an instance.
+	// If zero, the slots of the device are divided by MaxInstances.
+	PlacementSize uint32
+}
+
+// VgpuTypeId provides a reusable vGPU type implementation
+type VgpuTypeId struct {
+	mock.VgpuTypeId
+	VgpuType
+	device *Device
+}
+
+var _ nvml.VgpuTypeId = (*VgpuTypeId)(nil)
+
+// VgpuInstance provides a reusable vGPU instance implementation
+type VgpuInstance struct {
+	mock.VgpuInstance
+	sync.RWMutex
+	ID              uint32
+	Type            *VgpuTypeId
+	Device          *Device
+	UUID            string
+	MdevUUID        string
+	VmID            string
+	VmIDType        nvml.VgpuVmIdType
+	VmDriverVersion string
+	// FbUsage is the framebuffer usage reported for the instance in bytes
+	FbUsage         uint64
+	Licensed        bool
+	EncoderCapacity int
+	// Placement is the first placement slot occupied by the instance
+	Placement uint32
+}
+
+var _ nvml.VgpuInstance = (*VgpuInstance)(nil)
+
+// WithVgpuTypes enables vGPU support with the specified types on all devices
+func WithVgpuTypes(types ...VgpuType) Option {
+	return func(o *options) error {
+		o.vgpuTypes = types
+		return nil
+	}
+}
+
+// WithDeviceVgpuTypes enables vGPU support with the specified types on the
+// device with the specified index
+func WithDeviceVgpuTypes(index int, types ...VgpuType) Option {
+	return func(o *options) error {
+		if o.deviceVgpuTypes == nil {
+			o.deviceVgpuTypes = make(map[int][]VgpuType)
+		}
+		o.deviceVgpuTypes[index] = types
+		return nil
+	}
+}
+
+// SetVgpuTypes sets the vGPU types supported by the device. vGPU support is
+// disabled if no types are specified.
+func (d *Device) SetVgpuTypes(types ...VgpuType) error {
+	d.Lock()
+	defer d.Unlock()
+	if len(d.VgpuInstances) > 0 {
+		return fmt.Errorf("device %d has active vGPU instances", d.Index)
+	}
+
+	var slots uint32
+	for _, t := range types {
+		if t.MaxInstances <= 0 {
+			return fmt.Errorf("vGPU type %d: MaxInstances must be positive", t.ID)
+		}
+		size := t.PlacementSize
+		if size == 0 {
+			size = 1
+		}
+		if n := uint32(t.MaxInstances) * size; n > slots {
+			slots = n
+		}
+	}
+
+	vgpuTypes := make(map[uint32]*VgpuTypeId)
+	for _, t := range types {
+		if _, exists := vgpuTypes[t.ID]; exists {
+			return fmt.Errorf("duplicate vGPU type %d", t.ID)
+		}
+		if t.FramebufferMB > d.Config.MemoryMB {
+			return fmt.Errorf("vGPU type %d: framebuffer exceeds device memory", t.ID)
+		}
+		if t.PlacementSize == 0 {
+			t.PlacementSize = slots / uint32(t.MaxInstances)
+		}
+		vgpuType := &VgpuTypeId{VgpuType: t, device: d}
+		vgpuType.SetMockFuncs()
+		vgpuTypes[t.ID] = vgpuType
+	}
+	d.VgpuTypes = vgpuTypes
+	d.vgpuPlacementSlots = slots
+	return nil
+}
+
+// CreateVgpu creates a vGPU instance of the specified type for a VM at the
+// lowest creatable placement. This is done by the hypervisor on a real host.
+func (d *Device) CreateVgpu(typeID uint32, vmID string) (*VgpuInstance, nvml.Return) {
+	d.Lock()
+	defer d.Unlock()
+	t, exists := d.VgpuTypes[typeID]
+	if !exists {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+	placements := d.creatablePlacements(t)
+	if len(placements) == 0 {
+		return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+	}
+	return d.createVgpu(t, placements[0], vmID), nvml.SUCCESS
+}
+
+// CreateVgpuWithPlacement creates a vGPU instance of the specified type for a
+// VM at the specified placement
+func (d *Device) CreateVgpuWithPlacement(typeID uint32, placementID uint32, vmID string) (*VgpuInstance, nvml.Return) {
+	d.Lock()
+	defer d.Unlock()
+	t, exists := d.VgpuTypes[typeID]
+	if !exists {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+	for _, p := range d.creatablePlacements(t) {
+		if p == placementID {
+			return d.createVgpu(t, p, vmID), nvml.SUCCESS
+		}
+	}
+	return nil, nvml.ERROR_INSUFFICIENT_RESOURCES
+}
+
+// createVgpu adds a vGPU instance to the device. The caller must hold the
+// device lock.
+func (d *Device) createVgpu(t *VgpuTypeId, placement uint32, vmID string) *VgpuInstance {
+	id := uuid.New().String()
+	v := &VgpuInstance{
+		ID:              vgpuInstanceCounter.Add(1),
+		Type:            t,
+		Device:          d,
+		UUID:            id,
+		MdevUUID:        id,
+		VmID:            vmID,
+		VmIDType:        nvml.VGPU_VM_ID_UUID,
+		Licensed:        true,
+		EncoderCapacity: 100,
+		Placement:       placement,
+	}
+	v.SetMockFuncs()
+	d.VgpuInstances[v.ID] = v
+	return v
+}
+
+// DestroyVgpu removes a vGPU instance from the device
+func (d *Device) DestroyVgpu(v *VgpuInstance) nvml.Return {
+	d.Lock()
+	defer d.Unlock()
+	if d.VgpuInstances[v.ID] != v {
+		return nvml.ERROR_NOT_FOUND
+	}
+	delete(d.VgpuInstances, v.ID)
+	return nvml.SUCCESS
+}
+
+// supportedPlacements returns the placements of a vGPU type on the device
+func (d *Device) supportedPlacements(t *VgpuTypeId) []uint32 {
+	var placements []uint32
+	for p := uint32(0); p+t.PlacementSize <= d.vgpuPlacementSlots; p += t.PlacementSize {
+		placements = append(placements, p)
+	}
+	return placements
+}
+
+// creatablePlacements returns the placements at which an instance of a vGPU
+// type can currently be created. In homogeneous mode all instances on a
+// device must be of the same type. The caller must hold the device lock.
+func (d *Device) creatablePlacements(t *VgpuTypeId) []uint32 {
+	var instances int
+	var framebufferMB uint64
+	for _, v := range d.VgpuInstances {
+		if !d.VgpuHeterogeneousMode && v.Type.ID != t.ID {
+			return nil
+		}
+		if v.Type.ID == t.ID {
+			instances++
+		}
+		framebufferMB += v.Type.FramebufferMB
+	}
+	if instances >= t.MaxInstances || framebufferMB+t.FramebufferMB > d.Config.MemoryMB {
+		return nil
+	}
+
+	var placements []uint32
+	for _, p := range d.supportedPlacements(t) {
+		free := true
+		for _, v := range d.VgpuInstances {
+			if p < v.Placement+v.Type.PlacementSize && v.Placement < p+t.PlacementSize {
+				free = false
+				break
+			}
+		}
+		if free {
+			placements = append(placements, p)
+		}
+	}
+	return placements
+}
+
+// vgpuTypes returns the specified vGPU types ordered by ID
+func vgpuTypes(types []*VgpuTypeId) []nvml.VgpuTypeId {
+	sort.Slice(types, func(i, j int) bool { return types[i].ID < types[j].ID })
+	ids := make([]nvml.VgpuTypeId, len(types))
+	for i, t := range types {
+		ids[i] = t
+	}
+	return ids
+}
+
+// placementList returns the placement list for a vGPU type on the device.
+// The caller must hold the device lock.
+func (d *Device) placementList(t *VgpuTypeId, placements []uint32) nvml.VgpuPlacementList {
+	list := nvml.VgpuPlacementList{
+		PlacementSize: t.PlacementSize,
+		Count:         uint32(len(placements)),
+		Mode:          nvml.VGPU_PGPU_HOMOGENEOUS_MODE,
+	}
+	list.Version = nvml.STRUCT_VERSION(list, 1)
+	if d.VgpuHeterogeneousMode {
+		list.Mode = nvml.VGPU_PGPU_HETEROGENEOUS_MODE
+	}
+	if len(placements) > 0 {
+		list.PlacementIds = &placements[0]
+	}
+	return list
+}
+
+// vgpuType returns the vGPU type with the ID of t on the specified device
+func vgpuType(device nvml.Device, t nvml.VgpuTypeId) (*VgpuTypeId, nvml.Return) {
+	d, ok := device.(*Device)
+	if !ok {
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+	id, ok := t.(*VgpuTypeId)
+	if !ok {
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+	d.RLock()
+	defer d.RUnlock()
+	vgpuType, exists := d.VgpuTypes[id.ID]
+	if !exists {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+	return vgpuType, nvml.SUCCESS
+}
+
+// setVgpuFuncs configures the vGPU queries of a device
+func (d *Device) setVgpuFuncs() {
+	d.GetVirtualizationModeFunc = func() (nvml.GpuVirtualizationMode, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return nvml.GPU_VIRTUALIZATION_MODE_NONE, nvml.SUCCESS
+		}
+		return nvml.GPU_VIRTUALIZATION_MODE_HOST_VGPU, nvml.SUCCESS
+	}
+
+	d.GetHostVgpuModeFunc = func() (nvml.HostVgpuMode, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return nvml.HOST_VGPU_MODE_SRIOV, nvml.SUCCESS
+	}
+
+	d.GetSupportedVgpusFunc = func() ([]nvml.VgpuTypeId, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var types []*VgpuTypeId
+		for _, t := range d.VgpuTypes {
+			types = append(types, t)
+		}
+		return vgpuTypes(types), nvml.SUCCESS
+	}
+
+	d.GetCreatableVgpusFunc = func() ([]nvml.VgpuTypeId, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var types []*VgpuTypeId
+		for _, t := range d.VgpuTypes {
+			if len(d.creatablePlacements(t)) > 0 {
+				types = append(types, t)
+			}
+		}
+		return vgpuTypes(types), nvml.SUCCESS
+	}
+
+	d.GetActiveVgpusFunc = func() ([]nvml.VgpuInstance, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+		var ids []uint32
+		for id := range d.VgpuInstances {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		instances := make([]nvml.VgpuInstance, len(ids))
+		for i, id := range ids {
+			instances[i] = d.VgpuInstances[id]
+		}
+		return instances, nvml.SUCCESS
+	}
+
+	d.GetVgpuHeterogeneousModeFunc = func() (nvml.VgpuHeterogeneousMode, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		if len(d.VgpuTypes) == 0 {
+			return nvml.VgpuHeterogeneousMode{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		mode := nvml.VgpuHeterogeneousMode{Mode: nvml.VGPU_PGPU_HOMOGENEOUS_MODE}
+		mode.Version = nvml.STRUCT_VERSION(mode, 1)
+		if d.VgpuHeterogeneousMode {
+			mode.Mode = nvml.VGPU_PGPU_HETEROGENEOUS_MODE
+		}
+		return mode, nvml.SUCCESS
+	}
+
+	d.SetVgpuHeterogeneousModeFunc = func(mode nvml.VgpuHeterogeneousMode) nvml.Return {
+		if mode.Mode != nvml.VGPU_PGPU_HETEROGENEOUS_MODE && mode.Mode != nvml.VGPU_PGPU_HOMOGENEOUS_MODE {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		d.Lock()
+		defer d.Unlock()
+		if len(d.VgpuTypes) == 0 {
+			return nvml.ERROR_NOT_SUPPORTED
+		}
+		if len(d.VgpuInstances) > 0 {
+			return nvml.ERROR_IN_USE
+		}
+		d.VgpuHeterogeneousMode = mode.Mode == nvml.VGPU_PGPU_HETEROGENEOUS_MODE
+		return nvml.SUCCESS
+	}
+
+	d.GetVgpuTypeSupportedPlacementsFunc = func(t nvml.VgpuTypeId) (nvml.VgpuPlacementList, nvml.Return) {
+		return t.GetSupportedPlacements(d)
+	}
+
+	d.GetVgpuTypeCreatablePlacementsFunc = func(t nvml.VgpuTypeId) (nvml.VgpuPlacementList, nvml.Return) {
+		return t.GetCreatablePlacements(d)
+	}
+}
+
+// SetMockFuncs configures all the mock function implementations for the vGPU type
+func (t *VgpuTypeId) SetMockFuncs() {
+	t.GetNameFunc = func() (string, nvml.Return) {
+		return t.Name, nvml.SUCCESS
+	}
+
+	t.GetClassFunc = func() (string, nvml.Return) {
+		return t.Class, nvml.SUCCESS
+	}
+
+	t.GetLicenseFunc = func() (string, nvml.Return) {
+		return t.License, nvml.SUCCESS
+	}
+
+	t.GetFramebufferSizeFunc = func() (uint64, nvml.Return) {
+		return t.FramebufferMB * 1024 * 1024, nvml.SUCCESS
+	}
+
+	t.GetFrameRateLimitFunc = func() (uint32, nvml.Return) {
+		return t.FrameRateLimit, nvml.SUCCESS
+	}
+
+	t.GetNumDisplayHeadsFunc = func() (int, nvml.Return) {
+		return t.NumDisplayHeads, nvml.SUCCESS
+	}
+
+	t.GetResolutionFunc = func(displayIndex int) (uint32, uint32, nvml.Return) {
+		if displayIndex < 0 || displayIndex >= t.NumDisplayHeads {
+			return 0, 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return t.MaxResolutionX, t.MaxResolutionY, nvml.SUCCESS
+	}
+
+	t.GetMaxInstancesPerVmFunc = func() (int, nvml.Return) {
+		return t.MaxInstancesPerVm, nvml.SUCCESS
+	}
+
+	t.GetDeviceIDFunc = func() (uint64, uint64, nvml.Return) {
+		return uint64(t.device.Config.PciDeviceId), uint64(t.ID), nvml.SUCCESS
+	}
+
+	t.GetBAR1InfoFunc = func() (nvml.VgpuTypeBar1Info, nvml.Return) {
+		info := nvml.VgpuTypeBar1Info{Bar1Size: vgpuBAR1SizeMB * 1024 * 1024}
+		info.Version = nvml.STRUCT_VERSION(info, 1)
+		return info, nvml.SUCCESS
+	}
+
+	t.GetCapabilitiesFunc = func(capability nvml.VgpuCapability) (bool, nvml.Return) {
+		if capability >= nvml.VGPU_CAP_COUNT {
+			return false, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return false, nvml.SUCCESS
+	}
+
+	t.GetGpuInstanceProfileIdFunc = func() (uint32, nvml.Return) {
+		return noInstanceID, nvml.SUCCESS
+	}
+
+	t.GetMaxInstancesFunc = func(device nvml.Device) (int, nvml.Return) {
+		vgpuType, ret := vgpuType(device, t)
+		if ret != nvml.SUCCESS {
+			return 0, ret
+		}
+		return vgpuType.MaxInstances, nvml.SUCCESS
+	}
+
+	t.GetSupportedPlacementsFunc = func(device nvml.Device) (nvml.VgpuPlacementList, nvml.Return) {
+		vgpuType, ret := vgpuType(device, t)
+		if ret != nvml.SUCCESS {
+			return nvml.VgpuPlacementList{}, ret
+		}
+		d := vgpuType.device
+		d.RLock()
+		defer d.RUnlock()
+		return d.placementList(vgpuType, d.supportedPlacements(vgpuType)), nvml.SUCCESS
+	}
+
+	t.GetCreatablePlacementsFunc = func(device nvml.Device) (nvml.VgpuPlacementList, nvml.Return) {
+		vgpuType, ret := vgpuType(device, t)
+		if ret != nvml.SUCCESS {
+			return nvml.VgpuPlacementList{}, ret
+		}
+		d := vgpuType.device
+		d.RLock()
+		defer d.RUnlock()
+		return d.placementList(vgpuType, d.creatablePlacements(vgpuType)), nvml.SUCCESS
+	}
+}
+
+// SetMockFuncs configures all the mock function implementations for the vGPU instance
+func (v *VgpuInstance) SetMockFuncs() {
+	v.GetTypeFunc = func() (nvml.VgpuTypeId, nvml.Return) {
+		return v.Type, nvml.SUCCESS
+	}
+
+	v.GetUUIDFunc = func() (string, nvml.Return) {
+		return v.UUID, nvml.SUCCESS
+	}
+
+	v.GetMdevUUIDFunc = func() (string, nvml.Return) {
+		return v.MdevUUID, nvml.SUCCESS
+	}
+
+	v.GetVmIDFunc = func() (string, nvml.VgpuVmIdType, nvml.Return) {
+		return v.VmID, v.VmIDType, nvml.SUCCESS
+	}
+
+	v.GetVmDriverVersionFunc = func() (string, nvml.Return) {
+		return v.VmDriverVersion, nvml.SUCCESS
+	}
+
+	v.GetFbUsageFunc = func() (uint64, nvml.Return) {
+		v.RLock()
+		defer v.RUnlock()
+		return v.FbUsage, nvml.SUCCESS
+	}
+
+	v.GetFrameRateLimitFunc = func() (uint32, nvml.Return) {
+		return v.Type.FrameRateLimit, nvml.SUCCESS
+	}
+
+	v.GetGpuInstanceIdFunc = func() (int, nvml.Return) {
+		return noInstanceID, nvml.SUCCESS
+	}
+
+	v.GetGpuPciIdFunc = func() (string, nvml.Return) {
+		return v.Device.PciBusID, nvml.SUCCESS
+	}
+
+	v.GetLicenseStatusFunc = func() (int, nvml.Return) {
+		v.RLock()
+		defer v.RUnlock()
+		if v.Licensed {
+			return 1, nvml.SUCCESS
+		}
+		return 0, nvml.SUCCESS
+	}
+
+	v.GetLicenseInfoFunc = func() (nvml.VgpuLicenseInfo, nvml.Return) {
+		v.RLock()
+		defer v.RUnlock()
+		if v.Licensed {
+			return nvml.VgpuLicenseInfo{IsLicensed: 1, CurrentState: nvml.GRID_LICENSE_STATE_LICENSED}, nvml.SUCCESS
+		}
+		return nvml.VgpuLicenseInfo{CurrentState: nvml.GRID_LICENSE_STATE_UNLICENSED}, nvml.SUCCESS
+	}
+
+	v.GetEccModeFunc = func() (nvml.EnableState, nvml.Return) {
+		return nvml.FEATURE_DISABLED, nvml.SUCCESS
+	}
+
+	v.GetEncoderCapacityFunc = func() (int, nvml.Return) {
+		v.RLock()
+		defer v.RUnlock()
+		return v.EncoderCapacity, nvml.SUCCESS
+	}
+
+	v.SetEncoderCapacityFunc = func(capacity int) nvml.Return {
+		if capacity < 0 || capacity > 100 {
+			return nvml.ERROR_INVALID_ARGUMENT
+		}
+		v.Lock()
+		defer v.Unlock()
+		v.EncoderCapacity = capacity
+		return nvml.SUCCESS
+	}
+
+	v.GetEncoderSessionsFunc = func() (int, nvml.EncoderSessionInfo, nvml.Return) {
+		return 0, nvml.EncoderSessionInfo{}, nvml.SUCCESS
+	}
+
+	v.GetEncoderStatsFunc = func() (int, uint32, uint32, nvml.Return) {
+		return 0, 0, 0, nvml.SUCCESS
+	}
+
+	v.GetFBCSessionsFunc = func() (int, nvml.FBCSessionInfo, nvml.Return) {
+		return 0, nvml.FBCSessionInfo{}, nvml.SUCCESS
+	}
+
+	v.GetFBCStatsFunc = func() (nvml.FBCStats, nvml.Return) {
+		return nvml.FBCStats{}, nvml.SUCCESS
+	}
+
+	v.GetAccountingModeFunc = func() (nvml.EnableState, nvml.Return) {
+		return nvml.FEATURE_DISABLED, nvml.SUCCESS
+	}
+
+	v.GetAccountingPidsFunc = func() ([]int, nvml.Return) {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+
+	v.GetAccountingStatsFunc = func(int) (nvml.AccountingStats, nvml.Return) {
+		return nvml.AccountingStats{}, nvml.ERROR_NOT_SUPPORTED
+	}
+
+	v.ClearAccountingPidsFunc = func() nvml.Return {
+		return nvml.ERROR_NOT_SUPPORTED
+	}
+
+	v.GetMetadataFunc = func() (nvml.VgpuMetadata, nvml.Return) {
+		return nvml.VgpuMetadata{}, nvml.ERROR_NOT_SUPPORTED
+	}
+
+	v.GetRuntimeStateSizeFunc = func() (nvml.VgpuRuntimeState, nvml.Return) {
+		return nvml.VgpuRuntimeState{}, nvml.ERROR_NOT_SUPPORTED
+	}
+}
+
+// setVgpuFuncs configures the server-level vGPU functions to delegate to
+// devices, vGPU types and vGPU instances
+func (s *Server) setVgpuFuncs() {
+	s.DeviceGetVirtualizationModeFunc = func(d nvml.Device) (nvml.GpuVirtualizationMode, nvml.Return) {
+		return d.GetVirtualizationMode()
+	}
+
+	s.DeviceGetHostVgpuModeFunc = func(d nvml.Device) (nvml.HostVgpuMode, nvml.Return) {
+		return d.GetHostVgpuMode()
+	}
+
+	s.DeviceGetSupportedVgpusFunc = func(d nvml.Device) ([]nvml.VgpuTypeId, nvml.Return) {
+		return d.GetSupportedVgpus()
+	}
+
+	s.DeviceGetCreatableVgpusFunc = func(d nvml.Device) ([]nvml.VgpuTypeId, nvml.Return) {
+		return d.GetCreatableVgpus()
+	}
+
+	s.DeviceGetActiveVgpusFunc = func(d nvml.Device) ([]nvml.VgpuInstance, nvml.Return) {
+		return d.GetActiveVgpus()
+	}
+
+	s.DeviceGetVgpuHeterogeneousModeFunc = func(d nvml.Device) (nvml.VgpuHeterogeneousMode, nvml.Return) {
+		return d.GetVgpuHeterogeneousMode()
+	}
+
+	s.DeviceSetVgpuHeterogeneousModeFunc = func(d nvml.Device, mode nvml.VgpuHeterogeneousMode) nvml.Return {
+		return d.SetVgpuHeterogeneousMode(mode)
+	}
+
+	s.DeviceGetVgpuTypeSupportedPlacementsFunc = func(d nvml.Device, t nvml.VgpuTypeId) (nvml.VgpuPlacementList, nvml.Return) {
+		return d.GetVgpuTypeSupportedPlacements(t)
+	}
+
+	s.DeviceGetVgpuTypeCreatablePlacementsFunc = func(d nvml.Device, t nvml.VgpuTypeId) (nvml.VgpuPlacementList, nvml.Return) {
+		return d.GetVgpuTypeCreatablePlacements(t)
+	}
+
+	s.VgpuTypeGetNameFunc = func(t nvml.VgpuTypeId) (string, nvml.Return) {
+		return t.GetName()
+	}
+
+	s.VgpuTypeGetClassFunc = func(t nvml.VgpuTypeId) (string, nvml.Return) {
+		return t.GetClass()
+	}
+
+	s.VgpuTypeGetLicenseFunc = func(t nvml.VgpuTypeId) (string, nvml.Return) {
+		return t.GetLicense()
+	}
+
+	s.VgpuTypeGetFramebufferSizeFunc = func(t nvml.VgpuTypeId) (uint64, nvml.Return) {
+		return t.GetFramebufferSize()
+	}
+
+	s.VgpuTypeGetFrameRateLimitFunc = func(t nvml.VgpuTypeId) (uint32, nvml.Return) {
+		return t.GetFrameRateLimit()
+	}
+
+	s.VgpuTypeGetNumDisplayHeadsFunc = func(t nvml.VgpuTypeId) (int, nvml.Return) {
+		return t.GetNumDisplayHeads()
+	}
+
+	s.VgpuTypeGetResolutionFunc = func(t nvml.VgpuTypeId, displayIndex int) (uint32, uint32, nvml.Return) {
+		return t.GetResolution(displayIndex)
+	}
+
+	s.VgpuTypeGetMaxInstancesFunc = func(d nvml.Device, t nvml.VgpuTypeId) (int, nvml.Return) {
+		return t.GetMaxInstances(d)
+	}
+
+	s.VgpuTypeGetMaxInstancesPerVmFunc = func(t nvml.VgpuTypeId) (int, nvml.Return) {
+		return t.GetMaxInstancesPerVm()
+	}
+
+	s.VgpuTypeGetDeviceIDFunc = func(t nvml.VgpuTypeId) (uint64, uint64, nvml.Return) {
+		return t.GetDeviceID()
+	}
+
+	s.VgpuTypeGetBAR1InfoFunc = func(t nvml.VgpuTypeId) (nvml.VgpuTypeBar1Info, nvml.Return) {
+		return t.GetBAR1Info()
+	}
+
+	s.VgpuTypeGetCapabilitiesFunc = func(t nvml.VgpuTypeId, capability nvml.VgpuCapability) (bool, nvml.Return) {
+		return t.GetCapabilities(capability)
+	}
+
+	s.VgpuTypeGetGpuInstanceProfileIdFunc = func(t nvml.VgpuTypeId) (uint32, nvml.Return) {
+		return t.GetGpuInstanceProfileId()
+	}
+
+	s.VgpuInstanceGetTypeFunc = func(v nvml.VgpuInstance) (nvml.VgpuTypeId, nvml.Return) {
+		return v.GetType()
+	}
+
+	s.VgpuInstanceGetUUIDFunc = func(v nvml.VgpuInstance) (string, nvml.Return) {
+		return v.GetUUID()
+	}
+
+	s.VgpuInstanceGetMdevUUIDFunc = func(v nvml.VgpuInstance) (string, nvml.Return) {
+		return v.GetMdevUUID()
+	}
+
+	s.VgpuInstanceGetVmIDFunc = func(v nvml.VgpuInstance) (string, nvml.VgpuVmIdType, nvml.Return) {
+		return v.GetVmID()
+	}
+
+	s.VgpuInstanceGetVmDriverVersionFunc = func(v nvml.VgpuInstance) (string, nvml.Return) {
+		return v.GetVmDriverVersion()
+	}
+
+	s.VgpuInstanceGetFbUsageFunc = func(v nvml.VgpuInstance) (uint64, nvml.Return) {
+		return v.GetFbUsage()
+	}
+
+	s.VgpuInstanceGetFrameRateLimitFunc = func(v nvml.VgpuInstance) (uint32, nvml.Return) {
+		return v.GetFrameRateLimit()
+	}
+
+	s.VgpuInstanceGetGpuInstanceIdFunc = func(v nvml.VgpuInstance) (int, nvml.Return) {
+		return v.GetGpuInstanceId()
+	}
+
+	s.VgpuInstanceGetGpuPciIdFunc = func(v nvml.VgpuInstance) (string, nvml.Return) {
+		return v.GetGpuPciId()
+	}
+
+	s.VgpuInstanceGetLicenseStatusFunc = func(v nvml.VgpuInstance) (int, nvml.Return) {
+		return v.GetLicenseStatus()
+	}
+
+	s.VgpuInstanceGetLicenseInfoFunc = func(v nvml.VgpuInstance) (nvml.VgpuLicenseInfo, nvml.Return) {
+		return v.GetLicenseInfo()
+	}
+
+	s.VgpuInstanceGetEccModeFunc = func(v nvml.VgpuInstance) (nvml.EnableState, nvml.Return) {
+		return v.GetEccMode()
+	}
+
+	s.VgpuInstanceGetEncoderCapacityFunc = func(v nvml.VgpuInstance) (int, nvml.Return) {
+		return v.GetEncoderCapacity()
+	}
+
+	s.VgpuInstanceSetEncoderCapacityFunc = func(v nvml.VgpuInstance, capacity int) nvml.Return {
+		return v.SetEncoderCapacity(capacity)
+	}
+
+	s.VgpuInstanceGetEncoderSessionsFunc = func(v nvml.VgpuInstance) (int, nvml.EncoderSessionInfo, nvml.Return) {
+		return v.GetEncoderSessions()
+	}
+
+	s.VgpuInstanceGetEncoderStatsFunc = func(v nvml.VgpuInstance) (int, uint32, uint32, nvml.Return) {
+		return v.GetEncoderStats()
+	}
+
+	s.VgpuInstanceGetFBCSessionsFunc = func(v nvml.VgpuInstance) (int, nvml.FBCSessionInfo, nvml.Return) {
+		return v.GetFBCSessions()
+	}
+
+	s.VgpuInstanceGetFBCStatsFunc = func(v nvml.VgpuInstance) (nvml.FBCStats, nvml.Return) {
+		return v.GetFBCStats()
+	}
+
+	s.VgpuInstanceGetAccountingModeFunc = func(v nvml.VgpuInstance) (nvml.EnableState, nvml.Return) {
+		return v.GetAccountingMode()
+	}
+
+	s.VgpuInstanceGetAccountingPidsFunc = func(v nvml.VgpuInstance) ([]int, nvml.Return) {
+		return v.GetAccountingPids()
+	}
+
+	s.VgpuInstanceGetAccountingStatsFunc = func(v nvml.VgpuInstance, pid int) (nvml.AccountingStats, nvml.Return) {
+		return v.GetAccountingStats(pid)
+	}
+
+	s.VgpuInstanceClearAccountingPidsFunc = func(v nvml.VgpuInstance) nvml.Return {
+		return v.ClearAccountingPids()
+	}
+
+	s.VgpuInstanceGetMetadataFunc = func(v nvml.VgpuInstance) (nvml.VgpuMetadata, nvml.Return) {
+		return v.GetMetadata()
+	}
+
+	s.VgpuInstanceGetRuntimeStateSizeFunc = func(v nvml.VgpuInstance) (nvml.VgpuRuntimeState, nvml.Return) {
+		return v.GetRuntimeStateSize()
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/gpus"
+)
+
+var testVgpuTypes = []VgpuType{
+	{ID: 10, Name: "GRID A100-4C", Class: "Compute", License: "NVIDIA-Virtual-Compute-Server,9.0", FramebufferMB: 4096, MaxInstances: 10, MaxInstancesPerVm: 1},
+	{ID: 20, Name: "GRID A100-8C", Class: "Compute", License: "NVIDIA-Virtual-Compute-Server,9.0", FramebufferMB: 8192, MaxInstances: 5, MaxInstancesPerVm: 1},
+	{ID: 30, Name: "GRID A100-20C", Class: "Compute", License: "NVIDIA-Virtual-Compute-Server,9.0", FramebufferMB: 20480, MaxInstances: 2, MaxInstancesPerVm: 1},
+}
+
+// vgpuTypeIDs returns the IDs of the specified vGPU types
+func vgpuTypeIDs(types []nvml.VgpuTypeId) []uint32 {
+	var ids []uint32
+	for _, t := range types {
+		ids = append(ids, t.(*VgpuTypeId).ID)
+	}
+	return ids
+}
+
+func TestVgpuTypes(t *testing.T) {
+	s, err := New(WithGPUs(gpus.Multiple(2, gpus.A100_SXM4_40GB)...), WithDeviceVgpuTypes(1, testVgpuTypes...))
+	require.NoError(t, err)
+
+	mode, ret := s.Devices[0].GetVirtualizationMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GPU_VIRTUALIZATION_MODE_NONE, mode)
+	_, ret = s.Devices[0].GetSupportedVgpus()
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	device := s.Devices[1]
+	mode, ret = s.DeviceGetVirtualizationMode(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GPU_VIRTUALIZATION_MODE_HOST_VGPU, mode)
+
+	supported, ret := s.DeviceGetSupportedVgpus(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint32{10, 20, 30}, vgpuTypeIDs(supported))
+
+	vgpuType := supported[1]
+	name, ret := s.VgpuTypeGetName(vgpuType)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "GRID A100-8C", name)
+	class, ret := vgpuType.GetClass()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "Compute", class)
+	license, ret := vgpuType.GetLicense()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "NVIDIA-Virtual-Compute-Server,9.0", license)
+	framebuffer, ret := vgpuType.GetFramebufferSize()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(8192)<<20, framebuffer)
+	maxInstances, ret := s.VgpuTypeGetMaxInstances(device, vgpuType)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 5, maxInstances)
+	_, ret = vgpuType.GetMaxInstances(s.Devices[0])
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	placements, ret := device.GetVgpuTypeSupportedPlacements(vgpuType)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(2), placements.PlacementSize)
+	require.Equal(t, []uint32{0, 2, 4, 6, 8}, unsafe.Slice(placements.PlacementIds, placements.Count))
+}
+
+func TestVgpuHomogeneousMode(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithVgpuTypes(testVgpuTypes...))
+	require.NoError(t, err)
+	device := s.Devices[0].(*Device)
+
+	mode, ret := device.GetVgpuHeterogeneousMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(nvml.VGPU_PGPU_HOMOGENEOUS_MODE), mode.Mode)
+
+	v, ret := device.CreateVgpu(20, "vm-1")
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = device.CreateVgpu(10, "vm-2")
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	creatable, ret := device.GetCreatableVgpus()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint32{20}, vgpuTypeIDs(creatable))
+
+	for i := 1; i < 5; i++ {
+		_, ret = device.CreateVgpu(20, "vm")
+		require.Equal(t, nvml.SUCCESS, ret)
+	}
+	creatable, ret = device.GetCreatableVgpus()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, creatable)
+
+	mode.Mode = nvml.VGPU_PGPU_HETEROGENEOUS_MODE
+	require.Equal(t, nvml.ERROR_IN_USE, device.SetVgpuHeterogeneousMode(mode))
+
+	require.Equal(t, nvml.SUCCESS, device.DestroyVgpu(v))
+	require.Equal(t, nvml.ERROR_NOT_FOUND, device.DestroyVgpu(v))
+}
+
+func TestVgpuHeterogeneousMode(t *testing.T) {
+	s, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithVgpuTypes(testVgpuTypes...))
+	require.NoError(t, err)
+	device := s.Devices[0].(*Device)
+
+	mode := nvml.VgpuHeterogeneousMode{Mode: nvml.VGPU_PGPU_HETEROGENEOUS_MODE}
+	require.Equal(t, nvml.SUCCESS, s.DeviceSetVgpuHeterogeneousMode(device, mode))
+
+	large, ret := device.CreateVgpuWithPlacement(30, 0, "vm-1")
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = device.CreateVgpuWithPlacement(20, 4, "vm-2")
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+
+	placements, ret := device.GetVgpuTypeCreatablePlacements(device.VgpuTypes[20])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(nvml.VGPU_PGPU_HETEROGENEOUS_MODE), placements.Mode)
+	require.Equal(t, []uint32{6, 8}, unsafe.Slice(placements.PlacementIds, placements.Count))
+
+	small, ret := device.CreateVgpu(20, "vm-2")
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(6), small.Placement)
+
+	// The remaining framebuffer does not fit another 20C instance
+	_, ret = device.CreateVgpu(30, "vm-3")
+	require.Equal(t, nvml.ERROR_INSUFFICIENT_RESOURCES, ret)
+	creatable, ret := device.GetCreatableVgpus()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint32{10, 20}, vgpuTypeIDs(creatable))
+
+	small.FbUsage = 1 << 30
+	active, ret := s.DeviceGetActiveVgpus(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, active, 2)
+	require.Equal(t, nvml.VgpuInstance(large), active[0])
+
+	vmID, vmIDType, ret := s.VgpuInstanceGetVmID(active[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "vm-2", vmID)
+	require.Equal(t, nvml.VGPU_VM_ID_UUID, vmIDType)
+	fbUsage, ret := active[1].GetFbUsage()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1<<30), fbUsage)
+	vgpuType, ret := active[1].GetType()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, device.VgpuTypes[20], vgpuType)
+	pciID, ret := active[1].GetGpuPciId()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, device.PciBusID, pciID)
+	licensed, ret := active[1].GetLicenseStatus()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 1, licensed)
+}
+
+func TestVgpuTypesErrors(t *testing.T) {
+	_, err := New(WithGPUs(gpus.A100_SXM4_40GB), WithVgpuTypes(VgpuType{ID: 1}))
+	require.Error(t, err)
+	_, err = New(WithGPUs(gpus.A100_SXM4_40GB), WithVgpuTypes(VgpuType{ID: 1, MaxInstances: 1, FramebufferMB: 81920}))
+	require.Error(t, err)
+	_, err = New(WithGPUs(gpus.A100_SXM4_40GB), WithVgpuTypes(testVgpuTypes[0], testVgpuTypes[0]))
+	require.Error(t, err)
+}
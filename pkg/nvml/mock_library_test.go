@@ -0,0 +1,142 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// buildMockLibrary builds the stub libnvidia-ml.so backed by the mock server
+func buildMockLibrary(t *testing.T) string {
+	if runtime.GOOS != "linux" {
+		t.Skip("the mock library is only supported on linux")
+	}
+	if testing.Short() {
+		t.Skip("skipping build of the mock library in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+
+	path := filepath.Join(t.TempDir(), "libnvidia-ml.so.1")
+	cmd := exec.Command(goBin, "build", "-buildmode=c-shared", "-o", path, "./mock/libnvml")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	return path
+}
+
+func TestMockLibrary(t *testing.T) {
+	path := buildMockLibrary(t)
+
+	l := New(WithLibraryPath(path))
+	require.Equal(t, SUCCESS, l.Init())
+	defer func() {
+		require.Equal(t, SUCCESS, l.Shutdown())
+	}()
+
+	// Versioned symbols are selected from the library
+	require.NoError(t, l.Extensions().LookupSymbol("nvmlDeviceGetPciInfo_v3"))
+	require.Error(t, l.Extensions().LookupSymbol("nvmlDeviceGetPciInfo_v2"))
+
+	version, ret := l.SystemGetDriverVersion()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "550.54.15", version)
+
+	count, ret := l.DeviceGetCount()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 8, count)
+
+	device, ret := l.DeviceGetHandleByIndex(3)
+	require.Equal(t, SUCCESS, ret)
+	name, ret := device.GetName()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "Mock NVIDIA A100-SXM4-40GB", name)
+	index, ret := device.GetIndex()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 3, index)
+
+	uuid, ret := device.GetUUID()
+	require.Equal(t, SUCCESS, ret)
+	byUUID, ret := l.DeviceGetHandleByUUID(uuid)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, device, byUUID)
+
+	pciInfo, ret := device.GetPciInfo()
+	require.Equal(t, SUCCESS, ret)
+	var busID []byte
+	for _, c := range pciInfo.BusId {
+		if c == 0 {
+			break
+		}
+		busID = append(busID, byte(c))
+	}
+	byBusID, ret := l.DeviceGetHandleByPciBusId(string(busID))
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, device, byBusID)
+
+	memory, ret := device.GetMemoryInfo()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint64(40960)<<20, memory.Total)
+	memoryV2, ret := device.GetMemoryInfo_v2()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, memory.Total, memoryV2.Total)
+
+	_, ret = l.DeviceGetHandleByIndex(8)
+	require.Equal(t, ERROR_INVALID_ARGUMENT, ret)
+
+	// MIG devices are created through the library
+	_, ret = device.SetMigMode(DEVICE_MIG_ENABLE)
+	require.Equal(t, SUCCESS, ret)
+	giProfile, ret := device.GetGpuInstanceProfileInfo(GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&giProfile)
+	require.Equal(t, SUCCESS, ret)
+	giInfo, ret := gi.GetInfo()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, device, giInfo.Device)
+	require.Equal(t, GpuInstancePlacement{Start: 0, Size: 4}, giInfo.Placement)
+
+	ciProfile, ret := gi.GetComputeInstanceProfileInfo(COMPUTE_INSTANCE_PROFILE_1_SLICE, COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, SUCCESS, ret)
+	ci, ret := gi.CreateComputeInstance(&ciProfile)
+	require.Equal(t, SUCCESS, ret)
+	ciInfo, ret := ci.GetInfo()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, gi, ciInfo.GpuInstance)
+
+	migDevice, ret := device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, SUCCESS, ret)
+	isMig, ret := migDevice.IsMigDeviceHandle()
+	require.Equal(t, SUCCESS, ret)
+	require.True(t, isMig)
+	parent, ret := migDevice.GetDeviceHandleFromMigDeviceHandle()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, device, parent)
+	processes, ret := migDevice.GetComputeRunningProcesses()
+	require.Equal(t, SUCCESS, ret)
+	require.Empty(t, processes)
+
+	require.Equal(t, ERROR_IN_USE, gi.Destroy())
+	require.Equal(t, SUCCESS, ci.Destroy())
+	require.Equal(t, SUCCESS, gi.Destroy())
+}
@@ -0,0 +1,161 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package nvmlerr converts the Return codes of NVML calls into Go errors.
+//
+// The errors carry the name of the NVML function, the identity of the device
+// (if any) and the Return of the call. They unwrap to the Return so that the
+// NVML codes can be used as sentinels.
+//
+// The Library returned by New, and the Device and other handles obtained
+// through it, wrap the methods of an nvml.Interface so that they return these
+// errors instead of a Return:
+//
+//	lib := nvmlerr.New(nvml.New())
+//	device, err := lib.DeviceGetHandleByIndex(0)
+//	...
+//	name, err := device.GetName()
+//	if errors.Is(err, nvml.ERROR_NOT_SUPPORTED) {
+//		...
+//	}
+//	if nvmlerr.IsFatal(err) {
+//		...
+//	}
+//
+// Returns of calls made directly through the nvml package can be converted
+// with FromReturn and FromDeviceReturn.
+package nvmlerr
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Error is a non-SUCCESS Return of an NVML call
+type Error struct {
+	// Function is the name of the NVML function (e.g. "DeviceGetName")
+	Function string
+	// Device identifies the device the call was made on, if any (e.g. its
+	// UUID, or "index 0").
+	Device string
+	Return nvml.Return
+}
+
+// FromReturn returns an error for the Return of a call to an NVML function,
+// or nil if the call succeeded.
+func FromReturn(function string, ret nvml.Return) error {
+	if ret == nvml.SUCCESS {
+		return nil
+	}
+	return &Error{Function: function, Return: ret}
+}
+
+// FromDeviceReturn returns an error for the Return of a call to an NVML
+// function made on the device identified by device (e.g. its UUID), or nil if
+// the call succeeded. No calls are made to determine the identity, so that
+// the error does not depend on the state of a failing device.
+func FromDeviceReturn(function string, device string, ret nvml.Return) error {
+	if ret == nvml.SUCCESS {
+		return nil
+	}
+	return &Error{Function: function, Device: device, Return: ret}
+}
+
+// Error returns the string representation of the error
+func (e *Error) Error() string {
+	if e.Device == "" {
+		return fmt.Sprintf("%s: %v", e.Function, e.Return)
+	}
+	return fmt.Sprintf("%s(%s): %v", e.Function, e.Device, e.Return)
+}
+
+// Unwrap returns the Return of the call
+func (e *Error) Unwrap() error {
+	return e.Return
+}
+
+// IsRetryable returns whether err is an NVML error that may clear up if the
+// call is retried (e.g. ERROR_TIMEOUT or ERROR_IN_USE)
+func IsRetryable(err error) bool {
+	var ret nvml.Return
+	if !errors.As(err, &ret) {
+		return false
+	}
+	switch ret {
+	case nvml.ERROR_TIMEOUT,
+		nvml.ERROR_IN_USE,
+		nvml.ERROR_NOT_READY,
+		nvml.ERROR_INSUFFICIENT_RESOURCES:
+		return true
+	}
+	return false
+}
+
+// IsFatal returns whether err is an NVML error that leaves the device (or the
+// driver) unusable until it is reset or reloaded (e.g. ERROR_GPU_IS_LOST or
+// ERROR_RESET_REQUIRED)
+func IsFatal(err error) bool {
+	var ret nvml.Return
+	if !errors.As(err, &ret) {
+		return false
+	}
+	switch ret {
+	case nvml.ERROR_GPU_IS_LOST,
+		nvml.ERROR_RESET_REQUIRED,
+		nvml.ERROR_IRQ_ISSUE,
+		nvml.ERROR_CORRUPTED_INFOROM,
+		nvml.ERROR_DRIVER_NOT_LOADED,
+		nvml.ERROR_LIB_RM_VERSION_MISMATCH:
+		return true
+	}
+	return false
+}
+
+// identity caches the identity of a Device
+type identity struct {
+	sync.Once
+	value string
+}
+
+// fromReturn returns an error for the Return of a call to an NVML function
+// made on the device, or nil if the call succeeded. The identity of the device
+// is queried once, when the first call on it fails.
+func (d *Device) fromReturn(function string, ret nvml.Return) error {
+	if ret == nvml.SUCCESS {
+		return nil
+	}
+	if d == nil {
+		return FromReturn(function, ret)
+	}
+	d.identity.Do(func() {
+		d.identity.value = deviceIdentity(d.Device)
+	})
+	return FromDeviceReturn(function, d.identity.value, ret)
+}
+
+// deviceIdentity returns a string identifying a device
+func deviceIdentity(device nvml.Device) string {
+	if uuid, ret := device.GetUUID(); ret == nvml.SUCCESS {
+		return uuid
+	}
+	if index, ret := device.GetIndex(); ret == nvml.SUCCESS {
+		return fmt.Sprintf("index %d", index)
+	}
+	return ""
+}
@@ -0,0 +1,144 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvmlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+func TestFromReturn(t *testing.T) {
+	require.NoError(t, FromReturn("DeviceGetCount", nvml.SUCCESS))
+
+	err := FromReturn("DeviceGetCount", nvml.ERROR_NOT_SUPPORTED)
+	require.Error(t, err)
+	require.Equal(t, "DeviceGetCount: ERROR_NOT_SUPPORTED", err.Error())
+	require.ErrorIs(t, err, nvml.ERROR_NOT_SUPPORTED)
+	require.NotErrorIs(t, err, nvml.ERROR_UNKNOWN)
+
+	var e *Error
+	require.ErrorAs(t, fmt.Errorf("wrapped: %w", err), &e)
+	require.Equal(t, "DeviceGetCount", e.Function)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, e.Return)
+}
+
+func TestFromDeviceReturn(t *testing.T) {
+	require.NoError(t, FromDeviceReturn("DeviceGetName", "GPU-0", nvml.SUCCESS))
+
+	err := FromDeviceReturn("DeviceGetName", "GPU-0", nvml.ERROR_GPU_IS_LOST)
+	require.Equal(t, "DeviceGetName(GPU-0): ERROR_GPU_IS_LOST", err.Error())
+	require.ErrorIs(t, err, nvml.ERROR_GPU_IS_LOST)
+
+	err = FromDeviceReturn("DeviceGetName", "", nvml.ERROR_GPU_IS_LOST)
+	require.Equal(t, "DeviceGetName: ERROR_GPU_IS_LOST", err.Error())
+}
+
+func TestLibrary(t *testing.T) {
+	uuidCalls := 0
+	device := &mock.Device{
+		GetNameFunc: func() (string, nvml.Return) {
+			return "", nvml.ERROR_GPU_IS_LOST
+		},
+		GetUUIDFunc: func() (string, nvml.Return) {
+			uuidCalls++
+			return "GPU-0", nvml.SUCCESS
+		},
+		GetIndexFunc: func() (int, nvml.Return) {
+			return 0, nvml.SUCCESS
+		},
+		GetTopologyNearestGpusFunc: func(nvml.GpuTopologyLevel) ([]nvml.Device, nvml.Return) {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		},
+	}
+	lib := New(&mock.Interface{
+		DeviceGetCountFunc: func() (int, nvml.Return) {
+			return 1, nvml.SUCCESS
+		},
+		DeviceGetHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			if index != 0 {
+				return nil, nvml.ERROR_INVALID_ARGUMENT
+			}
+			return device, nvml.SUCCESS
+		},
+		DeviceGetNameFunc: func(d nvml.Device) (string, nvml.Return) {
+			require.Equal(t, device, d)
+			return d.GetName()
+		},
+		ShutdownFunc: func() nvml.Return {
+			return nvml.ERROR_UNINITIALIZED
+		},
+	})
+
+	count, err := lib.DeviceGetCount()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = lib.DeviceGetHandleByIndex(1)
+	require.ErrorIs(t, err, nvml.ERROR_INVALID_ARGUMENT)
+	require.Equal(t, "DeviceGetHandleByIndex: ERROR_INVALID_ARGUMENT", err.Error())
+
+	d, err := lib.DeviceGetHandleByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, device, d.Device)
+
+	// Successful calls do not query the identity of the device
+	index, err := d.GetIndex()
+	require.NoError(t, err)
+	require.Equal(t, 0, index)
+	require.Equal(t, 0, uuidCalls)
+
+	// Failed calls carry the identity of the device, which is queried once
+	_, err = d.GetName()
+	require.True(t, IsFatal(err))
+	require.Equal(t, "DeviceGetName(GPU-0): ERROR_GPU_IS_LOST", err.Error())
+	_, err = lib.DeviceGetName(d)
+	require.Equal(t, "DeviceGetName(GPU-0): ERROR_GPU_IS_LOST", err.Error())
+	_, err = d.GetTopologyNearestGpus(nvml.TOPOLOGY_SINGLE)
+	require.ErrorIs(t, err, nvml.ERROR_NOT_SUPPORTED)
+	require.Equal(t, 1, uuidCalls)
+
+	require.ErrorIs(t, lib.Shutdown(), nvml.ERROR_UNINITIALIZED)
+}
+
+func TestClassification(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+		fatal     bool
+	}{
+		{err: FromReturn("DeviceGetCount", nvml.ERROR_TIMEOUT), retryable: true},
+		{err: FromReturn("DeviceGetCount", nvml.ERROR_IN_USE), retryable: true},
+		{err: FromReturn("DeviceGetCount", nvml.ERROR_GPU_IS_LOST), fatal: true},
+		{err: FromReturn("DeviceGetCount", nvml.ERROR_RESET_REQUIRED), fatal: true},
+		{err: FromReturn("DeviceGetCount", nvml.ERROR_NOT_SUPPORTED)},
+		{err: nvml.ERROR_GPU_IS_LOST, fatal: true},
+		{err: errors.New("not an NVML error")},
+		{err: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.err), func(t *testing.T) {
+			require.Equal(t, tc.retryable, IsRetryable(tc.err))
+			require.Equal(t, tc.fatal, IsFatal(tc.err))
+		})
+	}
+}
@@ -0,0 +1,3866 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvmlerr
+
+import "github.com/NVIDIA/go-nvml/pkg/nvml"
+
+// Library calls the methods of an nvml.Interface, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.Interface directly.
+type Library struct {
+	nvml.Interface
+}
+
+// New returns a Library that calls the methods of lib.
+func New(lib nvml.Interface) *Library {
+	if lib == nil {
+		return nil
+	}
+	return &Library{Interface: lib}
+}
+
+func (x *Library) ComputeInstanceDestroy(computeInstance *ComputeInstance) error {
+	ret := x.Interface.ComputeInstanceDestroy(unwrapComputeInstance(computeInstance))
+	return FromReturn("ComputeInstanceDestroy", ret)
+}
+
+func (x *Library) ComputeInstanceGetInfo(computeInstance *ComputeInstance) (nvml.ComputeInstanceInfo, error) {
+	r0, ret := x.Interface.ComputeInstanceGetInfo(unwrapComputeInstance(computeInstance))
+	return r0, FromReturn("ComputeInstanceGetInfo", ret)
+}
+
+func (x *Library) DeviceClearAccountingPids(device *Device) error {
+	ret := x.Interface.DeviceClearAccountingPids(unwrapDevice(device))
+	return device.fromReturn("DeviceClearAccountingPids", ret)
+}
+
+func (x *Library) DeviceClearCpuAffinity(device *Device) error {
+	ret := x.Interface.DeviceClearCpuAffinity(unwrapDevice(device))
+	return device.fromReturn("DeviceClearCpuAffinity", ret)
+}
+
+func (x *Library) DeviceClearEccErrorCounts(device *Device, counterType nvml.EccCounterType) error {
+	ret := x.Interface.DeviceClearEccErrorCounts(unwrapDevice(device), counterType)
+	return device.fromReturn("DeviceClearEccErrorCounts", ret)
+}
+
+func (x *Library) DeviceClearFieldValues(device *Device, values []nvml.FieldValue) error {
+	ret := x.Interface.DeviceClearFieldValues(unwrapDevice(device), values)
+	return device.fromReturn("DeviceClearFieldValues", ret)
+}
+
+func (x *Library) DeviceCreateGpuInstance(device *Device, info *nvml.GpuInstanceProfileInfo) (*GpuInstance, error) {
+	r0, ret := x.Interface.DeviceCreateGpuInstance(unwrapDevice(device), info)
+	return NewGpuInstance(r0), device.fromReturn("DeviceCreateGpuInstance", ret)
+}
+
+func (x *Library) DeviceCreateGpuInstanceWithPlacement(device *Device, info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (*GpuInstance, error) {
+	r0, ret := x.Interface.DeviceCreateGpuInstanceWithPlacement(unwrapDevice(device), info, placement)
+	return NewGpuInstance(r0), device.fromReturn("DeviceCreateGpuInstanceWithPlacement", ret)
+}
+
+func (x *Library) DeviceDiscoverGpus() (nvml.PciInfo, error) {
+	r0, ret := x.Interface.DeviceDiscoverGpus()
+	return r0, FromReturn("DeviceDiscoverGpus", ret)
+}
+
+func (x *Library) DeviceFreezeNvLinkUtilizationCounter(device *Device, link int, counter int, freeze nvml.EnableState) error {
+	ret := x.Interface.DeviceFreezeNvLinkUtilizationCounter(unwrapDevice(device), link, counter, freeze)
+	return device.fromReturn("DeviceFreezeNvLinkUtilizationCounter", ret)
+}
+
+func (x *Library) DeviceGetAPIRestriction(device *Device, apiType nvml.RestrictedAPI) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetAPIRestriction(unwrapDevice(device), apiType)
+	return r0, device.fromReturn("DeviceGetAPIRestriction", ret)
+}
+
+func (x *Library) DeviceGetAccountingBufferSize(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetAccountingBufferSize(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAccountingBufferSize", ret)
+}
+
+func (x *Library) DeviceGetAccountingMode(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetAccountingMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAccountingMode", ret)
+}
+
+func (x *Library) DeviceGetAccountingPids(device *Device) ([]int, error) {
+	r0, ret := x.Interface.DeviceGetAccountingPids(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAccountingPids", ret)
+}
+
+func (x *Library) DeviceGetAccountingStats(device *Device, pid uint32) (nvml.AccountingStats, error) {
+	r0, ret := x.Interface.DeviceGetAccountingStats(unwrapDevice(device), pid)
+	return r0, device.fromReturn("DeviceGetAccountingStats", ret)
+}
+
+func (x *Library) DeviceGetAccountingStats_v2(device *Device, pid uint32) (nvml.AccountingStats_v2, error) {
+	r0, ret := x.Interface.DeviceGetAccountingStats_v2(unwrapDevice(device), pid)
+	return r0, device.fromReturn("DeviceGetAccountingStats_v2", ret)
+}
+
+func (x *Library) DeviceGetActiveVgpus(device *Device) ([]*VgpuInstance, error) {
+	r0, ret := x.Interface.DeviceGetActiveVgpus(unwrapDevice(device))
+	return newVgpuInstances(r0), device.fromReturn("DeviceGetActiveVgpus", ret)
+}
+
+func (x *Library) DeviceGetAdaptiveClockInfoStatus(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetAdaptiveClockInfoStatus(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAdaptiveClockInfoStatus", ret)
+}
+
+func (x *Library) DeviceGetAddressingMode(device *Device) (nvml.DeviceAddressingMode, error) {
+	r0, ret := x.Interface.DeviceGetAddressingMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAddressingMode", ret)
+}
+
+func (x *Library) DeviceGetApplicationsClock(device *Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetApplicationsClock(unwrapDevice(device), clockType)
+	return r0, device.fromReturn("DeviceGetApplicationsClock", ret)
+}
+
+func (x *Library) DeviceGetArchitecture(device *Device) (nvml.DeviceArchitecture, error) {
+	r0, ret := x.Interface.DeviceGetArchitecture(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetArchitecture", ret)
+}
+
+func (x *Library) DeviceGetAttributes(device *Device) (nvml.DeviceAttributes, error) {
+	r0, ret := x.Interface.DeviceGetAttributes(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetAttributes", ret)
+}
+
+func (x *Library) DeviceGetAutoBoostedClocksEnabled(device *Device) (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := x.Interface.DeviceGetAutoBoostedClocksEnabled(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Library) DeviceGetBAR1MemoryInfo(device *Device) (nvml.BAR1Memory, error) {
+	r0, ret := x.Interface.DeviceGetBAR1MemoryInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBAR1MemoryInfo", ret)
+}
+
+func (x *Library) DeviceGetBBXTimeData_v1(device *Device) (nvml.BBXTimeData_v1, error) {
+	r0, ret := x.Interface.DeviceGetBBXTimeData_v1(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBBXTimeData_v1", ret)
+}
+
+func (x *Library) DeviceGetBoardId(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetBoardId(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBoardId", ret)
+}
+
+func (x *Library) DeviceGetBoardPartNumber(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetBoardPartNumber(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBoardPartNumber", ret)
+}
+
+func (x *Library) DeviceGetBrand(device *Device) (nvml.BrandType, error) {
+	r0, ret := x.Interface.DeviceGetBrand(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBrand", ret)
+}
+
+func (x *Library) DeviceGetBridgeChipInfo(device *Device) (nvml.BridgeChipHierarchy, error) {
+	r0, ret := x.Interface.DeviceGetBridgeChipInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBridgeChipInfo", ret)
+}
+
+func (x *Library) DeviceGetBusType(device *Device) (nvml.BusType, error) {
+	r0, ret := x.Interface.DeviceGetBusType(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetBusType", ret)
+}
+
+func (x *Library) DeviceGetCapabilities(device *Device) (nvml.DeviceCapabilities, error) {
+	r0, ret := x.Interface.DeviceGetCapabilities(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCapabilities", ret)
+}
+
+func (x *Library) DeviceGetClkMonStatus(device *Device) (nvml.ClkMonStatus, error) {
+	r0, ret := x.Interface.DeviceGetClkMonStatus(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetClkMonStatus", ret)
+}
+
+func (x *Library) DeviceGetClock(device *Device, clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetClock(unwrapDevice(device), clockType, clockId)
+	return r0, device.fromReturn("DeviceGetClock", ret)
+}
+
+func (x *Library) DeviceGetClockInfo(device *Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetClockInfo(unwrapDevice(device), clockType)
+	return r0, device.fromReturn("DeviceGetClockInfo", ret)
+}
+
+func (x *Library) DeviceGetClockOffsets(device *Device) (nvml.ClockOffset, error) {
+	r0, ret := x.Interface.DeviceGetClockOffsets(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetClockOffsets", ret)
+}
+
+func (x *Library) DeviceGetComputeInstanceId(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetComputeInstanceId(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetComputeInstanceId", ret)
+}
+
+func (x *Library) DeviceGetComputeMode(device *Device) (nvml.ComputeMode, error) {
+	r0, ret := x.Interface.DeviceGetComputeMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetComputeMode", ret)
+}
+
+func (x *Library) DeviceGetComputeRunningProcesses(device *Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Interface.DeviceGetComputeRunningProcesses(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetComputeRunningProcesses", ret)
+}
+
+func (x *Library) DeviceGetConfComputeGpuAttestationReport(device *Device, gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error {
+	ret := x.Interface.DeviceGetConfComputeGpuAttestationReport(unwrapDevice(device), gpuAtstReport)
+	return device.fromReturn("DeviceGetConfComputeGpuAttestationReport", ret)
+}
+
+func (x *Library) DeviceGetConfComputeGpuCertificate(device *Device) (nvml.ConfComputeGpuCertificate, error) {
+	r0, ret := x.Interface.DeviceGetConfComputeGpuCertificate(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetConfComputeGpuCertificate", ret)
+}
+
+func (x *Library) DeviceGetConfComputeMemSizeInfo(device *Device) (nvml.ConfComputeMemSizeInfo, error) {
+	r0, ret := x.Interface.DeviceGetConfComputeMemSizeInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetConfComputeMemSizeInfo", ret)
+}
+
+func (x *Library) DeviceGetConfComputeProtectedMemoryUsage(device *Device) (nvml.Memory, error) {
+	r0, ret := x.Interface.DeviceGetConfComputeProtectedMemoryUsage(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetConfComputeProtectedMemoryUsage", ret)
+}
+
+func (x *Library) DeviceGetCoolerInfo(device *Device) (nvml.CoolerInfo, error) {
+	r0, ret := x.Interface.DeviceGetCoolerInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCoolerInfo", ret)
+}
+
+func (x *Library) DeviceGetCount() (int, error) {
+	r0, ret := x.Interface.DeviceGetCount()
+	return r0, FromReturn("DeviceGetCount", ret)
+}
+
+func (x *Library) DeviceGetCpuAffinity(device *Device, numCPUs int) ([]uint, error) {
+	r0, ret := x.Interface.DeviceGetCpuAffinity(unwrapDevice(device), numCPUs)
+	return r0, device.fromReturn("DeviceGetCpuAffinity", ret)
+}
+
+func (x *Library) DeviceGetCpuAffinityWithinScope(device *Device, numCPUs int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := x.Interface.DeviceGetCpuAffinityWithinScope(unwrapDevice(device), numCPUs, scope)
+	return r0, device.fromReturn("DeviceGetCpuAffinityWithinScope", ret)
+}
+
+func (x *Library) DeviceGetCreatableVgpus(device *Device) ([]*VgpuTypeId, error) {
+	r0, ret := x.Interface.DeviceGetCreatableVgpus(unwrapDevice(device))
+	return newVgpuTypeIds(r0), device.fromReturn("DeviceGetCreatableVgpus", ret)
+}
+
+func (x *Library) DeviceGetCudaComputeCapability(device *Device) (int, int, error) {
+	r0, r1, ret := x.Interface.DeviceGetCudaComputeCapability(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetCudaComputeCapability", ret)
+}
+
+func (x *Library) DeviceGetCurrPcieLinkGeneration(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetCurrPcieLinkGeneration(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCurrPcieLinkGeneration", ret)
+}
+
+func (x *Library) DeviceGetCurrPcieLinkWidth(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetCurrPcieLinkWidth(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCurrPcieLinkWidth", ret)
+}
+
+func (x *Library) DeviceGetCurrentClockFreqs(device *Device) (nvml.DeviceCurrentClockFreqs, error) {
+	r0, ret := x.Interface.DeviceGetCurrentClockFreqs(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCurrentClockFreqs", ret)
+}
+
+func (x *Library) DeviceGetCurrentClocksEventReasons(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetCurrentClocksEventReasons(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCurrentClocksEventReasons", ret)
+}
+
+func (x *Library) DeviceGetCurrentClocksThrottleReasons(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetCurrentClocksThrottleReasons(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetCurrentClocksThrottleReasons", ret)
+}
+
+func (x *Library) DeviceGetDecoderUtilization(device *Device) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetDecoderUtilization(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetDecoderUtilization", ret)
+}
+
+func (x *Library) DeviceGetDefaultApplicationsClock(device *Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetDefaultApplicationsClock(unwrapDevice(device), clockType)
+	return r0, device.fromReturn("DeviceGetDefaultApplicationsClock", ret)
+}
+
+func (x *Library) DeviceGetDefaultEccMode(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetDefaultEccMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetDefaultEccMode", ret)
+}
+
+func (x *Library) DeviceGetDetailedEccErrors(device *Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error) {
+	r0, ret := x.Interface.DeviceGetDetailedEccErrors(unwrapDevice(device), errorType, counterType)
+	return r0, device.fromReturn("DeviceGetDetailedEccErrors", ret)
+}
+
+func (x *Library) DeviceGetDeviceHandleFromMigDeviceHandle(migdevice *Device) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetDeviceHandleFromMigDeviceHandle(unwrapDevice(migdevice))
+	return NewDevice(r0), migdevice.fromReturn("DeviceGetDeviceHandleFromMigDeviceHandle", ret)
+}
+
+func (x *Library) DeviceGetDisplayActive(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetDisplayActive(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetDisplayActive", ret)
+}
+
+func (x *Library) DeviceGetDisplayMode(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetDisplayMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetDisplayMode", ret)
+}
+
+func (x *Library) DeviceGetDramEncryptionMode(device *Device) (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error) {
+	r0, r1, ret := x.Interface.DeviceGetDramEncryptionMode(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetDramEncryptionMode", ret)
+}
+
+func (x *Library) DeviceGetDriverModel(device *Device) (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := x.Interface.DeviceGetDriverModel(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetDriverModel", ret)
+}
+
+func (x *Library) DeviceGetDriverModel_v2(device *Device) (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := x.Interface.DeviceGetDriverModel_v2(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetDriverModel_v2", ret)
+}
+
+func (x *Library) DeviceGetDynamicPstatesInfo(device *Device) (nvml.GpuDynamicPstatesInfo, error) {
+	r0, ret := x.Interface.DeviceGetDynamicPstatesInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetDynamicPstatesInfo", ret)
+}
+
+func (x *Library) DeviceGetEccMode(device *Device) (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := x.Interface.DeviceGetEccMode(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetEccMode", ret)
+}
+
+func (x *Library) DeviceGetEncoderCapacity(device *Device, encoderQueryType nvml.EncoderType) (int, error) {
+	r0, ret := x.Interface.DeviceGetEncoderCapacity(unwrapDevice(device), encoderQueryType)
+	return r0, device.fromReturn("DeviceGetEncoderCapacity", ret)
+}
+
+func (x *Library) DeviceGetEncoderSessions(device *Device) ([]nvml.EncoderSessionInfo, error) {
+	r0, ret := x.Interface.DeviceGetEncoderSessions(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetEncoderSessions", ret)
+}
+
+func (x *Library) DeviceGetEncoderStats(device *Device) (int, uint32, uint32, error) {
+	r0, r1, r2, ret := x.Interface.DeviceGetEncoderStats(unwrapDevice(device))
+	return r0, r1, r2, device.fromReturn("DeviceGetEncoderStats", ret)
+}
+
+func (x *Library) DeviceGetEncoderUtilization(device *Device) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetEncoderUtilization(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetEncoderUtilization", ret)
+}
+
+func (x *Library) DeviceGetEnforcedPowerLimit(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetEnforcedPowerLimit(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetEnforcedPowerLimit", ret)
+}
+
+func (x *Library) DeviceGetFBCSessions(device *Device) ([]nvml.FBCSessionInfo, error) {
+	r0, ret := x.Interface.DeviceGetFBCSessions(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetFBCSessions", ret)
+}
+
+func (x *Library) DeviceGetFBCStats(device *Device) (nvml.FBCStats, error) {
+	r0, ret := x.Interface.DeviceGetFBCStats(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetFBCStats", ret)
+}
+
+func (x *Library) DeviceGetFanControlPolicy_v2(device *Device, fan int) (nvml.FanControlPolicy, error) {
+	r0, ret := x.Interface.DeviceGetFanControlPolicy_v2(unwrapDevice(device), fan)
+	return r0, device.fromReturn("DeviceGetFanControlPolicy_v2", ret)
+}
+
+func (x *Library) DeviceGetFanSpeed(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetFanSpeed(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetFanSpeed", ret)
+}
+
+func (x *Library) DeviceGetFanSpeedRPM(device *Device) (nvml.FanSpeedInfo, error) {
+	r0, ret := x.Interface.DeviceGetFanSpeedRPM(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetFanSpeedRPM", ret)
+}
+
+func (x *Library) DeviceGetFanSpeed_v2(device *Device, fan int) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetFanSpeed_v2(unwrapDevice(device), fan)
+	return r0, device.fromReturn("DeviceGetFanSpeed_v2", ret)
+}
+
+func (x *Library) DeviceGetFieldValues(device *Device, values []nvml.FieldValue) error {
+	ret := x.Interface.DeviceGetFieldValues(unwrapDevice(device), values)
+	return device.fromReturn("DeviceGetFieldValues", ret)
+}
+
+func (x *Library) DeviceGetGpcClkMinMaxVfOffset(device *Device) (int, int, error) {
+	r0, r1, ret := x.Interface.DeviceGetGpcClkMinMaxVfOffset(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetGpcClkMinMaxVfOffset", ret)
+}
+
+func (x *Library) DeviceGetGpcClkVfOffset(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetGpcClkVfOffset(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGpcClkVfOffset", ret)
+}
+
+func (x *Library) DeviceGetGpuFabricInfo(device *Device) (nvml.GpuFabricInfo, error) {
+	r0, ret := x.Interface.DeviceGetGpuFabricInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGpuFabricInfo", ret)
+}
+
+func (x *Library) DeviceGetGpuInstanceById(device *Device, id int) (*GpuInstance, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstanceById(unwrapDevice(device), id)
+	return NewGpuInstance(r0), device.fromReturn("DeviceGetGpuInstanceById", ret)
+}
+
+func (x *Library) DeviceGetGpuInstanceId(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstanceId(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGpuInstanceId", ret)
+}
+
+func (x *Library) DeviceGetGpuInstancePossiblePlacements(device *Device, info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstancePossiblePlacements(unwrapDevice(device), info)
+	return r0, device.fromReturn("DeviceGetGpuInstancePossiblePlacements", ret)
+}
+
+func (x *Library) DeviceGetGpuInstanceProfileInfo(device *Device, profile int) (nvml.GpuInstanceProfileInfo, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstanceProfileInfo(unwrapDevice(device), profile)
+	return r0, device.fromReturn("DeviceGetGpuInstanceProfileInfo", ret)
+}
+
+func (x *Library) DeviceGetGpuInstanceRemainingCapacity(device *Device, info *nvml.GpuInstanceProfileInfo) (int, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstanceRemainingCapacity(unwrapDevice(device), info)
+	return r0, device.fromReturn("DeviceGetGpuInstanceRemainingCapacity", ret)
+}
+
+func (x *Library) DeviceGetGpuInstances(device *Device, info *nvml.GpuInstanceProfileInfo) ([]*GpuInstance, error) {
+	r0, ret := x.Interface.DeviceGetGpuInstances(unwrapDevice(device), info)
+	return newGpuInstances(r0), device.fromReturn("DeviceGetGpuInstances", ret)
+}
+
+func (x *Library) DeviceGetGpuMaxPcieLinkGeneration(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetGpuMaxPcieLinkGeneration(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGpuMaxPcieLinkGeneration", ret)
+}
+
+func (x *Library) DeviceGetGpuOperationMode(device *Device) (nvml.GpuOperationMode, nvml.GpuOperationMode, error) {
+	r0, r1, ret := x.Interface.DeviceGetGpuOperationMode(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetGpuOperationMode", ret)
+}
+
+func (x *Library) DeviceGetGraphicsRunningProcesses(device *Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Interface.DeviceGetGraphicsRunningProcesses(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGraphicsRunningProcesses", ret)
+}
+
+func (x *Library) DeviceGetGridLicensableFeatures(device *Device) (nvml.GridLicensableFeatures, error) {
+	r0, ret := x.Interface.DeviceGetGridLicensableFeatures(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGridLicensableFeatures", ret)
+}
+
+func (x *Library) DeviceGetGspFirmwareMode(device *Device) (bool, bool, error) {
+	r0, r1, ret := x.Interface.DeviceGetGspFirmwareMode(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetGspFirmwareMode", ret)
+}
+
+func (x *Library) DeviceGetGspFirmwareVersion(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetGspFirmwareVersion(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetGspFirmwareVersion", ret)
+}
+
+func (x *Library) DeviceGetHandleByIndex(index int) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetHandleByIndex(index)
+	return NewDevice(r0), FromReturn("DeviceGetHandleByIndex", ret)
+}
+
+func (x *Library) DeviceGetHandleByPciBusId(pciBusId string) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetHandleByPciBusId(pciBusId)
+	return NewDevice(r0), FromReturn("DeviceGetHandleByPciBusId", ret)
+}
+
+func (x *Library) DeviceGetHandleBySerial(serial string) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetHandleBySerial(serial)
+	return NewDevice(r0), FromReturn("DeviceGetHandleBySerial", ret)
+}
+
+func (x *Library) DeviceGetHandleByUUID(uuid string) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetHandleByUUID(uuid)
+	return NewDevice(r0), FromReturn("DeviceGetHandleByUUID", ret)
+}
+
+func (x *Library) DeviceGetHandleByUUIDV(uuid *nvml.UUID) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetHandleByUUIDV(uuid)
+	return NewDevice(r0), FromReturn("DeviceGetHandleByUUIDV", ret)
+}
+
+func (x *Library) DeviceGetHostVgpuMode(device *Device) (nvml.HostVgpuMode, error) {
+	r0, ret := x.Interface.DeviceGetHostVgpuMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetHostVgpuMode", ret)
+}
+
+func (x *Library) DeviceGetHostname_v1(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetHostname_v1(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetHostname_v1", ret)
+}
+
+func (x *Library) DeviceGetIndex(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetIndex(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetIndex", ret)
+}
+
+func (x *Library) DeviceGetInforomConfigurationChecksum(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetInforomConfigurationChecksum(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetInforomConfigurationChecksum", ret)
+}
+
+func (x *Library) DeviceGetInforomImageVersion(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetInforomImageVersion(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetInforomImageVersion", ret)
+}
+
+func (x *Library) DeviceGetInforomVersion(device *Device, object nvml.InforomObject) (string, error) {
+	r0, ret := x.Interface.DeviceGetInforomVersion(unwrapDevice(device), object)
+	return r0, device.fromReturn("DeviceGetInforomVersion", ret)
+}
+
+func (x *Library) DeviceGetIrqNum(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetIrqNum(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetIrqNum", ret)
+}
+
+func (x *Library) DeviceGetJpgUtilization(device *Device) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetJpgUtilization(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetJpgUtilization", ret)
+}
+
+func (x *Library) DeviceGetLastBBXFlushTime(device *Device) (uint64, uint, error) {
+	r0, r1, ret := x.Interface.DeviceGetLastBBXFlushTime(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetLastBBXFlushTime", ret)
+}
+
+func (x *Library) DeviceGetMPSComputeRunningProcesses(device *Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Interface.DeviceGetMPSComputeRunningProcesses(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMPSComputeRunningProcesses", ret)
+}
+
+func (x *Library) DeviceGetMarginTemperature(device *Device) (nvml.MarginTemperature, error) {
+	r0, ret := x.Interface.DeviceGetMarginTemperature(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMarginTemperature", ret)
+}
+
+func (x *Library) DeviceGetMaxClockInfo(device *Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetMaxClockInfo(unwrapDevice(device), clockType)
+	return r0, device.fromReturn("DeviceGetMaxClockInfo", ret)
+}
+
+func (x *Library) DeviceGetMaxCustomerBoostClock(device *Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetMaxCustomerBoostClock(unwrapDevice(device), clockType)
+	return r0, device.fromReturn("DeviceGetMaxCustomerBoostClock", ret)
+}
+
+func (x *Library) DeviceGetMaxMigDeviceCount(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMaxMigDeviceCount(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMaxMigDeviceCount", ret)
+}
+
+func (x *Library) DeviceGetMaxPcieLinkGeneration(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMaxPcieLinkGeneration(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMaxPcieLinkGeneration", ret)
+}
+
+func (x *Library) DeviceGetMaxPcieLinkWidth(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMaxPcieLinkWidth(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMaxPcieLinkWidth", ret)
+}
+
+func (x *Library) DeviceGetMemClkMinMaxVfOffset(device *Device) (int, int, error) {
+	r0, r1, ret := x.Interface.DeviceGetMemClkMinMaxVfOffset(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetMemClkMinMaxVfOffset", ret)
+}
+
+func (x *Library) DeviceGetMemClkVfOffset(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMemClkVfOffset(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMemClkVfOffset", ret)
+}
+
+func (x *Library) DeviceGetMemoryAffinity(device *Device, numNodes int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := x.Interface.DeviceGetMemoryAffinity(unwrapDevice(device), numNodes, scope)
+	return r0, device.fromReturn("DeviceGetMemoryAffinity", ret)
+}
+
+func (x *Library) DeviceGetMemoryBusWidth(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetMemoryBusWidth(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMemoryBusWidth", ret)
+}
+
+func (x *Library) DeviceGetMemoryErrorCounter(device *Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetMemoryErrorCounter(unwrapDevice(device), errorType, counterType, locationType)
+	return r0, device.fromReturn("DeviceGetMemoryErrorCounter", ret)
+}
+
+func (x *Library) DeviceGetMemoryInfo(device *Device) (nvml.Memory, error) {
+	r0, ret := x.Interface.DeviceGetMemoryInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMemoryInfo", ret)
+}
+
+func (x *Library) DeviceGetMemoryInfo_v2(device *Device) (nvml.Memory_v2, error) {
+	r0, ret := x.Interface.DeviceGetMemoryInfo_v2(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMemoryInfo_v2", ret)
+}
+
+func (x *Library) DeviceGetMigDeviceHandleByIndex(device *Device, index int) (*Device, error) {
+	r0, ret := x.Interface.DeviceGetMigDeviceHandleByIndex(unwrapDevice(device), index)
+	return NewDevice(r0), device.fromReturn("DeviceGetMigDeviceHandleByIndex", ret)
+}
+
+func (x *Library) DeviceGetMigMode(device *Device) (int, int, error) {
+	r0, r1, ret := x.Interface.DeviceGetMigMode(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetMigMode", ret)
+}
+
+func (x *Library) DeviceGetMinMaxClockOfPState(device *Device, clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetMinMaxClockOfPState(unwrapDevice(device), clockType, pstate)
+	return r0, r1, device.fromReturn("DeviceGetMinMaxClockOfPState", ret)
+}
+
+func (x *Library) DeviceGetMinMaxFanSpeed(device *Device) (int, int, error) {
+	r0, r1, ret := x.Interface.DeviceGetMinMaxFanSpeed(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetMinMaxFanSpeed", ret)
+}
+
+func (x *Library) DeviceGetMinorNumber(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMinorNumber(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMinorNumber", ret)
+}
+
+func (x *Library) DeviceGetModuleId(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetModuleId(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetModuleId", ret)
+}
+
+func (x *Library) DeviceGetMultiGpuBoard(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetMultiGpuBoard(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetMultiGpuBoard", ret)
+}
+
+func (x *Library) DeviceGetName(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetName(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetName", ret)
+}
+
+func (x *Library) DeviceGetNumFans(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetNumFans(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetNumFans", ret)
+}
+
+func (x *Library) DeviceGetNumGpuCores(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetNumGpuCores(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetNumGpuCores", ret)
+}
+
+func (x *Library) DeviceGetNumaNodeId(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetNumaNodeId(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetNumaNodeId", ret)
+}
+
+func (x *Library) DeviceGetNvLinkCapability(device *Device, link int, capability nvml.NvLinkCapability) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkCapability(unwrapDevice(device), link, capability)
+	return r0, device.fromReturn("DeviceGetNvLinkCapability", ret)
+}
+
+func (x *Library) DeviceGetNvLinkErrorCounter(device *Device, link int, counter nvml.NvLinkErrorCounter) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkErrorCounter(unwrapDevice(device), link, counter)
+	return r0, device.fromReturn("DeviceGetNvLinkErrorCounter", ret)
+}
+
+func (x *Library) DeviceGetNvLinkRemoteDeviceType(device *Device, link int) (nvml.IntNvLinkDeviceType, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkRemoteDeviceType(unwrapDevice(device), link)
+	return r0, device.fromReturn("DeviceGetNvLinkRemoteDeviceType", ret)
+}
+
+func (x *Library) DeviceGetNvLinkRemotePciInfo(device *Device, link int) (nvml.PciInfo, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkRemotePciInfo(unwrapDevice(device), link)
+	return r0, device.fromReturn("DeviceGetNvLinkRemotePciInfo", ret)
+}
+
+func (x *Library) DeviceGetNvLinkState(device *Device, link int) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkState(unwrapDevice(device), link)
+	return r0, device.fromReturn("DeviceGetNvLinkState", ret)
+}
+
+func (x *Library) DeviceGetNvLinkUtilizationControl(device *Device, link int, counter int) (nvml.NvLinkUtilizationControl, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkUtilizationControl(unwrapDevice(device), link, counter)
+	return r0, device.fromReturn("DeviceGetNvLinkUtilizationControl", ret)
+}
+
+func (x *Library) DeviceGetNvLinkUtilizationCounter(device *Device, link int, counter int) (uint64, uint64, error) {
+	r0, r1, ret := x.Interface.DeviceGetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+	return r0, r1, device.fromReturn("DeviceGetNvLinkUtilizationCounter", ret)
+}
+
+func (x *Library) DeviceGetNvLinkVersion(device *Device, link int) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetNvLinkVersion(unwrapDevice(device), link)
+	return r0, device.fromReturn("DeviceGetNvLinkVersion", ret)
+}
+
+func (x *Library) DeviceGetNvlinkBwMode(device *Device) (nvml.NvlinkGetBwMode, error) {
+	r0, ret := x.Interface.DeviceGetNvlinkBwMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetNvlinkBwMode", ret)
+}
+
+func (x *Library) DeviceGetNvlinkSupportedBwModes(device *Device) (nvml.NvlinkSupportedBwModes, error) {
+	r0, ret := x.Interface.DeviceGetNvlinkSupportedBwModes(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetNvlinkSupportedBwModes", ret)
+}
+
+func (x *Library) DeviceGetOfaUtilization(device *Device) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetOfaUtilization(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetOfaUtilization", ret)
+}
+
+func (x *Library) DeviceGetP2PStatus(device1 *Device, device2 *Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error) {
+	r0, ret := x.Interface.DeviceGetP2PStatus(unwrapDevice(device1), unwrapDevice(device2), p2pIndex)
+	return r0, device1.fromReturn("DeviceGetP2PStatus", ret)
+}
+
+func (x *Library) DeviceGetPciInfo(device *Device) (nvml.PciInfo, error) {
+	r0, ret := x.Interface.DeviceGetPciInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPciInfo", ret)
+}
+
+func (x *Library) DeviceGetPciInfoExt(device *Device) (nvml.PciInfoExt, error) {
+	r0, ret := x.Interface.DeviceGetPciInfoExt(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPciInfoExt", ret)
+}
+
+func (x *Library) DeviceGetPcieLinkMaxSpeed(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetPcieLinkMaxSpeed(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPcieLinkMaxSpeed", ret)
+}
+
+func (x *Library) DeviceGetPcieReplayCounter(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetPcieReplayCounter(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPcieReplayCounter", ret)
+}
+
+func (x *Library) DeviceGetPcieSpeed(device *Device) (int, error) {
+	r0, ret := x.Interface.DeviceGetPcieSpeed(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPcieSpeed", ret)
+}
+
+func (x *Library) DeviceGetPcieThroughput(device *Device, counter nvml.PcieUtilCounter) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetPcieThroughput(unwrapDevice(device), counter)
+	return r0, device.fromReturn("DeviceGetPcieThroughput", ret)
+}
+
+func (x *Library) DeviceGetPdi(device *Device) (nvml.Pdi, error) {
+	r0, ret := x.Interface.DeviceGetPdi(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPdi", ret)
+}
+
+func (x *Library) DeviceGetPerformanceModes(device *Device) (nvml.DevicePerfModes, error) {
+	r0, ret := x.Interface.DeviceGetPerformanceModes(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPerformanceModes", ret)
+}
+
+func (x *Library) DeviceGetPerformanceState(device *Device) (nvml.Pstates, error) {
+	r0, ret := x.Interface.DeviceGetPerformanceState(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPerformanceState", ret)
+}
+
+func (x *Library) DeviceGetPersistenceMode(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetPersistenceMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPersistenceMode", ret)
+}
+
+func (x *Library) DeviceGetPgpuMetadataString(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetPgpuMetadataString(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPgpuMetadataString", ret)
+}
+
+func (x *Library) DeviceGetPlatformInfo(device *Device) (nvml.PlatformInfo, error) {
+	r0, ret := x.Interface.DeviceGetPlatformInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPlatformInfo", ret)
+}
+
+func (x *Library) DeviceGetPowerManagementDefaultLimit(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetPowerManagementDefaultLimit(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerManagementDefaultLimit", ret)
+}
+
+func (x *Library) DeviceGetPowerManagementLimit(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetPowerManagementLimit(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerManagementLimit", ret)
+}
+
+func (x *Library) DeviceGetPowerManagementLimitConstraints(device *Device) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetPowerManagementLimitConstraints(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetPowerManagementLimitConstraints", ret)
+}
+
+func (x *Library) DeviceGetPowerManagementMode(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetPowerManagementMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerManagementMode", ret)
+}
+
+func (x *Library) DeviceGetPowerMizerMode_v1(device *Device) (nvml.DevicePowerMizerModes_v1, error) {
+	r0, ret := x.Interface.DeviceGetPowerMizerMode_v1(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerMizerMode_v1", ret)
+}
+
+func (x *Library) DeviceGetPowerSource(device *Device) (nvml.PowerSource, error) {
+	r0, ret := x.Interface.DeviceGetPowerSource(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerSource", ret)
+}
+
+func (x *Library) DeviceGetPowerState(device *Device) (nvml.Pstates, error) {
+	r0, ret := x.Interface.DeviceGetPowerState(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerState", ret)
+}
+
+func (x *Library) DeviceGetPowerUsage(device *Device) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetPowerUsage(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetPowerUsage", ret)
+}
+
+func (x *Library) DeviceGetProcessUtilization(device *Device, lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error) {
+	r0, ret := x.Interface.DeviceGetProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, device.fromReturn("DeviceGetProcessUtilization", ret)
+}
+
+func (x *Library) DeviceGetProcessesUtilizationInfo(device *Device) (nvml.ProcessesUtilizationInfo, error) {
+	r0, ret := x.Interface.DeviceGetProcessesUtilizationInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetProcessesUtilizationInfo", ret)
+}
+
+func (x *Library) DeviceGetRemappedRows(device *Device) (int, int, bool, bool, error) {
+	r0, r1, r2, r3, ret := x.Interface.DeviceGetRemappedRows(unwrapDevice(device))
+	return r0, r1, r2, r3, device.fromReturn("DeviceGetRemappedRows", ret)
+}
+
+func (x *Library) DeviceGetRemappedRows_v2(device *Device) (nvml.RemappedRowsInfo_v2, error) {
+	r0, ret := x.Interface.DeviceGetRemappedRows_v2(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetRemappedRows_v2", ret)
+}
+
+func (x *Library) DeviceGetRepairStatus(device *Device) (nvml.RepairStatus, error) {
+	r0, ret := x.Interface.DeviceGetRepairStatus(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetRepairStatus", ret)
+}
+
+func (x *Library) DeviceGetRetiredPages(device *Device, cause nvml.PageRetirementCause) ([]uint64, error) {
+	r0, ret := x.Interface.DeviceGetRetiredPages(unwrapDevice(device), cause)
+	return r0, device.fromReturn("DeviceGetRetiredPages", ret)
+}
+
+func (x *Library) DeviceGetRetiredPagesPendingStatus(device *Device) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceGetRetiredPagesPendingStatus(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetRetiredPagesPendingStatus", ret)
+}
+
+func (x *Library) DeviceGetRetiredPages_v2(device *Device, cause nvml.PageRetirementCause) ([]uint64, []uint64, error) {
+	r0, r1, ret := x.Interface.DeviceGetRetiredPages_v2(unwrapDevice(device), cause)
+	return r0, r1, device.fromReturn("DeviceGetRetiredPages_v2", ret)
+}
+
+func (x *Library) DeviceGetRowRemapperHistogram(device *Device) (nvml.RowRemapperHistogramValues, error) {
+	r0, ret := x.Interface.DeviceGetRowRemapperHistogram(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetRowRemapperHistogram", ret)
+}
+
+func (x *Library) DeviceGetRunningProcessDetailList(device *Device) (nvml.ProcessDetailList, error) {
+	r0, ret := x.Interface.DeviceGetRunningProcessDetailList(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetRunningProcessDetailList", ret)
+}
+
+func (x *Library) DeviceGetSamples(device *Device, samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error) {
+	r0, r1, ret := x.Interface.DeviceGetSamples(unwrapDevice(device), samplingType, lastSeenTimestamp)
+	return r0, r1, device.fromReturn("DeviceGetSamples", ret)
+}
+
+func (x *Library) DeviceGetSerial(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetSerial(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSerial", ret)
+}
+
+func (x *Library) DeviceGetSramEccErrorStatus(device *Device) (nvml.EccSramErrorStatus, error) {
+	r0, ret := x.Interface.DeviceGetSramEccErrorStatus(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSramEccErrorStatus", ret)
+}
+
+func (x *Library) DeviceGetSramUniqueUncorrectedEccErrorCounts(device *Device, errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error {
+	ret := x.Interface.DeviceGetSramUniqueUncorrectedEccErrorCounts(unwrapDevice(device), errorCounts)
+	return device.fromReturn("DeviceGetSramUniqueUncorrectedEccErrorCounts", ret)
+}
+
+func (x *Library) DeviceGetSupportedClocksEventReasons(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetSupportedClocksEventReasons(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSupportedClocksEventReasons", ret)
+}
+
+func (x *Library) DeviceGetSupportedClocksThrottleReasons(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetSupportedClocksThrottleReasons(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSupportedClocksThrottleReasons", ret)
+}
+
+func (x *Library) DeviceGetSupportedEventTypes(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetSupportedEventTypes(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSupportedEventTypes", ret)
+}
+
+func (x *Library) DeviceGetSupportedGraphicsClocks(device *Device, memoryClockMHz int) (int, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetSupportedGraphicsClocks(unwrapDevice(device), memoryClockMHz)
+	return r0, r1, device.fromReturn("DeviceGetSupportedGraphicsClocks", ret)
+}
+
+func (x *Library) DeviceGetSupportedMemoryClocks(device *Device) (int, uint32, error) {
+	r0, r1, ret := x.Interface.DeviceGetSupportedMemoryClocks(unwrapDevice(device))
+	return r0, r1, device.fromReturn("DeviceGetSupportedMemoryClocks", ret)
+}
+
+func (x *Library) DeviceGetSupportedPerformanceStates(device *Device) ([]nvml.Pstates, error) {
+	r0, ret := x.Interface.DeviceGetSupportedPerformanceStates(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetSupportedPerformanceStates", ret)
+}
+
+func (x *Library) DeviceGetSupportedVgpus(device *Device) ([]*VgpuTypeId, error) {
+	r0, ret := x.Interface.DeviceGetSupportedVgpus(unwrapDevice(device))
+	return newVgpuTypeIds(r0), device.fromReturn("DeviceGetSupportedVgpus", ret)
+}
+
+func (x *Library) DeviceGetTargetFanSpeed(device *Device, fan int) (int, error) {
+	r0, ret := x.Interface.DeviceGetTargetFanSpeed(unwrapDevice(device), fan)
+	return r0, device.fromReturn("DeviceGetTargetFanSpeed", ret)
+}
+
+func (x *Library) DeviceGetTemperature(device *Device, sensorType nvml.TemperatureSensors) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetTemperature(unwrapDevice(device), sensorType)
+	return r0, device.fromReturn("DeviceGetTemperature", ret)
+}
+
+func (x *Library) DeviceGetTemperatureThreshold(device *Device, thresholdType nvml.TemperatureThresholds) (uint32, error) {
+	r0, ret := x.Interface.DeviceGetTemperatureThreshold(unwrapDevice(device), thresholdType)
+	return r0, device.fromReturn("DeviceGetTemperatureThreshold", ret)
+}
+
+func (x *Library) DeviceGetThermalSettings(device *Device, sensorIndex uint32) (nvml.GpuThermalSettings, error) {
+	r0, ret := x.Interface.DeviceGetThermalSettings(unwrapDevice(device), sensorIndex)
+	return r0, device.fromReturn("DeviceGetThermalSettings", ret)
+}
+
+func (x *Library) DeviceGetTopologyCommonAncestor(device1 *Device, device2 *Device) (nvml.GpuTopologyLevel, error) {
+	r0, ret := x.Interface.DeviceGetTopologyCommonAncestor(unwrapDevice(device1), unwrapDevice(device2))
+	return r0, device1.fromReturn("DeviceGetTopologyCommonAncestor", ret)
+}
+
+func (x *Library) DeviceGetTopologyNearestGpus(device *Device, level nvml.GpuTopologyLevel) ([]*Device, error) {
+	r0, ret := x.Interface.DeviceGetTopologyNearestGpus(unwrapDevice(device), level)
+	return newDevices(r0), device.fromReturn("DeviceGetTopologyNearestGpus", ret)
+}
+
+func (x *Library) DeviceGetTotalEccErrors(device *Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetTotalEccErrors(unwrapDevice(device), errorType, counterType)
+	return r0, device.fromReturn("DeviceGetTotalEccErrors", ret)
+}
+
+func (x *Library) DeviceGetTotalEnergyConsumption(device *Device) (uint64, error) {
+	r0, ret := x.Interface.DeviceGetTotalEnergyConsumption(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetTotalEnergyConsumption", ret)
+}
+
+func (x *Library) DeviceGetUUID(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetUUID(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetUUID", ret)
+}
+
+func (x *Library) DeviceGetUnrepairableMemoryFlag_v1(device *Device) (nvml.UnrepairableMemoryStatus_v1, error) {
+	r0, ret := x.Interface.DeviceGetUnrepairableMemoryFlag_v1(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetUnrepairableMemoryFlag_v1", ret)
+}
+
+func (x *Library) DeviceGetUtilizationRates(device *Device) (nvml.Utilization, error) {
+	r0, ret := x.Interface.DeviceGetUtilizationRates(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetUtilizationRates", ret)
+}
+
+func (x *Library) DeviceGetVbiosVersion(device *Device) (string, error) {
+	r0, ret := x.Interface.DeviceGetVbiosVersion(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVbiosVersion", ret)
+}
+
+func (x *Library) DeviceGetVgpuCapabilities(device *Device, capability nvml.DeviceVgpuCapability) (bool, error) {
+	r0, ret := x.Interface.DeviceGetVgpuCapabilities(unwrapDevice(device), capability)
+	return r0, device.fromReturn("DeviceGetVgpuCapabilities", ret)
+}
+
+func (x *Library) DeviceGetVgpuHeterogeneousMode(device *Device) (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := x.Interface.DeviceGetVgpuHeterogeneousMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Library) DeviceGetVgpuInstancesUtilizationInfo(device *Device) (nvml.VgpuInstancesUtilizationInfo, error) {
+	r0, ret := x.Interface.DeviceGetVgpuInstancesUtilizationInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuInstancesUtilizationInfo", ret)
+}
+
+func (x *Library) DeviceGetVgpuMetadata(device *Device) (nvml.VgpuPgpuMetadata, error) {
+	r0, ret := x.Interface.DeviceGetVgpuMetadata(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuMetadata", ret)
+}
+
+func (x *Library) DeviceGetVgpuProcessUtilization(device *Device, lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error) {
+	r0, ret := x.Interface.DeviceGetVgpuProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, device.fromReturn("DeviceGetVgpuProcessUtilization", ret)
+}
+
+func (x *Library) DeviceGetVgpuProcessesUtilizationInfo(device *Device) (nvml.VgpuProcessesUtilizationInfo, error) {
+	r0, ret := x.Interface.DeviceGetVgpuProcessesUtilizationInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuProcessesUtilizationInfo", ret)
+}
+
+func (x *Library) DeviceGetVgpuSchedulerCapabilities(device *Device) (nvml.VgpuSchedulerCapabilities, error) {
+	r0, ret := x.Interface.DeviceGetVgpuSchedulerCapabilities(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuSchedulerCapabilities", ret)
+}
+
+func (x *Library) DeviceGetVgpuSchedulerLog(device *Device) (nvml.VgpuSchedulerLog, error) {
+	r0, ret := x.Interface.DeviceGetVgpuSchedulerLog(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuSchedulerLog", ret)
+}
+
+func (x *Library) DeviceGetVgpuSchedulerLog_v2(device *Device, logInfo nvml.VgpuSchedulerLogInfo_v2) (nvml.VgpuSchedulerLogInfo_v2, error) {
+	r0, ret := x.Interface.DeviceGetVgpuSchedulerLog_v2(unwrapDevice(device), logInfo)
+	return r0, device.fromReturn("DeviceGetVgpuSchedulerLog_v2", ret)
+}
+
+func (x *Library) DeviceGetVgpuSchedulerState(device *Device) (nvml.VgpuSchedulerGetState, error) {
+	r0, ret := x.Interface.DeviceGetVgpuSchedulerState(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVgpuSchedulerState", ret)
+}
+
+func (x *Library) DeviceGetVgpuSchedulerState_v2(device *Device, info nvml.VgpuSchedulerStateInfo_v2) (nvml.VgpuSchedulerStateInfo_v2, error) {
+	r0, ret := x.Interface.DeviceGetVgpuSchedulerState_v2(unwrapDevice(device), info)
+	return r0, device.fromReturn("DeviceGetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Library) DeviceGetVgpuTypeCreatablePlacements(device *Device, vgpuTypeId *VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.Interface.DeviceGetVgpuTypeCreatablePlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, device.fromReturn("DeviceGetVgpuTypeCreatablePlacements", ret)
+}
+
+func (x *Library) DeviceGetVgpuTypeSupportedPlacements(device *Device, vgpuTypeId *VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.Interface.DeviceGetVgpuTypeSupportedPlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, device.fromReturn("DeviceGetVgpuTypeSupportedPlacements", ret)
+}
+
+func (x *Library) DeviceGetVgpuUtilization(device *Device, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error) {
+	r0, r1, ret := x.Interface.DeviceGetVgpuUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, r1, device.fromReturn("DeviceGetVgpuUtilization", ret)
+}
+
+func (x *Library) DeviceGetViolationStatus(device *Device, perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error) {
+	r0, ret := x.Interface.DeviceGetViolationStatus(unwrapDevice(device), perfPolicyType)
+	return r0, device.fromReturn("DeviceGetViolationStatus", ret)
+}
+
+func (x *Library) DeviceGetVirtualizationMode(device *Device) (nvml.GpuVirtualizationMode, error) {
+	r0, ret := x.Interface.DeviceGetVirtualizationMode(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceGetVirtualizationMode", ret)
+}
+
+func (x *Library) DeviceIsMigDeviceHandle(device *Device) (bool, error) {
+	r0, ret := x.Interface.DeviceIsMigDeviceHandle(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceIsMigDeviceHandle", ret)
+}
+
+func (x *Library) DeviceModifyDrainState(pciInfo *nvml.PciInfo, newState nvml.EnableState) error {
+	ret := x.Interface.DeviceModifyDrainState(pciInfo, newState)
+	return FromReturn("DeviceModifyDrainState", ret)
+}
+
+func (x *Library) DeviceOnSameBoard(device1 *Device, device2 *Device) (int, error) {
+	r0, ret := x.Interface.DeviceOnSameBoard(unwrapDevice(device1), unwrapDevice(device2))
+	return r0, device1.fromReturn("DeviceOnSameBoard", ret)
+}
+
+func (x *Library) DevicePowerSmoothingActivatePresetProfile(device *Device, profile *nvml.PowerSmoothingProfile) error {
+	ret := x.Interface.DevicePowerSmoothingActivatePresetProfile(unwrapDevice(device), profile)
+	return device.fromReturn("DevicePowerSmoothingActivatePresetProfile", ret)
+}
+
+func (x *Library) DevicePowerSmoothingSetState(device *Device, state *nvml.PowerSmoothingState) error {
+	ret := x.Interface.DevicePowerSmoothingSetState(unwrapDevice(device), state)
+	return device.fromReturn("DevicePowerSmoothingSetState", ret)
+}
+
+func (x *Library) DevicePowerSmoothingUpdatePresetProfileParam(device *Device, profile *nvml.PowerSmoothingProfile) error {
+	ret := x.Interface.DevicePowerSmoothingUpdatePresetProfileParam(unwrapDevice(device), profile)
+	return device.fromReturn("DevicePowerSmoothingUpdatePresetProfileParam", ret)
+}
+
+func (x *Library) DeviceQueryDrainState(pciInfo *nvml.PciInfo) (nvml.EnableState, error) {
+	r0, ret := x.Interface.DeviceQueryDrainState(pciInfo)
+	return r0, FromReturn("DeviceQueryDrainState", ret)
+}
+
+func (x *Library) DeviceReadPRMCounters_v1(device *Device, prmCounters []nvml.PRMCounterId, localPort int) ([]nvml.PRMCounter_v1, error) {
+	r0, ret := x.Interface.DeviceReadPRMCounters_v1(unwrapDevice(device), prmCounters, localPort)
+	return r0, device.fromReturn("DeviceReadPRMCounters_v1", ret)
+}
+
+func (x *Library) DeviceReadWritePRM_v1(device *Device, buffer *nvml.PRMTLV_v1) error {
+	ret := x.Interface.DeviceReadWritePRM_v1(unwrapDevice(device), buffer)
+	return device.fromReturn("DeviceReadWritePRM_v1", ret)
+}
+
+func (x *Library) DeviceRegisterEvents(device *Device, eventTypes uint64, set *EventSet) error {
+	ret := x.Interface.DeviceRegisterEvents(unwrapDevice(device), eventTypes, unwrapEventSet(set))
+	return device.fromReturn("DeviceRegisterEvents", ret)
+}
+
+func (x *Library) DeviceRemoveGpu(pciInfo *nvml.PciInfo) error {
+	ret := x.Interface.DeviceRemoveGpu(pciInfo)
+	return FromReturn("DeviceRemoveGpu", ret)
+}
+
+func (x *Library) DeviceRemoveGpu_v2(pciInfo *nvml.PciInfo, gpuState nvml.DetachGpuState, linkState nvml.PcieLinkState) error {
+	ret := x.Interface.DeviceRemoveGpu_v2(pciInfo, gpuState, linkState)
+	return FromReturn("DeviceRemoveGpu_v2", ret)
+}
+
+func (x *Library) DeviceResetApplicationsClocks(device *Device) error {
+	ret := x.Interface.DeviceResetApplicationsClocks(unwrapDevice(device))
+	return device.fromReturn("DeviceResetApplicationsClocks", ret)
+}
+
+func (x *Library) DeviceResetGpuLockedClocks(device *Device) error {
+	ret := x.Interface.DeviceResetGpuLockedClocks(unwrapDevice(device))
+	return device.fromReturn("DeviceResetGpuLockedClocks", ret)
+}
+
+func (x *Library) DeviceResetMemoryLockedClocks(device *Device) error {
+	ret := x.Interface.DeviceResetMemoryLockedClocks(unwrapDevice(device))
+	return device.fromReturn("DeviceResetMemoryLockedClocks", ret)
+}
+
+func (x *Library) DeviceResetNvLinkErrorCounters(device *Device, link int) error {
+	ret := x.Interface.DeviceResetNvLinkErrorCounters(unwrapDevice(device), link)
+	return device.fromReturn("DeviceResetNvLinkErrorCounters", ret)
+}
+
+func (x *Library) DeviceResetNvLinkUtilizationCounter(device *Device, link int, counter int) error {
+	ret := x.Interface.DeviceResetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+	return device.fromReturn("DeviceResetNvLinkUtilizationCounter", ret)
+}
+
+func (x *Library) DeviceSetAPIRestriction(device *Device, apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error {
+	ret := x.Interface.DeviceSetAPIRestriction(unwrapDevice(device), apiType, isRestricted)
+	return device.fromReturn("DeviceSetAPIRestriction", ret)
+}
+
+func (x *Library) DeviceSetAccountingMode(device *Device, mode nvml.EnableState) error {
+	ret := x.Interface.DeviceSetAccountingMode(unwrapDevice(device), mode)
+	return device.fromReturn("DeviceSetAccountingMode", ret)
+}
+
+func (x *Library) DeviceSetApplicationsClocks(device *Device, memClockMHz uint32, graphicsClockMHz uint32) error {
+	ret := x.Interface.DeviceSetApplicationsClocks(unwrapDevice(device), memClockMHz, graphicsClockMHz)
+	return device.fromReturn("DeviceSetApplicationsClocks", ret)
+}
+
+func (x *Library) DeviceSetAutoBoostedClocksEnabled(device *Device, enabled nvml.EnableState) error {
+	ret := x.Interface.DeviceSetAutoBoostedClocksEnabled(unwrapDevice(device), enabled)
+	return device.fromReturn("DeviceSetAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Library) DeviceSetClockOffsets(device *Device, info nvml.ClockOffset) error {
+	ret := x.Interface.DeviceSetClockOffsets(unwrapDevice(device), info)
+	return device.fromReturn("DeviceSetClockOffsets", ret)
+}
+
+func (x *Library) DeviceSetComputeMode(device *Device, mode nvml.ComputeMode) error {
+	ret := x.Interface.DeviceSetComputeMode(unwrapDevice(device), mode)
+	return device.fromReturn("DeviceSetComputeMode", ret)
+}
+
+func (x *Library) DeviceSetConfComputeUnprotectedMemSize(device *Device, sizeKiB uint64) error {
+	ret := x.Interface.DeviceSetConfComputeUnprotectedMemSize(unwrapDevice(device), sizeKiB)
+	return device.fromReturn("DeviceSetConfComputeUnprotectedMemSize", ret)
+}
+
+func (x *Library) DeviceSetCpuAffinity(device *Device) error {
+	ret := x.Interface.DeviceSetCpuAffinity(unwrapDevice(device))
+	return device.fromReturn("DeviceSetCpuAffinity", ret)
+}
+
+func (x *Library) DeviceSetDefaultAutoBoostedClocksEnabled(device *Device, enabled nvml.EnableState, flags uint32) error {
+	ret := x.Interface.DeviceSetDefaultAutoBoostedClocksEnabled(unwrapDevice(device), enabled, flags)
+	return device.fromReturn("DeviceSetDefaultAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Library) DeviceSetDefaultFanSpeed_v2(device *Device, fan int) error {
+	ret := x.Interface.DeviceSetDefaultFanSpeed_v2(unwrapDevice(device), fan)
+	return device.fromReturn("DeviceSetDefaultFanSpeed_v2", ret)
+}
+
+func (x *Library) DeviceSetDramEncryptionMode(device *Device, dramEncryption *nvml.DramEncryptionInfo) error {
+	ret := x.Interface.DeviceSetDramEncryptionMode(unwrapDevice(device), dramEncryption)
+	return device.fromReturn("DeviceSetDramEncryptionMode", ret)
+}
+
+func (x *Library) DeviceSetDriverModel(device *Device, driverModel nvml.DriverModel, flags uint32) error {
+	ret := x.Interface.DeviceSetDriverModel(unwrapDevice(device), driverModel, flags)
+	return device.fromReturn("DeviceSetDriverModel", ret)
+}
+
+func (x *Library) DeviceSetEccMode(device *Device, ecc nvml.EnableState) error {
+	ret := x.Interface.DeviceSetEccMode(unwrapDevice(device), ecc)
+	return device.fromReturn("DeviceSetEccMode", ret)
+}
+
+func (x *Library) DeviceSetFanControlPolicy(device *Device, fan int, policy nvml.FanControlPolicy) error {
+	ret := x.Interface.DeviceSetFanControlPolicy(unwrapDevice(device), fan, policy)
+	return device.fromReturn("DeviceSetFanControlPolicy", ret)
+}
+
+func (x *Library) DeviceSetFanSpeed_v2(device *Device, fan int, speed int) error {
+	ret := x.Interface.DeviceSetFanSpeed_v2(unwrapDevice(device), fan, speed)
+	return device.fromReturn("DeviceSetFanSpeed_v2", ret)
+}
+
+func (x *Library) DeviceSetGpcClkVfOffset(device *Device, offset int) error {
+	ret := x.Interface.DeviceSetGpcClkVfOffset(unwrapDevice(device), offset)
+	return device.fromReturn("DeviceSetGpcClkVfOffset", ret)
+}
+
+func (x *Library) DeviceSetGpuLockedClocks(device *Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) error {
+	ret := x.Interface.DeviceSetGpuLockedClocks(unwrapDevice(device), minGpuClockMHz, maxGpuClockMHz)
+	return device.fromReturn("DeviceSetGpuLockedClocks", ret)
+}
+
+func (x *Library) DeviceSetGpuOperationMode(device *Device, mode nvml.GpuOperationMode) error {
+	ret := x.Interface.DeviceSetGpuOperationMode(unwrapDevice(device), mode)
+	return device.fromReturn("DeviceSetGpuOperationMode", ret)
+}
+
+func (x *Library) DeviceSetHostname_v1(device *Device, hostName string) error {
+	ret := x.Interface.DeviceSetHostname_v1(unwrapDevice(device), hostName)
+	return device.fromReturn("DeviceSetHostname_v1", ret)
+}
+
+func (x *Library) DeviceSetMemClkVfOffset(device *Device, offset int) error {
+	ret := x.Interface.DeviceSetMemClkVfOffset(unwrapDevice(device), offset)
+	return device.fromReturn("DeviceSetMemClkVfOffset", ret)
+}
+
+func (x *Library) DeviceSetMemoryLockedClocks(device *Device, minMemClockMHz uint32, maxMemClockMHz uint32) error {
+	ret := x.Interface.DeviceSetMemoryLockedClocks(unwrapDevice(device), minMemClockMHz, maxMemClockMHz)
+	return device.fromReturn("DeviceSetMemoryLockedClocks", ret)
+}
+
+func (x *Library) DeviceSetMigMode(device *Device, mode int) (nvml.Return, error) {
+	r0, ret := x.Interface.DeviceSetMigMode(unwrapDevice(device), mode)
+	return r0, device.fromReturn("DeviceSetMigMode", ret)
+}
+
+func (x *Library) DeviceSetNvLinkDeviceLowPowerThreshold(device *Device, info *nvml.NvLinkPowerThres) error {
+	ret := x.Interface.DeviceSetNvLinkDeviceLowPowerThreshold(unwrapDevice(device), info)
+	return device.fromReturn("DeviceSetNvLinkDeviceLowPowerThreshold", ret)
+}
+
+func (x *Library) DeviceSetNvLinkUtilizationControl(device *Device, link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error {
+	ret := x.Interface.DeviceSetNvLinkUtilizationControl(unwrapDevice(device), link, counter, control, reset)
+	return device.fromReturn("DeviceSetNvLinkUtilizationControl", ret)
+}
+
+func (x *Library) DeviceSetNvlinkBwMode(device *Device, setBwMode *nvml.NvlinkSetBwMode) error {
+	ret := x.Interface.DeviceSetNvlinkBwMode(unwrapDevice(device), setBwMode)
+	return device.fromReturn("DeviceSetNvlinkBwMode", ret)
+}
+
+func (x *Library) DeviceSetPersistenceMode(device *Device, mode nvml.EnableState) error {
+	ret := x.Interface.DeviceSetPersistenceMode(unwrapDevice(device), mode)
+	return device.fromReturn("DeviceSetPersistenceMode", ret)
+}
+
+func (x *Library) DeviceSetPowerManagementLimit(device *Device, limit uint32) error {
+	ret := x.Interface.DeviceSetPowerManagementLimit(unwrapDevice(device), limit)
+	return device.fromReturn("DeviceSetPowerManagementLimit", ret)
+}
+
+func (x *Library) DeviceSetPowerManagementLimit_v2(device *Device, powerValue *nvml.PowerValue_v2) error {
+	ret := x.Interface.DeviceSetPowerManagementLimit_v2(unwrapDevice(device), powerValue)
+	return device.fromReturn("DeviceSetPowerManagementLimit_v2", ret)
+}
+
+func (x *Library) DeviceSetRusdSettings_v1(device *Device, settings nvml.RusdSettings_v1) error {
+	ret := x.Interface.DeviceSetRusdSettings_v1(unwrapDevice(device), settings)
+	return device.fromReturn("DeviceSetRusdSettings_v1", ret)
+}
+
+func (x *Library) DeviceSetTemperatureThreshold(device *Device, thresholdType nvml.TemperatureThresholds, temp int) error {
+	ret := x.Interface.DeviceSetTemperatureThreshold(unwrapDevice(device), thresholdType, temp)
+	return device.fromReturn("DeviceSetTemperatureThreshold", ret)
+}
+
+func (x *Library) DeviceSetVgpuCapabilities(device *Device, capability nvml.DeviceVgpuCapability, state nvml.EnableState) error {
+	ret := x.Interface.DeviceSetVgpuCapabilities(unwrapDevice(device), capability, state)
+	return device.fromReturn("DeviceSetVgpuCapabilities", ret)
+}
+
+func (x *Library) DeviceSetVgpuHeterogeneousMode(device *Device, heterogeneousMode nvml.VgpuHeterogeneousMode) error {
+	ret := x.Interface.DeviceSetVgpuHeterogeneousMode(unwrapDevice(device), heterogeneousMode)
+	return device.fromReturn("DeviceSetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Library) DeviceSetVgpuSchedulerState(device *Device, pSchedulerState *nvml.VgpuSchedulerSetState) error {
+	ret := x.Interface.DeviceSetVgpuSchedulerState(unwrapDevice(device), pSchedulerState)
+	return device.fromReturn("DeviceSetVgpuSchedulerState", ret)
+}
+
+func (x *Library) DeviceSetVgpuSchedulerState_v2(device *Device, pSchedulerState *nvml.VgpuSchedulerState_v2) error {
+	ret := x.Interface.DeviceSetVgpuSchedulerState_v2(unwrapDevice(device), pSchedulerState)
+	return device.fromReturn("DeviceSetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Library) DeviceSetVirtualizationMode(device *Device, virtualMode nvml.GpuVirtualizationMode) error {
+	ret := x.Interface.DeviceSetVirtualizationMode(unwrapDevice(device), virtualMode)
+	return device.fromReturn("DeviceSetVirtualizationMode", ret)
+}
+
+func (x *Library) DeviceValidateInforom(device *Device) error {
+	ret := x.Interface.DeviceValidateInforom(unwrapDevice(device))
+	return device.fromReturn("DeviceValidateInforom", ret)
+}
+
+func (x *Library) DeviceVgpuForceGspUnload(device *Device) error {
+	ret := x.Interface.DeviceVgpuForceGspUnload(unwrapDevice(device))
+	return device.fromReturn("DeviceVgpuForceGspUnload", ret)
+}
+
+func (x *Library) DeviceWorkloadPowerProfileClearRequestedProfiles(device *Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := x.Interface.DeviceWorkloadPowerProfileClearRequestedProfiles(unwrapDevice(device), requestedProfiles)
+	return device.fromReturn("DeviceWorkloadPowerProfileClearRequestedProfiles", ret)
+}
+
+func (x *Library) DeviceWorkloadPowerProfileGetCurrentProfiles(device *Device) (nvml.WorkloadPowerProfileCurrentProfiles, error) {
+	r0, ret := x.Interface.DeviceWorkloadPowerProfileGetCurrentProfiles(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceWorkloadPowerProfileGetCurrentProfiles", ret)
+}
+
+func (x *Library) DeviceWorkloadPowerProfileGetProfilesInfo(device *Device) (nvml.WorkloadPowerProfileProfilesInfo, error) {
+	r0, ret := x.Interface.DeviceWorkloadPowerProfileGetProfilesInfo(unwrapDevice(device))
+	return r0, device.fromReturn("DeviceWorkloadPowerProfileGetProfilesInfo", ret)
+}
+
+func (x *Library) DeviceWorkloadPowerProfileSetRequestedProfiles(device *Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := x.Interface.DeviceWorkloadPowerProfileSetRequestedProfiles(unwrapDevice(device), requestedProfiles)
+	return device.fromReturn("DeviceWorkloadPowerProfileSetRequestedProfiles", ret)
+}
+
+func (x *Library) DeviceWorkloadPowerProfileUpdateProfiles_v1(device *Device, operation nvml.PowerProfileOperation, profileTypes []nvml.PowerProfileType) error {
+	ret := x.Interface.DeviceWorkloadPowerProfileUpdateProfiles_v1(unwrapDevice(device), operation, profileTypes)
+	return device.fromReturn("DeviceWorkloadPowerProfileUpdateProfiles_v1", ret)
+}
+
+func (x *Library) EventSetCreate() (*EventSet, error) {
+	r0, ret := x.Interface.EventSetCreate()
+	return NewEventSet(r0), FromReturn("EventSetCreate", ret)
+}
+
+func (x *Library) EventSetFree(set *EventSet) error {
+	ret := x.Interface.EventSetFree(unwrapEventSet(set))
+	return FromReturn("EventSetFree", ret)
+}
+
+func (x *Library) EventSetWait(set *EventSet, timeoutms uint32) (nvml.EventData, error) {
+	r0, ret := x.Interface.EventSetWait(unwrapEventSet(set), timeoutms)
+	return r0, FromReturn("EventSetWait", ret)
+}
+
+func (x *Library) GetExcludedDeviceCount() (int, error) {
+	r0, ret := x.Interface.GetExcludedDeviceCount()
+	return r0, FromReturn("GetExcludedDeviceCount", ret)
+}
+
+func (x *Library) GetExcludedDeviceInfoByIndex(index int) (nvml.ExcludedDeviceInfo, error) {
+	r0, ret := x.Interface.GetExcludedDeviceInfoByIndex(index)
+	return r0, FromReturn("GetExcludedDeviceInfoByIndex", ret)
+}
+
+func (x *Library) GetVgpuCompatibility(vgpuMetadata *nvml.VgpuMetadata, pgpuMetadata *nvml.VgpuPgpuMetadata) (nvml.VgpuPgpuCompatibility, error) {
+	r0, ret := x.Interface.GetVgpuCompatibility(vgpuMetadata, pgpuMetadata)
+	return r0, FromReturn("GetVgpuCompatibility", ret)
+}
+
+func (x *Library) GetVgpuDriverCapabilities(capability nvml.VgpuDriverCapability) (bool, error) {
+	r0, ret := x.Interface.GetVgpuDriverCapabilities(capability)
+	return r0, FromReturn("GetVgpuDriverCapabilities", ret)
+}
+
+func (x *Library) GetVgpuVersion() (nvml.VgpuVersion, nvml.VgpuVersion, error) {
+	r0, r1, ret := x.Interface.GetVgpuVersion()
+	return r0, r1, FromReturn("GetVgpuVersion", ret)
+}
+
+func (x *Library) GpmMetricsGet(metricsGet *nvml.GpmMetricsGetType) error {
+	ret := x.Interface.GpmMetricsGet(metricsGet)
+	return FromReturn("GpmMetricsGet", ret)
+}
+
+func (x *Library) GpmMigSampleGet(device *Device, gpuInstanceId int, gpmSample *GpmSample) error {
+	ret := x.Interface.GpmMigSampleGet(unwrapDevice(device), gpuInstanceId, unwrapGpmSample(gpmSample))
+	return device.fromReturn("GpmMigSampleGet", ret)
+}
+
+func (x *Library) GpmQueryDeviceSupport(device *Device) (nvml.GpmSupport, error) {
+	r0, ret := x.Interface.GpmQueryDeviceSupport(unwrapDevice(device))
+	return r0, device.fromReturn("GpmQueryDeviceSupport", ret)
+}
+
+func (x *Library) GpmQueryIfStreamingEnabled(device *Device) (uint32, error) {
+	r0, ret := x.Interface.GpmQueryIfStreamingEnabled(unwrapDevice(device))
+	return r0, device.fromReturn("GpmQueryIfStreamingEnabled", ret)
+}
+
+func (x *Library) GpmSampleAlloc() (*GpmSample, error) {
+	r0, ret := x.Interface.GpmSampleAlloc()
+	return NewGpmSample(r0), FromReturn("GpmSampleAlloc", ret)
+}
+
+func (x *Library) GpmSampleFree(gpmSample *GpmSample) error {
+	ret := x.Interface.GpmSampleFree(unwrapGpmSample(gpmSample))
+	return FromReturn("GpmSampleFree", ret)
+}
+
+func (x *Library) GpmSampleGet(device *Device, gpmSample *GpmSample) error {
+	ret := x.Interface.GpmSampleGet(unwrapDevice(device), unwrapGpmSample(gpmSample))
+	return device.fromReturn("GpmSampleGet", ret)
+}
+
+func (x *Library) GpmSetStreamingEnabled(device *Device, state uint32) error {
+	ret := x.Interface.GpmSetStreamingEnabled(unwrapDevice(device), state)
+	return device.fromReturn("GpmSetStreamingEnabled", ret)
+}
+
+func (x *Library) GpuInstanceCreateComputeInstance(gpuInstance *GpuInstance, info *nvml.ComputeInstanceProfileInfo) (*ComputeInstance, error) {
+	r0, ret := x.Interface.GpuInstanceCreateComputeInstance(unwrapGpuInstance(gpuInstance), info)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceCreateComputeInstance", ret)
+}
+
+func (x *Library) GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance *GpuInstance, info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (*ComputeInstance, error) {
+	r0, ret := x.Interface.GpuInstanceCreateComputeInstanceWithPlacement(unwrapGpuInstance(gpuInstance), info, placement)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceCreateComputeInstanceWithPlacement", ret)
+}
+
+func (x *Library) GpuInstanceDestroy(gpuInstance *GpuInstance) error {
+	ret := x.Interface.GpuInstanceDestroy(unwrapGpuInstance(gpuInstance))
+	return FromReturn("GpuInstanceDestroy", ret)
+}
+
+func (x *Library) GpuInstanceGetActiveVgpus(gpuInstance *GpuInstance) (nvml.ActiveVgpuInstanceInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetActiveVgpus(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetActiveVgpus", ret)
+}
+
+func (x *Library) GpuInstanceGetComputeInstanceById(gpuInstance *GpuInstance, id int) (*ComputeInstance, error) {
+	r0, ret := x.Interface.GpuInstanceGetComputeInstanceById(unwrapGpuInstance(gpuInstance), id)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceGetComputeInstanceById", ret)
+}
+
+func (x *Library) GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance *GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error) {
+	r0, ret := x.Interface.GpuInstanceGetComputeInstancePossiblePlacements(unwrapGpuInstance(gpuInstance), info)
+	return r0, FromReturn("GpuInstanceGetComputeInstancePossiblePlacements", ret)
+}
+
+func (x *Library) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance *GpuInstance, profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetComputeInstanceProfileInfo(unwrapGpuInstance(gpuInstance), profile, engProfile)
+	return r0, FromReturn("GpuInstanceGetComputeInstanceProfileInfo", ret)
+}
+
+func (x *Library) GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance *GpuInstance, info *nvml.ComputeInstanceProfileInfo) (int, error) {
+	r0, ret := x.Interface.GpuInstanceGetComputeInstanceRemainingCapacity(unwrapGpuInstance(gpuInstance), info)
+	return r0, FromReturn("GpuInstanceGetComputeInstanceRemainingCapacity", ret)
+}
+
+func (x *Library) GpuInstanceGetComputeInstances(gpuInstance *GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]*ComputeInstance, error) {
+	r0, ret := x.Interface.GpuInstanceGetComputeInstances(unwrapGpuInstance(gpuInstance), info)
+	return newComputeInstances(r0), FromReturn("GpuInstanceGetComputeInstances", ret)
+}
+
+func (x *Library) GpuInstanceGetCreatableVgpus(gpuInstance *GpuInstance) (nvml.VgpuTypeIdInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetCreatableVgpus(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetCreatableVgpus", ret)
+}
+
+func (x *Library) GpuInstanceGetInfo(gpuInstance *GpuInstance) (nvml.GpuInstanceInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetInfo(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetInfo", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance *GpuInstance) (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuSchedulerLog(gpuInstance *GpuInstance) (nvml.VgpuSchedulerLogInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuSchedulerLog(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerLog", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuSchedulerLog_v2(gpuInstance *GpuInstance, logInfo nvml.VgpuSchedulerLogInfo_v2) (nvml.VgpuSchedulerLogInfo_v2, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuSchedulerLog_v2(unwrapGpuInstance(gpuInstance), logInfo)
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerLog_v2", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuSchedulerState(gpuInstance *GpuInstance) (nvml.VgpuSchedulerStateInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuSchedulerState(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerState", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuSchedulerState_v2(gpuInstance *GpuInstance, info nvml.VgpuSchedulerStateInfo_v2) (nvml.VgpuSchedulerStateInfo_v2, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuSchedulerState_v2(unwrapGpuInstance(gpuInstance), info)
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Library) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance *GpuInstance) (nvml.VgpuCreatablePlacementInfo, error) {
+	r0, ret := x.Interface.GpuInstanceGetVgpuTypeCreatablePlacements(unwrapGpuInstance(gpuInstance))
+	return r0, FromReturn("GpuInstanceGetVgpuTypeCreatablePlacements", ret)
+}
+
+func (x *Library) GpuInstanceSetVgpuHeterogeneousMode(gpuInstance *GpuInstance, heterogeneousMode *nvml.VgpuHeterogeneousMode) error {
+	ret := x.Interface.GpuInstanceSetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance), heterogeneousMode)
+	return FromReturn("GpuInstanceSetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Library) GpuInstanceSetVgpuSchedulerState(gpuInstance *GpuInstance, scheduler *nvml.VgpuSchedulerState) error {
+	ret := x.Interface.GpuInstanceSetVgpuSchedulerState(unwrapGpuInstance(gpuInstance), scheduler)
+	return FromReturn("GpuInstanceSetVgpuSchedulerState", ret)
+}
+
+func (x *Library) GpuInstanceSetVgpuSchedulerState_v2(gpuInstance *GpuInstance, schedulerState *nvml.VgpuSchedulerState_v2) error {
+	ret := x.Interface.GpuInstanceSetVgpuSchedulerState_v2(unwrapGpuInstance(gpuInstance), schedulerState)
+	return FromReturn("GpuInstanceSetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Library) Init() error {
+	ret := x.Interface.Init()
+	return FromReturn("Init", ret)
+}
+
+func (x *Library) InitWithFlags(flags uint32) error {
+	ret := x.Interface.InitWithFlags(flags)
+	return FromReturn("InitWithFlags", ret)
+}
+
+func (x *Library) SetVgpuVersion(vgpuVersion *nvml.VgpuVersion) error {
+	ret := x.Interface.SetVgpuVersion(vgpuVersion)
+	return FromReturn("SetVgpuVersion", ret)
+}
+
+func (x *Library) Shutdown() error {
+	ret := x.Interface.Shutdown()
+	return FromReturn("Shutdown", ret)
+}
+
+func (x *Library) SystemEventSetCreate(request *nvml.SystemEventSetCreateRequest) error {
+	ret := x.Interface.SystemEventSetCreate(request)
+	return FromReturn("SystemEventSetCreate", ret)
+}
+
+func (x *Library) SystemEventSetFree(request *nvml.SystemEventSetFreeRequest) error {
+	ret := x.Interface.SystemEventSetFree(request)
+	return FromReturn("SystemEventSetFree", ret)
+}
+
+func (x *Library) SystemEventSetWait(request *nvml.SystemEventSetWaitRequest) error {
+	ret := x.Interface.SystemEventSetWait(request)
+	return FromReturn("SystemEventSetWait", ret)
+}
+
+func (x *Library) SystemGetCPER_v1(cper *nvml.GetCPER_v1) error {
+	ret := x.Interface.SystemGetCPER_v1(cper)
+	return FromReturn("SystemGetCPER_v1", ret)
+}
+
+func (x *Library) SystemGetConfComputeCapabilities() (nvml.ConfComputeSystemCaps, error) {
+	r0, ret := x.Interface.SystemGetConfComputeCapabilities()
+	return r0, FromReturn("SystemGetConfComputeCapabilities", ret)
+}
+
+func (x *Library) SystemGetConfComputeGpusReadyState() (uint32, error) {
+	r0, ret := x.Interface.SystemGetConfComputeGpusReadyState()
+	return r0, FromReturn("SystemGetConfComputeGpusReadyState", ret)
+}
+
+func (x *Library) SystemGetConfComputeKeyRotationThresholdInfo() (nvml.ConfComputeGetKeyRotationThresholdInfo, error) {
+	r0, ret := x.Interface.SystemGetConfComputeKeyRotationThresholdInfo()
+	return r0, FromReturn("SystemGetConfComputeKeyRotationThresholdInfo", ret)
+}
+
+func (x *Library) SystemGetConfComputeSettings() (nvml.SystemConfComputeSettings, error) {
+	r0, ret := x.Interface.SystemGetConfComputeSettings()
+	return r0, FromReturn("SystemGetConfComputeSettings", ret)
+}
+
+func (x *Library) SystemGetConfComputeState() (nvml.ConfComputeSystemState, error) {
+	r0, ret := x.Interface.SystemGetConfComputeState()
+	return r0, FromReturn("SystemGetConfComputeState", ret)
+}
+
+func (x *Library) SystemGetCudaDriverVersion() (int, error) {
+	r0, ret := x.Interface.SystemGetCudaDriverVersion()
+	return r0, FromReturn("SystemGetCudaDriverVersion", ret)
+}
+
+func (x *Library) SystemGetCudaDriverVersion_v2() (int, error) {
+	r0, ret := x.Interface.SystemGetCudaDriverVersion_v2()
+	return r0, FromReturn("SystemGetCudaDriverVersion_v2", ret)
+}
+
+func (x *Library) SystemGetDriverBranch() (nvml.SystemDriverBranchInfo, error) {
+	r0, ret := x.Interface.SystemGetDriverBranch()
+	return r0, FromReturn("SystemGetDriverBranch", ret)
+}
+
+func (x *Library) SystemGetDriverVersion() (string, error) {
+	r0, ret := x.Interface.SystemGetDriverVersion()
+	return r0, FromReturn("SystemGetDriverVersion", ret)
+}
+
+func (x *Library) SystemGetHicVersion() ([]nvml.HwbcEntry, error) {
+	r0, ret := x.Interface.SystemGetHicVersion()
+	return r0, FromReturn("SystemGetHicVersion", ret)
+}
+
+func (x *Library) SystemGetNVMLVersion() (string, error) {
+	r0, ret := x.Interface.SystemGetNVMLVersion()
+	return r0, FromReturn("SystemGetNVMLVersion", ret)
+}
+
+func (x *Library) SystemGetNvlinkBwMode() (uint32, error) {
+	r0, ret := x.Interface.SystemGetNvlinkBwMode()
+	return r0, FromReturn("SystemGetNvlinkBwMode", ret)
+}
+
+func (x *Library) SystemGetProcessName(pid int) (string, error) {
+	r0, ret := x.Interface.SystemGetProcessName(pid)
+	return r0, FromReturn("SystemGetProcessName", ret)
+}
+
+func (x *Library) SystemGetTopologyGpuSet(cpuNumber int) ([]*Device, error) {
+	r0, ret := x.Interface.SystemGetTopologyGpuSet(cpuNumber)
+	return newDevices(r0), FromReturn("SystemGetTopologyGpuSet", ret)
+}
+
+func (x *Library) SystemRegisterEvents(request *nvml.SystemRegisterEventRequest) error {
+	ret := x.Interface.SystemRegisterEvents(request)
+	return FromReturn("SystemRegisterEvents", ret)
+}
+
+func (x *Library) SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) error {
+	ret := x.Interface.SystemSetConfComputeGpusReadyState(isAcceptingWork)
+	return FromReturn("SystemSetConfComputeGpusReadyState", ret)
+}
+
+func (x *Library) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo) error {
+	ret := x.Interface.SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo)
+	return FromReturn("SystemSetConfComputeKeyRotationThresholdInfo", ret)
+}
+
+func (x *Library) SystemSetNvlinkBwMode(nvlinkBwMode uint32) error {
+	ret := x.Interface.SystemSetNvlinkBwMode(nvlinkBwMode)
+	return FromReturn("SystemSetNvlinkBwMode", ret)
+}
+
+func (x *Library) UnitGetCount() (int, error) {
+	r0, ret := x.Interface.UnitGetCount()
+	return r0, FromReturn("UnitGetCount", ret)
+}
+
+func (x *Library) UnitGetDevices(unit *Unit) ([]*Device, error) {
+	r0, ret := x.Interface.UnitGetDevices(unwrapUnit(unit))
+	return newDevices(r0), FromReturn("UnitGetDevices", ret)
+}
+
+func (x *Library) UnitGetFanSpeedInfo(unit *Unit) (nvml.UnitFanSpeeds, error) {
+	r0, ret := x.Interface.UnitGetFanSpeedInfo(unwrapUnit(unit))
+	return r0, FromReturn("UnitGetFanSpeedInfo", ret)
+}
+
+func (x *Library) UnitGetHandleByIndex(index int) (*Unit, error) {
+	r0, ret := x.Interface.UnitGetHandleByIndex(index)
+	return NewUnit(r0), FromReturn("UnitGetHandleByIndex", ret)
+}
+
+func (x *Library) UnitGetLedState(unit *Unit) (nvml.LedState, error) {
+	r0, ret := x.Interface.UnitGetLedState(unwrapUnit(unit))
+	return r0, FromReturn("UnitGetLedState", ret)
+}
+
+func (x *Library) UnitGetPsuInfo(unit *Unit) (nvml.PSUInfo, error) {
+	r0, ret := x.Interface.UnitGetPsuInfo(unwrapUnit(unit))
+	return r0, FromReturn("UnitGetPsuInfo", ret)
+}
+
+func (x *Library) UnitGetTemperature(unit *Unit, ttype int) (uint32, error) {
+	r0, ret := x.Interface.UnitGetTemperature(unwrapUnit(unit), ttype)
+	return r0, FromReturn("UnitGetTemperature", ret)
+}
+
+func (x *Library) UnitGetUnitInfo(unit *Unit) (nvml.UnitInfo, error) {
+	r0, ret := x.Interface.UnitGetUnitInfo(unwrapUnit(unit))
+	return r0, FromReturn("UnitGetUnitInfo", ret)
+}
+
+func (x *Library) UnitSetLedState(unit *Unit, color nvml.LedColor) error {
+	ret := x.Interface.UnitSetLedState(unwrapUnit(unit), color)
+	return FromReturn("UnitSetLedState", ret)
+}
+
+func (x *Library) VgpuInstanceClearAccountingPids(vgpuInstance *VgpuInstance) error {
+	ret := x.Interface.VgpuInstanceClearAccountingPids(unwrapVgpuInstance(vgpuInstance))
+	return FromReturn("VgpuInstanceClearAccountingPids", ret)
+}
+
+func (x *Library) VgpuInstanceGetAccountingMode(vgpuInstance *VgpuInstance) (nvml.EnableState, error) {
+	r0, ret := x.Interface.VgpuInstanceGetAccountingMode(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetAccountingMode", ret)
+}
+
+func (x *Library) VgpuInstanceGetAccountingPids(vgpuInstance *VgpuInstance) ([]int, error) {
+	r0, ret := x.Interface.VgpuInstanceGetAccountingPids(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetAccountingPids", ret)
+}
+
+func (x *Library) VgpuInstanceGetAccountingStats(vgpuInstance *VgpuInstance, pid int) (nvml.AccountingStats, error) {
+	r0, ret := x.Interface.VgpuInstanceGetAccountingStats(unwrapVgpuInstance(vgpuInstance), pid)
+	return r0, FromReturn("VgpuInstanceGetAccountingStats", ret)
+}
+
+func (x *Library) VgpuInstanceGetEccMode(vgpuInstance *VgpuInstance) (nvml.EnableState, error) {
+	r0, ret := x.Interface.VgpuInstanceGetEccMode(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetEccMode", ret)
+}
+
+func (x *Library) VgpuInstanceGetEncoderCapacity(vgpuInstance *VgpuInstance) (int, error) {
+	r0, ret := x.Interface.VgpuInstanceGetEncoderCapacity(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetEncoderCapacity", ret)
+}
+
+func (x *Library) VgpuInstanceGetEncoderSessions(vgpuInstance *VgpuInstance) (int, nvml.EncoderSessionInfo, error) {
+	r0, r1, ret := x.Interface.VgpuInstanceGetEncoderSessions(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, FromReturn("VgpuInstanceGetEncoderSessions", ret)
+}
+
+func (x *Library) VgpuInstanceGetEncoderStats(vgpuInstance *VgpuInstance) (int, uint32, uint32, error) {
+	r0, r1, r2, ret := x.Interface.VgpuInstanceGetEncoderStats(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, r2, FromReturn("VgpuInstanceGetEncoderStats", ret)
+}
+
+func (x *Library) VgpuInstanceGetFBCSessions(vgpuInstance *VgpuInstance) (int, nvml.FBCSessionInfo, error) {
+	r0, r1, ret := x.Interface.VgpuInstanceGetFBCSessions(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, FromReturn("VgpuInstanceGetFBCSessions", ret)
+}
+
+func (x *Library) VgpuInstanceGetFBCStats(vgpuInstance *VgpuInstance) (nvml.FBCStats, error) {
+	r0, ret := x.Interface.VgpuInstanceGetFBCStats(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetFBCStats", ret)
+}
+
+func (x *Library) VgpuInstanceGetFbUsage(vgpuInstance *VgpuInstance) (uint64, error) {
+	r0, ret := x.Interface.VgpuInstanceGetFbUsage(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetFbUsage", ret)
+}
+
+func (x *Library) VgpuInstanceGetFrameRateLimit(vgpuInstance *VgpuInstance) (uint32, error) {
+	r0, ret := x.Interface.VgpuInstanceGetFrameRateLimit(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetFrameRateLimit", ret)
+}
+
+func (x *Library) VgpuInstanceGetGpuInstanceId(vgpuInstance *VgpuInstance) (int, error) {
+	r0, ret := x.Interface.VgpuInstanceGetGpuInstanceId(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetGpuInstanceId", ret)
+}
+
+func (x *Library) VgpuInstanceGetGpuPciId(vgpuInstance *VgpuInstance) (string, error) {
+	r0, ret := x.Interface.VgpuInstanceGetGpuPciId(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetGpuPciId", ret)
+}
+
+func (x *Library) VgpuInstanceGetLicenseInfo(vgpuInstance *VgpuInstance) (nvml.VgpuLicenseInfo, error) {
+	r0, ret := x.Interface.VgpuInstanceGetLicenseInfo(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetLicenseInfo", ret)
+}
+
+func (x *Library) VgpuInstanceGetLicenseStatus(vgpuInstance *VgpuInstance) (int, error) {
+	r0, ret := x.Interface.VgpuInstanceGetLicenseStatus(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetLicenseStatus", ret)
+}
+
+func (x *Library) VgpuInstanceGetMdevUUID(vgpuInstance *VgpuInstance) (string, error) {
+	r0, ret := x.Interface.VgpuInstanceGetMdevUUID(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetMdevUUID", ret)
+}
+
+func (x *Library) VgpuInstanceGetMetadata(vgpuInstance *VgpuInstance) (nvml.VgpuMetadata, error) {
+	r0, ret := x.Interface.VgpuInstanceGetMetadata(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetMetadata", ret)
+}
+
+func (x *Library) VgpuInstanceGetRuntimeStateSize(vgpuInstance *VgpuInstance) (nvml.VgpuRuntimeState, error) {
+	r0, ret := x.Interface.VgpuInstanceGetRuntimeStateSize(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetRuntimeStateSize", ret)
+}
+
+func (x *Library) VgpuInstanceGetType(vgpuInstance *VgpuInstance) (*VgpuTypeId, error) {
+	r0, ret := x.Interface.VgpuInstanceGetType(unwrapVgpuInstance(vgpuInstance))
+	return NewVgpuTypeId(r0), FromReturn("VgpuInstanceGetType", ret)
+}
+
+func (x *Library) VgpuInstanceGetUUID(vgpuInstance *VgpuInstance) (string, error) {
+	r0, ret := x.Interface.VgpuInstanceGetUUID(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetUUID", ret)
+}
+
+func (x *Library) VgpuInstanceGetVmDriverVersion(vgpuInstance *VgpuInstance) (string, error) {
+	r0, ret := x.Interface.VgpuInstanceGetVmDriverVersion(unwrapVgpuInstance(vgpuInstance))
+	return r0, FromReturn("VgpuInstanceGetVmDriverVersion", ret)
+}
+
+func (x *Library) VgpuInstanceGetVmID(vgpuInstance *VgpuInstance) (string, nvml.VgpuVmIdType, error) {
+	r0, r1, ret := x.Interface.VgpuInstanceGetVmID(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, FromReturn("VgpuInstanceGetVmID", ret)
+}
+
+func (x *Library) VgpuInstanceSetEncoderCapacity(vgpuInstance *VgpuInstance, encoderCapacity int) error {
+	ret := x.Interface.VgpuInstanceSetEncoderCapacity(unwrapVgpuInstance(vgpuInstance), encoderCapacity)
+	return FromReturn("VgpuInstanceSetEncoderCapacity", ret)
+}
+
+func (x *Library) VgpuTypeGetBAR1Info(vgpuTypeId *VgpuTypeId) (nvml.VgpuTypeBar1Info, error) {
+	r0, ret := x.Interface.VgpuTypeGetBAR1Info(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetBAR1Info", ret)
+}
+
+func (x *Library) VgpuTypeGetCapabilities(vgpuTypeId *VgpuTypeId, capability nvml.VgpuCapability) (bool, error) {
+	r0, ret := x.Interface.VgpuTypeGetCapabilities(unwrapVgpuTypeId(vgpuTypeId), capability)
+	return r0, FromReturn("VgpuTypeGetCapabilities", ret)
+}
+
+func (x *Library) VgpuTypeGetClass(vgpuTypeId *VgpuTypeId) (string, error) {
+	r0, ret := x.Interface.VgpuTypeGetClass(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetClass", ret)
+}
+
+func (x *Library) VgpuTypeGetDeviceID(vgpuTypeId *VgpuTypeId) (uint64, uint64, error) {
+	r0, r1, ret := x.Interface.VgpuTypeGetDeviceID(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, r1, FromReturn("VgpuTypeGetDeviceID", ret)
+}
+
+func (x *Library) VgpuTypeGetFrameRateLimit(vgpuTypeId *VgpuTypeId) (uint32, error) {
+	r0, ret := x.Interface.VgpuTypeGetFrameRateLimit(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetFrameRateLimit", ret)
+}
+
+func (x *Library) VgpuTypeGetFramebufferSize(vgpuTypeId *VgpuTypeId) (uint64, error) {
+	r0, ret := x.Interface.VgpuTypeGetFramebufferSize(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetFramebufferSize", ret)
+}
+
+func (x *Library) VgpuTypeGetGpuInstanceProfileId(vgpuTypeId *VgpuTypeId) (uint32, error) {
+	r0, ret := x.Interface.VgpuTypeGetGpuInstanceProfileId(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetGpuInstanceProfileId", ret)
+}
+
+func (x *Library) VgpuTypeGetLicense(vgpuTypeId *VgpuTypeId) (string, error) {
+	r0, ret := x.Interface.VgpuTypeGetLicense(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetLicense", ret)
+}
+
+func (x *Library) VgpuTypeGetMaxInstances(device *Device, vgpuTypeId *VgpuTypeId) (int, error) {
+	r0, ret := x.Interface.VgpuTypeGetMaxInstances(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, device.fromReturn("VgpuTypeGetMaxInstances", ret)
+}
+
+func (x *Library) VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *nvml.VgpuTypeMaxInstance) error {
+	ret := x.Interface.VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance)
+	return FromReturn("VgpuTypeGetMaxInstancesPerGpuInstance", ret)
+}
+
+func (x *Library) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId *VgpuTypeId) (int, error) {
+	r0, ret := x.Interface.VgpuTypeGetMaxInstancesPerVm(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetMaxInstancesPerVm", ret)
+}
+
+func (x *Library) VgpuTypeGetName(vgpuTypeId *VgpuTypeId) (string, error) {
+	r0, ret := x.Interface.VgpuTypeGetName(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetName", ret)
+}
+
+func (x *Library) VgpuTypeGetNumDisplayHeads(vgpuTypeId *VgpuTypeId) (int, error) {
+	r0, ret := x.Interface.VgpuTypeGetNumDisplayHeads(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, FromReturn("VgpuTypeGetNumDisplayHeads", ret)
+}
+
+func (x *Library) VgpuTypeGetResolution(vgpuTypeId *VgpuTypeId, displayIndex int) (uint32, uint32, error) {
+	r0, r1, ret := x.Interface.VgpuTypeGetResolution(unwrapVgpuTypeId(vgpuTypeId), displayIndex)
+	return r0, r1, FromReturn("VgpuTypeGetResolution", ret)
+}
+
+// Device calls the methods of an nvml.Device, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.Device directly.
+type Device struct {
+	nvml.Device
+	identity identity
+}
+
+// NewDevice returns a Device that calls the methods of v.
+func NewDevice(v nvml.Device) *Device {
+	if v == nil {
+		return nil
+	}
+	return &Device{Device: v}
+}
+
+// unwrapDevice returns the nvml.Device called by v.
+func unwrapDevice(v *Device) nvml.Device {
+	if v == nil {
+		return nil
+	}
+	return v.Device
+}
+
+// newDevices wraps each nvml.Device of v.
+func newDevices(v []nvml.Device) []*Device {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]*Device, len(v))
+	for i := range v {
+		wrapped[i] = NewDevice(v[i])
+	}
+	return wrapped
+}
+
+func (x *Device) ClearAccountingPids() error {
+	ret := x.Device.ClearAccountingPids()
+	return x.fromReturn("DeviceClearAccountingPids", ret)
+}
+
+func (x *Device) ClearCpuAffinity() error {
+	ret := x.Device.ClearCpuAffinity()
+	return x.fromReturn("DeviceClearCpuAffinity", ret)
+}
+
+func (x *Device) ClearEccErrorCounts(counterType nvml.EccCounterType) error {
+	ret := x.Device.ClearEccErrorCounts(counterType)
+	return x.fromReturn("DeviceClearEccErrorCounts", ret)
+}
+
+func (x *Device) ClearFieldValues(values []nvml.FieldValue) error {
+	ret := x.Device.ClearFieldValues(values)
+	return x.fromReturn("DeviceClearFieldValues", ret)
+}
+
+func (x *Device) CreateGpuInstance(info *nvml.GpuInstanceProfileInfo) (*GpuInstance, error) {
+	r0, ret := x.Device.CreateGpuInstance(info)
+	return NewGpuInstance(r0), x.fromReturn("DeviceCreateGpuInstance", ret)
+}
+
+func (x *Device) CreateGpuInstanceWithPlacement(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (*GpuInstance, error) {
+	r0, ret := x.Device.CreateGpuInstanceWithPlacement(info, placement)
+	return NewGpuInstance(r0), x.fromReturn("DeviceCreateGpuInstanceWithPlacement", ret)
+}
+
+func (x *Device) FreezeNvLinkUtilizationCounter(link int, counter int, freeze nvml.EnableState) error {
+	ret := x.Device.FreezeNvLinkUtilizationCounter(link, counter, freeze)
+	return x.fromReturn("DeviceFreezeNvLinkUtilizationCounter", ret)
+}
+
+func (x *Device) GetAPIRestriction(apiType nvml.RestrictedAPI) (nvml.EnableState, error) {
+	r0, ret := x.Device.GetAPIRestriction(apiType)
+	return r0, x.fromReturn("DeviceGetAPIRestriction", ret)
+}
+
+func (x *Device) GetAccountingBufferSize() (int, error) {
+	r0, ret := x.Device.GetAccountingBufferSize()
+	return r0, x.fromReturn("DeviceGetAccountingBufferSize", ret)
+}
+
+func (x *Device) GetAccountingMode() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetAccountingMode()
+	return r0, x.fromReturn("DeviceGetAccountingMode", ret)
+}
+
+func (x *Device) GetAccountingPids() ([]int, error) {
+	r0, ret := x.Device.GetAccountingPids()
+	return r0, x.fromReturn("DeviceGetAccountingPids", ret)
+}
+
+func (x *Device) GetAccountingStats(pid uint32) (nvml.AccountingStats, error) {
+	r0, ret := x.Device.GetAccountingStats(pid)
+	return r0, x.fromReturn("DeviceGetAccountingStats", ret)
+}
+
+func (x *Device) GetAccountingStats_v2(pid uint32) (nvml.AccountingStats_v2, error) {
+	r0, ret := x.Device.GetAccountingStats_v2(pid)
+	return r0, x.fromReturn("DeviceGetAccountingStats_v2", ret)
+}
+
+func (x *Device) GetActiveVgpus() ([]*VgpuInstance, error) {
+	r0, ret := x.Device.GetActiveVgpus()
+	return newVgpuInstances(r0), x.fromReturn("DeviceGetActiveVgpus", ret)
+}
+
+func (x *Device) GetAdaptiveClockInfoStatus() (uint32, error) {
+	r0, ret := x.Device.GetAdaptiveClockInfoStatus()
+	return r0, x.fromReturn("DeviceGetAdaptiveClockInfoStatus", ret)
+}
+
+func (x *Device) GetAddressingMode() (nvml.DeviceAddressingMode, error) {
+	r0, ret := x.Device.GetAddressingMode()
+	return r0, x.fromReturn("DeviceGetAddressingMode", ret)
+}
+
+func (x *Device) GetApplicationsClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Device.GetApplicationsClock(clockType)
+	return r0, x.fromReturn("DeviceGetApplicationsClock", ret)
+}
+
+func (x *Device) GetArchitecture() (nvml.DeviceArchitecture, error) {
+	r0, ret := x.Device.GetArchitecture()
+	return r0, x.fromReturn("DeviceGetArchitecture", ret)
+}
+
+func (x *Device) GetAttributes() (nvml.DeviceAttributes, error) {
+	r0, ret := x.Device.GetAttributes()
+	return r0, x.fromReturn("DeviceGetAttributes", ret)
+}
+
+func (x *Device) GetAutoBoostedClocksEnabled() (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := x.Device.GetAutoBoostedClocksEnabled()
+	return r0, r1, x.fromReturn("DeviceGetAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Device) GetBAR1MemoryInfo() (nvml.BAR1Memory, error) {
+	r0, ret := x.Device.GetBAR1MemoryInfo()
+	return r0, x.fromReturn("DeviceGetBAR1MemoryInfo", ret)
+}
+
+func (x *Device) GetBBXTimeData_v1() (nvml.BBXTimeData_v1, error) {
+	r0, ret := x.Device.GetBBXTimeData_v1()
+	return r0, x.fromReturn("DeviceGetBBXTimeData_v1", ret)
+}
+
+func (x *Device) GetBoardId() (uint32, error) {
+	r0, ret := x.Device.GetBoardId()
+	return r0, x.fromReturn("DeviceGetBoardId", ret)
+}
+
+func (x *Device) GetBoardPartNumber() (string, error) {
+	r0, ret := x.Device.GetBoardPartNumber()
+	return r0, x.fromReturn("DeviceGetBoardPartNumber", ret)
+}
+
+func (x *Device) GetBrand() (nvml.BrandType, error) {
+	r0, ret := x.Device.GetBrand()
+	return r0, x.fromReturn("DeviceGetBrand", ret)
+}
+
+func (x *Device) GetBridgeChipInfo() (nvml.BridgeChipHierarchy, error) {
+	r0, ret := x.Device.GetBridgeChipInfo()
+	return r0, x.fromReturn("DeviceGetBridgeChipInfo", ret)
+}
+
+func (x *Device) GetBusType() (nvml.BusType, error) {
+	r0, ret := x.Device.GetBusType()
+	return r0, x.fromReturn("DeviceGetBusType", ret)
+}
+
+func (x *Device) GetCapabilities() (nvml.DeviceCapabilities, error) {
+	r0, ret := x.Device.GetCapabilities()
+	return r0, x.fromReturn("DeviceGetCapabilities", ret)
+}
+
+func (x *Device) GetClkMonStatus() (nvml.ClkMonStatus, error) {
+	r0, ret := x.Device.GetClkMonStatus()
+	return r0, x.fromReturn("DeviceGetClkMonStatus", ret)
+}
+
+func (x *Device) GetClock(clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error) {
+	r0, ret := x.Device.GetClock(clockType, clockId)
+	return r0, x.fromReturn("DeviceGetClock", ret)
+}
+
+func (x *Device) GetClockInfo(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Device.GetClockInfo(clockType)
+	return r0, x.fromReturn("DeviceGetClockInfo", ret)
+}
+
+func (x *Device) GetClockOffsets() (nvml.ClockOffset, error) {
+	r0, ret := x.Device.GetClockOffsets()
+	return r0, x.fromReturn("DeviceGetClockOffsets", ret)
+}
+
+func (x *Device) GetComputeInstanceId() (int, error) {
+	r0, ret := x.Device.GetComputeInstanceId()
+	return r0, x.fromReturn("DeviceGetComputeInstanceId", ret)
+}
+
+func (x *Device) GetComputeMode() (nvml.ComputeMode, error) {
+	r0, ret := x.Device.GetComputeMode()
+	return r0, x.fromReturn("DeviceGetComputeMode", ret)
+}
+
+func (x *Device) GetComputeRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Device.GetComputeRunningProcesses()
+	return r0, x.fromReturn("DeviceGetComputeRunningProcesses", ret)
+}
+
+func (x *Device) GetConfComputeGpuAttestationReport(gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error {
+	ret := x.Device.GetConfComputeGpuAttestationReport(gpuAtstReport)
+	return x.fromReturn("DeviceGetConfComputeGpuAttestationReport", ret)
+}
+
+func (x *Device) GetConfComputeGpuCertificate() (nvml.ConfComputeGpuCertificate, error) {
+	r0, ret := x.Device.GetConfComputeGpuCertificate()
+	return r0, x.fromReturn("DeviceGetConfComputeGpuCertificate", ret)
+}
+
+func (x *Device) GetConfComputeMemSizeInfo() (nvml.ConfComputeMemSizeInfo, error) {
+	r0, ret := x.Device.GetConfComputeMemSizeInfo()
+	return r0, x.fromReturn("DeviceGetConfComputeMemSizeInfo", ret)
+}
+
+func (x *Device) GetConfComputeProtectedMemoryUsage() (nvml.Memory, error) {
+	r0, ret := x.Device.GetConfComputeProtectedMemoryUsage()
+	return r0, x.fromReturn("DeviceGetConfComputeProtectedMemoryUsage", ret)
+}
+
+func (x *Device) GetCoolerInfo() (nvml.CoolerInfo, error) {
+	r0, ret := x.Device.GetCoolerInfo()
+	return r0, x.fromReturn("DeviceGetCoolerInfo", ret)
+}
+
+func (x *Device) GetCpuAffinity(numCPUs int) ([]uint, error) {
+	r0, ret := x.Device.GetCpuAffinity(numCPUs)
+	return r0, x.fromReturn("DeviceGetCpuAffinity", ret)
+}
+
+func (x *Device) GetCpuAffinityWithinScope(numCPUs int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := x.Device.GetCpuAffinityWithinScope(numCPUs, scope)
+	return r0, x.fromReturn("DeviceGetCpuAffinityWithinScope", ret)
+}
+
+func (x *Device) GetCreatableVgpus() ([]*VgpuTypeId, error) {
+	r0, ret := x.Device.GetCreatableVgpus()
+	return newVgpuTypeIds(r0), x.fromReturn("DeviceGetCreatableVgpus", ret)
+}
+
+func (x *Device) GetCudaComputeCapability() (int, int, error) {
+	r0, r1, ret := x.Device.GetCudaComputeCapability()
+	return r0, r1, x.fromReturn("DeviceGetCudaComputeCapability", ret)
+}
+
+func (x *Device) GetCurrPcieLinkGeneration() (int, error) {
+	r0, ret := x.Device.GetCurrPcieLinkGeneration()
+	return r0, x.fromReturn("DeviceGetCurrPcieLinkGeneration", ret)
+}
+
+func (x *Device) GetCurrPcieLinkWidth() (int, error) {
+	r0, ret := x.Device.GetCurrPcieLinkWidth()
+	return r0, x.fromReturn("DeviceGetCurrPcieLinkWidth", ret)
+}
+
+func (x *Device) GetCurrentClockFreqs() (nvml.DeviceCurrentClockFreqs, error) {
+	r0, ret := x.Device.GetCurrentClockFreqs()
+	return r0, x.fromReturn("DeviceGetCurrentClockFreqs", ret)
+}
+
+func (x *Device) GetCurrentClocksEventReasons() (uint64, error) {
+	r0, ret := x.Device.GetCurrentClocksEventReasons()
+	return r0, x.fromReturn("DeviceGetCurrentClocksEventReasons", ret)
+}
+
+func (x *Device) GetCurrentClocksThrottleReasons() (uint64, error) {
+	r0, ret := x.Device.GetCurrentClocksThrottleReasons()
+	return r0, x.fromReturn("DeviceGetCurrentClocksThrottleReasons", ret)
+}
+
+func (x *Device) GetDecoderUtilization() (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetDecoderUtilization()
+	return r0, r1, x.fromReturn("DeviceGetDecoderUtilization", ret)
+}
+
+func (x *Device) GetDefaultApplicationsClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Device.GetDefaultApplicationsClock(clockType)
+	return r0, x.fromReturn("DeviceGetDefaultApplicationsClock", ret)
+}
+
+func (x *Device) GetDefaultEccMode() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetDefaultEccMode()
+	return r0, x.fromReturn("DeviceGetDefaultEccMode", ret)
+}
+
+func (x *Device) GetDetailedEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error) {
+	r0, ret := x.Device.GetDetailedEccErrors(errorType, counterType)
+	return r0, x.fromReturn("DeviceGetDetailedEccErrors", ret)
+}
+
+func (x *Device) GetDeviceHandleFromMigDeviceHandle() (*Device, error) {
+	r0, ret := x.Device.GetDeviceHandleFromMigDeviceHandle()
+	return NewDevice(r0), x.fromReturn("DeviceGetDeviceHandleFromMigDeviceHandle", ret)
+}
+
+func (x *Device) GetDisplayActive() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetDisplayActive()
+	return r0, x.fromReturn("DeviceGetDisplayActive", ret)
+}
+
+func (x *Device) GetDisplayMode() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetDisplayMode()
+	return r0, x.fromReturn("DeviceGetDisplayMode", ret)
+}
+
+func (x *Device) GetDramEncryptionMode() (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error) {
+	r0, r1, ret := x.Device.GetDramEncryptionMode()
+	return r0, r1, x.fromReturn("DeviceGetDramEncryptionMode", ret)
+}
+
+func (x *Device) GetDriverModel() (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := x.Device.GetDriverModel()
+	return r0, r1, x.fromReturn("DeviceGetDriverModel", ret)
+}
+
+func (x *Device) GetDriverModel_v2() (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := x.Device.GetDriverModel_v2()
+	return r0, r1, x.fromReturn("DeviceGetDriverModel_v2", ret)
+}
+
+func (x *Device) GetDynamicPstatesInfo() (nvml.GpuDynamicPstatesInfo, error) {
+	r0, ret := x.Device.GetDynamicPstatesInfo()
+	return r0, x.fromReturn("DeviceGetDynamicPstatesInfo", ret)
+}
+
+func (x *Device) GetEccMode() (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := x.Device.GetEccMode()
+	return r0, r1, x.fromReturn("DeviceGetEccMode", ret)
+}
+
+func (x *Device) GetEncoderCapacity(encoderQueryType nvml.EncoderType) (int, error) {
+	r0, ret := x.Device.GetEncoderCapacity(encoderQueryType)
+	return r0, x.fromReturn("DeviceGetEncoderCapacity", ret)
+}
+
+func (x *Device) GetEncoderSessions() ([]nvml.EncoderSessionInfo, error) {
+	r0, ret := x.Device.GetEncoderSessions()
+	return r0, x.fromReturn("DeviceGetEncoderSessions", ret)
+}
+
+func (x *Device) GetEncoderStats() (int, uint32, uint32, error) {
+	r0, r1, r2, ret := x.Device.GetEncoderStats()
+	return r0, r1, r2, x.fromReturn("DeviceGetEncoderStats", ret)
+}
+
+func (x *Device) GetEncoderUtilization() (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetEncoderUtilization()
+	return r0, r1, x.fromReturn("DeviceGetEncoderUtilization", ret)
+}
+
+func (x *Device) GetEnforcedPowerLimit() (uint32, error) {
+	r0, ret := x.Device.GetEnforcedPowerLimit()
+	return r0, x.fromReturn("DeviceGetEnforcedPowerLimit", ret)
+}
+
+func (x *Device) GetFBCSessions() ([]nvml.FBCSessionInfo, error) {
+	r0, ret := x.Device.GetFBCSessions()
+	return r0, x.fromReturn("DeviceGetFBCSessions", ret)
+}
+
+func (x *Device) GetFBCStats() (nvml.FBCStats, error) {
+	r0, ret := x.Device.GetFBCStats()
+	return r0, x.fromReturn("DeviceGetFBCStats", ret)
+}
+
+func (x *Device) GetFanControlPolicy_v2(fan int) (nvml.FanControlPolicy, error) {
+	r0, ret := x.Device.GetFanControlPolicy_v2(fan)
+	return r0, x.fromReturn("DeviceGetFanControlPolicy_v2", ret)
+}
+
+func (x *Device) GetFanSpeed() (uint32, error) {
+	r0, ret := x.Device.GetFanSpeed()
+	return r0, x.fromReturn("DeviceGetFanSpeed", ret)
+}
+
+func (x *Device) GetFanSpeedRPM() (nvml.FanSpeedInfo, error) {
+	r0, ret := x.Device.GetFanSpeedRPM()
+	return r0, x.fromReturn("DeviceGetFanSpeedRPM", ret)
+}
+
+func (x *Device) GetFanSpeed_v2(fan int) (uint32, error) {
+	r0, ret := x.Device.GetFanSpeed_v2(fan)
+	return r0, x.fromReturn("DeviceGetFanSpeed_v2", ret)
+}
+
+func (x *Device) GetFieldValues(values []nvml.FieldValue) error {
+	ret := x.Device.GetFieldValues(values)
+	return x.fromReturn("DeviceGetFieldValues", ret)
+}
+
+func (x *Device) GetGpcClkMinMaxVfOffset() (int, int, error) {
+	r0, r1, ret := x.Device.GetGpcClkMinMaxVfOffset()
+	return r0, r1, x.fromReturn("DeviceGetGpcClkMinMaxVfOffset", ret)
+}
+
+func (x *Device) GetGpcClkVfOffset() (int, error) {
+	r0, ret := x.Device.GetGpcClkVfOffset()
+	return r0, x.fromReturn("DeviceGetGpcClkVfOffset", ret)
+}
+
+func (x *Device) GetGpuFabricInfo() (nvml.GpuFabricInfo, error) {
+	r0, ret := x.Device.GetGpuFabricInfo()
+	return r0, x.fromReturn("DeviceGetGpuFabricInfo", ret)
+}
+
+func (x *Device) GetGpuInstanceById(id int) (*GpuInstance, error) {
+	r0, ret := x.Device.GetGpuInstanceById(id)
+	return NewGpuInstance(r0), x.fromReturn("DeviceGetGpuInstanceById", ret)
+}
+
+func (x *Device) GetGpuInstanceId() (int, error) {
+	r0, ret := x.Device.GetGpuInstanceId()
+	return r0, x.fromReturn("DeviceGetGpuInstanceId", ret)
+}
+
+func (x *Device) GetGpuInstancePossiblePlacements(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error) {
+	r0, ret := x.Device.GetGpuInstancePossiblePlacements(info)
+	return r0, x.fromReturn("DeviceGetGpuInstancePossiblePlacements", ret)
+}
+
+func (x *Device) GetGpuInstanceProfileInfo(profile int) (nvml.GpuInstanceProfileInfo, error) {
+	r0, ret := x.Device.GetGpuInstanceProfileInfo(profile)
+	return r0, x.fromReturn("DeviceGetGpuInstanceProfileInfo", ret)
+}
+
+func (x *Device) GetGpuInstanceRemainingCapacity(info *nvml.GpuInstanceProfileInfo) (int, error) {
+	r0, ret := x.Device.GetGpuInstanceRemainingCapacity(info)
+	return r0, x.fromReturn("DeviceGetGpuInstanceRemainingCapacity", ret)
+}
+
+func (x *Device) GetGpuInstances(info *nvml.GpuInstanceProfileInfo) ([]*GpuInstance, error) {
+	r0, ret := x.Device.GetGpuInstances(info)
+	return newGpuInstances(r0), x.fromReturn("DeviceGetGpuInstances", ret)
+}
+
+func (x *Device) GetGpuMaxPcieLinkGeneration() (int, error) {
+	r0, ret := x.Device.GetGpuMaxPcieLinkGeneration()
+	return r0, x.fromReturn("DeviceGetGpuMaxPcieLinkGeneration", ret)
+}
+
+func (x *Device) GetGpuOperationMode() (nvml.GpuOperationMode, nvml.GpuOperationMode, error) {
+	r0, r1, ret := x.Device.GetGpuOperationMode()
+	return r0, r1, x.fromReturn("DeviceGetGpuOperationMode", ret)
+}
+
+func (x *Device) GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Device.GetGraphicsRunningProcesses()
+	return r0, x.fromReturn("DeviceGetGraphicsRunningProcesses", ret)
+}
+
+func (x *Device) GetGridLicensableFeatures() (nvml.GridLicensableFeatures, error) {
+	r0, ret := x.Device.GetGridLicensableFeatures()
+	return r0, x.fromReturn("DeviceGetGridLicensableFeatures", ret)
+}
+
+func (x *Device) GetGspFirmwareMode() (bool, bool, error) {
+	r0, r1, ret := x.Device.GetGspFirmwareMode()
+	return r0, r1, x.fromReturn("DeviceGetGspFirmwareMode", ret)
+}
+
+func (x *Device) GetGspFirmwareVersion() (string, error) {
+	r0, ret := x.Device.GetGspFirmwareVersion()
+	return r0, x.fromReturn("DeviceGetGspFirmwareVersion", ret)
+}
+
+func (x *Device) GetHostVgpuMode() (nvml.HostVgpuMode, error) {
+	r0, ret := x.Device.GetHostVgpuMode()
+	return r0, x.fromReturn("DeviceGetHostVgpuMode", ret)
+}
+
+func (x *Device) GetHostname_v1() (string, error) {
+	r0, ret := x.Device.GetHostname_v1()
+	return r0, x.fromReturn("DeviceGetHostname_v1", ret)
+}
+
+func (x *Device) GetIndex() (int, error) {
+	r0, ret := x.Device.GetIndex()
+	return r0, x.fromReturn("DeviceGetIndex", ret)
+}
+
+func (x *Device) GetInforomConfigurationChecksum() (uint32, error) {
+	r0, ret := x.Device.GetInforomConfigurationChecksum()
+	return r0, x.fromReturn("DeviceGetInforomConfigurationChecksum", ret)
+}
+
+func (x *Device) GetInforomImageVersion() (string, error) {
+	r0, ret := x.Device.GetInforomImageVersion()
+	return r0, x.fromReturn("DeviceGetInforomImageVersion", ret)
+}
+
+func (x *Device) GetInforomVersion(object nvml.InforomObject) (string, error) {
+	r0, ret := x.Device.GetInforomVersion(object)
+	return r0, x.fromReturn("DeviceGetInforomVersion", ret)
+}
+
+func (x *Device) GetIrqNum() (int, error) {
+	r0, ret := x.Device.GetIrqNum()
+	return r0, x.fromReturn("DeviceGetIrqNum", ret)
+}
+
+func (x *Device) GetJpgUtilization() (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetJpgUtilization()
+	return r0, r1, x.fromReturn("DeviceGetJpgUtilization", ret)
+}
+
+func (x *Device) GetLastBBXFlushTime() (uint64, uint, error) {
+	r0, r1, ret := x.Device.GetLastBBXFlushTime()
+	return r0, r1, x.fromReturn("DeviceGetLastBBXFlushTime", ret)
+}
+
+func (x *Device) GetMPSComputeRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := x.Device.GetMPSComputeRunningProcesses()
+	return r0, x.fromReturn("DeviceGetMPSComputeRunningProcesses", ret)
+}
+
+func (x *Device) GetMarginTemperature() (nvml.MarginTemperature, error) {
+	r0, ret := x.Device.GetMarginTemperature()
+	return r0, x.fromReturn("DeviceGetMarginTemperature", ret)
+}
+
+func (x *Device) GetMaxClockInfo(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Device.GetMaxClockInfo(clockType)
+	return r0, x.fromReturn("DeviceGetMaxClockInfo", ret)
+}
+
+func (x *Device) GetMaxCustomerBoostClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := x.Device.GetMaxCustomerBoostClock(clockType)
+	return r0, x.fromReturn("DeviceGetMaxCustomerBoostClock", ret)
+}
+
+func (x *Device) GetMaxMigDeviceCount() (int, error) {
+	r0, ret := x.Device.GetMaxMigDeviceCount()
+	return r0, x.fromReturn("DeviceGetMaxMigDeviceCount", ret)
+}
+
+func (x *Device) GetMaxPcieLinkGeneration() (int, error) {
+	r0, ret := x.Device.GetMaxPcieLinkGeneration()
+	return r0, x.fromReturn("DeviceGetMaxPcieLinkGeneration", ret)
+}
+
+func (x *Device) GetMaxPcieLinkWidth() (int, error) {
+	r0, ret := x.Device.GetMaxPcieLinkWidth()
+	return r0, x.fromReturn("DeviceGetMaxPcieLinkWidth", ret)
+}
+
+func (x *Device) GetMemClkMinMaxVfOffset() (int, int, error) {
+	r0, r1, ret := x.Device.GetMemClkMinMaxVfOffset()
+	return r0, r1, x.fromReturn("DeviceGetMemClkMinMaxVfOffset", ret)
+}
+
+func (x *Device) GetMemClkVfOffset() (int, error) {
+	r0, ret := x.Device.GetMemClkVfOffset()
+	return r0, x.fromReturn("DeviceGetMemClkVfOffset", ret)
+}
+
+func (x *Device) GetMemoryAffinity(numNodes int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := x.Device.GetMemoryAffinity(numNodes, scope)
+	return r0, x.fromReturn("DeviceGetMemoryAffinity", ret)
+}
+
+func (x *Device) GetMemoryBusWidth() (uint32, error) {
+	r0, ret := x.Device.GetMemoryBusWidth()
+	return r0, x.fromReturn("DeviceGetMemoryBusWidth", ret)
+}
+
+func (x *Device) GetMemoryErrorCounter(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error) {
+	r0, ret := x.Device.GetMemoryErrorCounter(errorType, counterType, locationType)
+	return r0, x.fromReturn("DeviceGetMemoryErrorCounter", ret)
+}
+
+func (x *Device) GetMemoryInfo() (nvml.Memory, error) {
+	r0, ret := x.Device.GetMemoryInfo()
+	return r0, x.fromReturn("DeviceGetMemoryInfo", ret)
+}
+
+func (x *Device) GetMemoryInfo_v2() (nvml.Memory_v2, error) {
+	r0, ret := x.Device.GetMemoryInfo_v2()
+	return r0, x.fromReturn("DeviceGetMemoryInfo_v2", ret)
+}
+
+func (x *Device) GetMigDeviceHandleByIndex(index int) (*Device, error) {
+	r0, ret := x.Device.GetMigDeviceHandleByIndex(index)
+	return NewDevice(r0), x.fromReturn("DeviceGetMigDeviceHandleByIndex", ret)
+}
+
+func (x *Device) GetMigMode() (int, int, error) {
+	r0, r1, ret := x.Device.GetMigMode()
+	return r0, r1, x.fromReturn("DeviceGetMigMode", ret)
+}
+
+func (x *Device) GetMinMaxClockOfPState(clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetMinMaxClockOfPState(clockType, pstate)
+	return r0, r1, x.fromReturn("DeviceGetMinMaxClockOfPState", ret)
+}
+
+func (x *Device) GetMinMaxFanSpeed() (int, int, error) {
+	r0, r1, ret := x.Device.GetMinMaxFanSpeed()
+	return r0, r1, x.fromReturn("DeviceGetMinMaxFanSpeed", ret)
+}
+
+func (x *Device) GetMinorNumber() (int, error) {
+	r0, ret := x.Device.GetMinorNumber()
+	return r0, x.fromReturn("DeviceGetMinorNumber", ret)
+}
+
+func (x *Device) GetModuleId() (int, error) {
+	r0, ret := x.Device.GetModuleId()
+	return r0, x.fromReturn("DeviceGetModuleId", ret)
+}
+
+func (x *Device) GetMultiGpuBoard() (int, error) {
+	r0, ret := x.Device.GetMultiGpuBoard()
+	return r0, x.fromReturn("DeviceGetMultiGpuBoard", ret)
+}
+
+func (x *Device) GetName() (string, error) {
+	r0, ret := x.Device.GetName()
+	return r0, x.fromReturn("DeviceGetName", ret)
+}
+
+func (x *Device) GetNumFans() (int, error) {
+	r0, ret := x.Device.GetNumFans()
+	return r0, x.fromReturn("DeviceGetNumFans", ret)
+}
+
+func (x *Device) GetNumGpuCores() (int, error) {
+	r0, ret := x.Device.GetNumGpuCores()
+	return r0, x.fromReturn("DeviceGetNumGpuCores", ret)
+}
+
+func (x *Device) GetNumaNodeId() (int, error) {
+	r0, ret := x.Device.GetNumaNodeId()
+	return r0, x.fromReturn("DeviceGetNumaNodeId", ret)
+}
+
+func (x *Device) GetNvLinkCapability(link int, capability nvml.NvLinkCapability) (uint32, error) {
+	r0, ret := x.Device.GetNvLinkCapability(link, capability)
+	return r0, x.fromReturn("DeviceGetNvLinkCapability", ret)
+}
+
+func (x *Device) GetNvLinkErrorCounter(link int, counter nvml.NvLinkErrorCounter) (uint64, error) {
+	r0, ret := x.Device.GetNvLinkErrorCounter(link, counter)
+	return r0, x.fromReturn("DeviceGetNvLinkErrorCounter", ret)
+}
+
+func (x *Device) GetNvLinkRemoteDeviceType(link int) (nvml.IntNvLinkDeviceType, error) {
+	r0, ret := x.Device.GetNvLinkRemoteDeviceType(link)
+	return r0, x.fromReturn("DeviceGetNvLinkRemoteDeviceType", ret)
+}
+
+func (x *Device) GetNvLinkRemotePciInfo(link int) (nvml.PciInfo, error) {
+	r0, ret := x.Device.GetNvLinkRemotePciInfo(link)
+	return r0, x.fromReturn("DeviceGetNvLinkRemotePciInfo", ret)
+}
+
+func (x *Device) GetNvLinkState(link int) (nvml.EnableState, error) {
+	r0, ret := x.Device.GetNvLinkState(link)
+	return r0, x.fromReturn("DeviceGetNvLinkState", ret)
+}
+
+func (x *Device) GetNvLinkUtilizationControl(link int, counter int) (nvml.NvLinkUtilizationControl, error) {
+	r0, ret := x.Device.GetNvLinkUtilizationControl(link, counter)
+	return r0, x.fromReturn("DeviceGetNvLinkUtilizationControl", ret)
+}
+
+func (x *Device) GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, error) {
+	r0, r1, ret := x.Device.GetNvLinkUtilizationCounter(link, counter)
+	return r0, r1, x.fromReturn("DeviceGetNvLinkUtilizationCounter", ret)
+}
+
+func (x *Device) GetNvLinkVersion(link int) (uint32, error) {
+	r0, ret := x.Device.GetNvLinkVersion(link)
+	return r0, x.fromReturn("DeviceGetNvLinkVersion", ret)
+}
+
+func (x *Device) GetNvlinkBwMode() (nvml.NvlinkGetBwMode, error) {
+	r0, ret := x.Device.GetNvlinkBwMode()
+	return r0, x.fromReturn("DeviceGetNvlinkBwMode", ret)
+}
+
+func (x *Device) GetNvlinkSupportedBwModes() (nvml.NvlinkSupportedBwModes, error) {
+	r0, ret := x.Device.GetNvlinkSupportedBwModes()
+	return r0, x.fromReturn("DeviceGetNvlinkSupportedBwModes", ret)
+}
+
+func (x *Device) GetOfaUtilization() (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetOfaUtilization()
+	return r0, r1, x.fromReturn("DeviceGetOfaUtilization", ret)
+}
+
+func (x *Device) GetP2PStatus(device2 *Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error) {
+	r0, ret := x.Device.GetP2PStatus(unwrapDevice(device2), p2pIndex)
+	return r0, x.fromReturn("DeviceGetP2PStatus", ret)
+}
+
+func (x *Device) GetPciInfo() (nvml.PciInfo, error) {
+	r0, ret := x.Device.GetPciInfo()
+	return r0, x.fromReturn("DeviceGetPciInfo", ret)
+}
+
+func (x *Device) GetPciInfoExt() (nvml.PciInfoExt, error) {
+	r0, ret := x.Device.GetPciInfoExt()
+	return r0, x.fromReturn("DeviceGetPciInfoExt", ret)
+}
+
+func (x *Device) GetPcieLinkMaxSpeed() (uint32, error) {
+	r0, ret := x.Device.GetPcieLinkMaxSpeed()
+	return r0, x.fromReturn("DeviceGetPcieLinkMaxSpeed", ret)
+}
+
+func (x *Device) GetPcieReplayCounter() (int, error) {
+	r0, ret := x.Device.GetPcieReplayCounter()
+	return r0, x.fromReturn("DeviceGetPcieReplayCounter", ret)
+}
+
+func (x *Device) GetPcieSpeed() (int, error) {
+	r0, ret := x.Device.GetPcieSpeed()
+	return r0, x.fromReturn("DeviceGetPcieSpeed", ret)
+}
+
+func (x *Device) GetPcieThroughput(counter nvml.PcieUtilCounter) (uint32, error) {
+	r0, ret := x.Device.GetPcieThroughput(counter)
+	return r0, x.fromReturn("DeviceGetPcieThroughput", ret)
+}
+
+func (x *Device) GetPdi() (nvml.Pdi, error) {
+	r0, ret := x.Device.GetPdi()
+	return r0, x.fromReturn("DeviceGetPdi", ret)
+}
+
+func (x *Device) GetPerformanceModes() (nvml.DevicePerfModes, error) {
+	r0, ret := x.Device.GetPerformanceModes()
+	return r0, x.fromReturn("DeviceGetPerformanceModes", ret)
+}
+
+func (x *Device) GetPerformanceState() (nvml.Pstates, error) {
+	r0, ret := x.Device.GetPerformanceState()
+	return r0, x.fromReturn("DeviceGetPerformanceState", ret)
+}
+
+func (x *Device) GetPersistenceMode() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetPersistenceMode()
+	return r0, x.fromReturn("DeviceGetPersistenceMode", ret)
+}
+
+func (x *Device) GetPgpuMetadataString() (string, error) {
+	r0, ret := x.Device.GetPgpuMetadataString()
+	return r0, x.fromReturn("DeviceGetPgpuMetadataString", ret)
+}
+
+func (x *Device) GetPlatformInfo() (nvml.PlatformInfo, error) {
+	r0, ret := x.Device.GetPlatformInfo()
+	return r0, x.fromReturn("DeviceGetPlatformInfo", ret)
+}
+
+func (x *Device) GetPowerManagementDefaultLimit() (uint32, error) {
+	r0, ret := x.Device.GetPowerManagementDefaultLimit()
+	return r0, x.fromReturn("DeviceGetPowerManagementDefaultLimit", ret)
+}
+
+func (x *Device) GetPowerManagementLimit() (uint32, error) {
+	r0, ret := x.Device.GetPowerManagementLimit()
+	return r0, x.fromReturn("DeviceGetPowerManagementLimit", ret)
+}
+
+func (x *Device) GetPowerManagementLimitConstraints() (uint32, uint32, error) {
+	r0, r1, ret := x.Device.GetPowerManagementLimitConstraints()
+	return r0, r1, x.fromReturn("DeviceGetPowerManagementLimitConstraints", ret)
+}
+
+func (x *Device) GetPowerManagementMode() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetPowerManagementMode()
+	return r0, x.fromReturn("DeviceGetPowerManagementMode", ret)
+}
+
+func (x *Device) GetPowerMizerMode_v1() (nvml.DevicePowerMizerModes_v1, error) {
+	r0, ret := x.Device.GetPowerMizerMode_v1()
+	return r0, x.fromReturn("DeviceGetPowerMizerMode_v1", ret)
+}
+
+func (x *Device) GetPowerSource() (nvml.PowerSource, error) {
+	r0, ret := x.Device.GetPowerSource()
+	return r0, x.fromReturn("DeviceGetPowerSource", ret)
+}
+
+func (x *Device) GetPowerState() (nvml.Pstates, error) {
+	r0, ret := x.Device.GetPowerState()
+	return r0, x.fromReturn("DeviceGetPowerState", ret)
+}
+
+func (x *Device) GetPowerUsage() (uint32, error) {
+	r0, ret := x.Device.GetPowerUsage()
+	return r0, x.fromReturn("DeviceGetPowerUsage", ret)
+}
+
+func (x *Device) GetProcessUtilization(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error) {
+	r0, ret := x.Device.GetProcessUtilization(lastSeenTimestamp)
+	return r0, x.fromReturn("DeviceGetProcessUtilization", ret)
+}
+
+func (x *Device) GetProcessesUtilizationInfo() (nvml.ProcessesUtilizationInfo, error) {
+	r0, ret := x.Device.GetProcessesUtilizationInfo()
+	return r0, x.fromReturn("DeviceGetProcessesUtilizationInfo", ret)
+}
+
+func (x *Device) GetRemappedRows() (int, int, bool, bool, error) {
+	r0, r1, r2, r3, ret := x.Device.GetRemappedRows()
+	return r0, r1, r2, r3, x.fromReturn("DeviceGetRemappedRows", ret)
+}
+
+func (x *Device) GetRemappedRows_v2() (nvml.RemappedRowsInfo_v2, error) {
+	r0, ret := x.Device.GetRemappedRows_v2()
+	return r0, x.fromReturn("DeviceGetRemappedRows_v2", ret)
+}
+
+func (x *Device) GetRepairStatus() (nvml.RepairStatus, error) {
+	r0, ret := x.Device.GetRepairStatus()
+	return r0, x.fromReturn("DeviceGetRepairStatus", ret)
+}
+
+func (x *Device) GetRetiredPages(cause nvml.PageRetirementCause) ([]uint64, error) {
+	r0, ret := x.Device.GetRetiredPages(cause)
+	return r0, x.fromReturn("DeviceGetRetiredPages", ret)
+}
+
+func (x *Device) GetRetiredPagesPendingStatus() (nvml.EnableState, error) {
+	r0, ret := x.Device.GetRetiredPagesPendingStatus()
+	return r0, x.fromReturn("DeviceGetRetiredPagesPendingStatus", ret)
+}
+
+func (x *Device) GetRetiredPages_v2(cause nvml.PageRetirementCause) ([]uint64, []uint64, error) {
+	r0, r1, ret := x.Device.GetRetiredPages_v2(cause)
+	return r0, r1, x.fromReturn("DeviceGetRetiredPages_v2", ret)
+}
+
+func (x *Device) GetRowRemapperHistogram() (nvml.RowRemapperHistogramValues, error) {
+	r0, ret := x.Device.GetRowRemapperHistogram()
+	return r0, x.fromReturn("DeviceGetRowRemapperHistogram", ret)
+}
+
+func (x *Device) GetRunningProcessDetailList() (nvml.ProcessDetailList, error) {
+	r0, ret := x.Device.GetRunningProcessDetailList()
+	return r0, x.fromReturn("DeviceGetRunningProcessDetailList", ret)
+}
+
+func (x *Device) GetSamples(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error) {
+	r0, r1, ret := x.Device.GetSamples(samplingType, lastSeenTimestamp)
+	return r0, r1, x.fromReturn("DeviceGetSamples", ret)
+}
+
+func (x *Device) GetSerial() (string, error) {
+	r0, ret := x.Device.GetSerial()
+	return r0, x.fromReturn("DeviceGetSerial", ret)
+}
+
+func (x *Device) GetSramEccErrorStatus() (nvml.EccSramErrorStatus, error) {
+	r0, ret := x.Device.GetSramEccErrorStatus()
+	return r0, x.fromReturn("DeviceGetSramEccErrorStatus", ret)
+}
+
+func (x *Device) GetSramUniqueUncorrectedEccErrorCounts(errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error {
+	ret := x.Device.GetSramUniqueUncorrectedEccErrorCounts(errorCounts)
+	return x.fromReturn("DeviceGetSramUniqueUncorrectedEccErrorCounts", ret)
+}
+
+func (x *Device) GetSupportedClocksEventReasons() (uint64, error) {
+	r0, ret := x.Device.GetSupportedClocksEventReasons()
+	return r0, x.fromReturn("DeviceGetSupportedClocksEventReasons", ret)
+}
+
+func (x *Device) GetSupportedClocksThrottleReasons() (uint64, error) {
+	r0, ret := x.Device.GetSupportedClocksThrottleReasons()
+	return r0, x.fromReturn("DeviceGetSupportedClocksThrottleReasons", ret)
+}
+
+func (x *Device) GetSupportedEventTypes() (uint64, error) {
+	r0, ret := x.Device.GetSupportedEventTypes()
+	return r0, x.fromReturn("DeviceGetSupportedEventTypes", ret)
+}
+
+func (x *Device) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, error) {
+	r0, r1, ret := x.Device.GetSupportedGraphicsClocks(memoryClockMHz)
+	return r0, r1, x.fromReturn("DeviceGetSupportedGraphicsClocks", ret)
+}
+
+func (x *Device) GetSupportedMemoryClocks() (int, uint32, error) {
+	r0, r1, ret := x.Device.GetSupportedMemoryClocks()
+	return r0, r1, x.fromReturn("DeviceGetSupportedMemoryClocks", ret)
+}
+
+func (x *Device) GetSupportedPerformanceStates() ([]nvml.Pstates, error) {
+	r0, ret := x.Device.GetSupportedPerformanceStates()
+	return r0, x.fromReturn("DeviceGetSupportedPerformanceStates", ret)
+}
+
+func (x *Device) GetSupportedVgpus() ([]*VgpuTypeId, error) {
+	r0, ret := x.Device.GetSupportedVgpus()
+	return newVgpuTypeIds(r0), x.fromReturn("DeviceGetSupportedVgpus", ret)
+}
+
+func (x *Device) GetTargetFanSpeed(fan int) (int, error) {
+	r0, ret := x.Device.GetTargetFanSpeed(fan)
+	return r0, x.fromReturn("DeviceGetTargetFanSpeed", ret)
+}
+
+func (x *Device) GetTemperature(sensorType nvml.TemperatureSensors) (uint32, error) {
+	r0, ret := x.Device.GetTemperature(sensorType)
+	return r0, x.fromReturn("DeviceGetTemperature", ret)
+}
+
+func (x *Device) GetTemperatureThreshold(thresholdType nvml.TemperatureThresholds) (uint32, error) {
+	r0, ret := x.Device.GetTemperatureThreshold(thresholdType)
+	return r0, x.fromReturn("DeviceGetTemperatureThreshold", ret)
+}
+
+func (x *Device) GetThermalSettings(sensorIndex uint32) (nvml.GpuThermalSettings, error) {
+	r0, ret := x.Device.GetThermalSettings(sensorIndex)
+	return r0, x.fromReturn("DeviceGetThermalSettings", ret)
+}
+
+func (x *Device) GetTopologyCommonAncestor(device2 *Device) (nvml.GpuTopologyLevel, error) {
+	r0, ret := x.Device.GetTopologyCommonAncestor(unwrapDevice(device2))
+	return r0, x.fromReturn("DeviceGetTopologyCommonAncestor", ret)
+}
+
+func (x *Device) GetTopologyNearestGpus(level nvml.GpuTopologyLevel) ([]*Device, error) {
+	r0, ret := x.Device.GetTopologyNearestGpus(level)
+	return newDevices(r0), x.fromReturn("DeviceGetTopologyNearestGpus", ret)
+}
+
+func (x *Device) GetTotalEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error) {
+	r0, ret := x.Device.GetTotalEccErrors(errorType, counterType)
+	return r0, x.fromReturn("DeviceGetTotalEccErrors", ret)
+}
+
+func (x *Device) GetTotalEnergyConsumption() (uint64, error) {
+	r0, ret := x.Device.GetTotalEnergyConsumption()
+	return r0, x.fromReturn("DeviceGetTotalEnergyConsumption", ret)
+}
+
+func (x *Device) GetUUID() (string, error) {
+	r0, ret := x.Device.GetUUID()
+	return r0, x.fromReturn("DeviceGetUUID", ret)
+}
+
+func (x *Device) GetUnrepairableMemoryFlag_v1() (nvml.UnrepairableMemoryStatus_v1, error) {
+	r0, ret := x.Device.GetUnrepairableMemoryFlag_v1()
+	return r0, x.fromReturn("DeviceGetUnrepairableMemoryFlag_v1", ret)
+}
+
+func (x *Device) GetUtilizationRates() (nvml.Utilization, error) {
+	r0, ret := x.Device.GetUtilizationRates()
+	return r0, x.fromReturn("DeviceGetUtilizationRates", ret)
+}
+
+func (x *Device) GetVbiosVersion() (string, error) {
+	r0, ret := x.Device.GetVbiosVersion()
+	return r0, x.fromReturn("DeviceGetVbiosVersion", ret)
+}
+
+func (x *Device) GetVgpuCapabilities(capability nvml.DeviceVgpuCapability) (bool, error) {
+	r0, ret := x.Device.GetVgpuCapabilities(capability)
+	return r0, x.fromReturn("DeviceGetVgpuCapabilities", ret)
+}
+
+func (x *Device) GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := x.Device.GetVgpuHeterogeneousMode()
+	return r0, x.fromReturn("DeviceGetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Device) GetVgpuInstancesUtilizationInfo() (nvml.VgpuInstancesUtilizationInfo, error) {
+	r0, ret := x.Device.GetVgpuInstancesUtilizationInfo()
+	return r0, x.fromReturn("DeviceGetVgpuInstancesUtilizationInfo", ret)
+}
+
+func (x *Device) GetVgpuMetadata() (nvml.VgpuPgpuMetadata, error) {
+	r0, ret := x.Device.GetVgpuMetadata()
+	return r0, x.fromReturn("DeviceGetVgpuMetadata", ret)
+}
+
+func (x *Device) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error) {
+	r0, ret := x.Device.GetVgpuProcessUtilization(lastSeenTimestamp)
+	return r0, x.fromReturn("DeviceGetVgpuProcessUtilization", ret)
+}
+
+func (x *Device) GetVgpuProcessesUtilizationInfo() (nvml.VgpuProcessesUtilizationInfo, error) {
+	r0, ret := x.Device.GetVgpuProcessesUtilizationInfo()
+	return r0, x.fromReturn("DeviceGetVgpuProcessesUtilizationInfo", ret)
+}
+
+func (x *Device) GetVgpuSchedulerCapabilities() (nvml.VgpuSchedulerCapabilities, error) {
+	r0, ret := x.Device.GetVgpuSchedulerCapabilities()
+	return r0, x.fromReturn("DeviceGetVgpuSchedulerCapabilities", ret)
+}
+
+func (x *Device) GetVgpuSchedulerLog() (nvml.VgpuSchedulerLog, error) {
+	r0, ret := x.Device.GetVgpuSchedulerLog()
+	return r0, x.fromReturn("DeviceGetVgpuSchedulerLog", ret)
+}
+
+func (x *Device) GetVgpuSchedulerLog_v2(logInfo nvml.VgpuSchedulerLogInfo_v2) (nvml.VgpuSchedulerLogInfo_v2, error) {
+	r0, ret := x.Device.GetVgpuSchedulerLog_v2(logInfo)
+	return r0, x.fromReturn("DeviceGetVgpuSchedulerLog_v2", ret)
+}
+
+func (x *Device) GetVgpuSchedulerState() (nvml.VgpuSchedulerGetState, error) {
+	r0, ret := x.Device.GetVgpuSchedulerState()
+	return r0, x.fromReturn("DeviceGetVgpuSchedulerState", ret)
+}
+
+func (x *Device) GetVgpuSchedulerState_v2(info nvml.VgpuSchedulerStateInfo_v2) (nvml.VgpuSchedulerStateInfo_v2, error) {
+	r0, ret := x.Device.GetVgpuSchedulerState_v2(info)
+	return r0, x.fromReturn("DeviceGetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Device) GetVgpuTypeCreatablePlacements(vgpuTypeId *VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.Device.GetVgpuTypeCreatablePlacements(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, x.fromReturn("DeviceGetVgpuTypeCreatablePlacements", ret)
+}
+
+func (x *Device) GetVgpuTypeSupportedPlacements(vgpuTypeId *VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.Device.GetVgpuTypeSupportedPlacements(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, x.fromReturn("DeviceGetVgpuTypeSupportedPlacements", ret)
+}
+
+func (x *Device) GetVgpuUtilization(lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error) {
+	r0, r1, ret := x.Device.GetVgpuUtilization(lastSeenTimestamp)
+	return r0, r1, x.fromReturn("DeviceGetVgpuUtilization", ret)
+}
+
+func (x *Device) GetViolationStatus(perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error) {
+	r0, ret := x.Device.GetViolationStatus(perfPolicyType)
+	return r0, x.fromReturn("DeviceGetViolationStatus", ret)
+}
+
+func (x *Device) GetVirtualizationMode() (nvml.GpuVirtualizationMode, error) {
+	r0, ret := x.Device.GetVirtualizationMode()
+	return r0, x.fromReturn("DeviceGetVirtualizationMode", ret)
+}
+
+func (x *Device) GpmMigSampleGet(gpuInstanceId int, gpmSample *GpmSample) error {
+	ret := x.Device.GpmMigSampleGet(gpuInstanceId, unwrapGpmSample(gpmSample))
+	return x.fromReturn("DeviceGpmMigSampleGet", ret)
+}
+
+func (x *Device) GpmQueryDeviceSupport() (nvml.GpmSupport, error) {
+	r0, ret := x.Device.GpmQueryDeviceSupport()
+	return r0, x.fromReturn("DeviceGpmQueryDeviceSupport", ret)
+}
+
+func (x *Device) GpmQueryIfStreamingEnabled() (uint32, error) {
+	r0, ret := x.Device.GpmQueryIfStreamingEnabled()
+	return r0, x.fromReturn("DeviceGpmQueryIfStreamingEnabled", ret)
+}
+
+func (x *Device) GpmSampleGet(gpmSample *GpmSample) error {
+	ret := x.Device.GpmSampleGet(unwrapGpmSample(gpmSample))
+	return x.fromReturn("DeviceGpmSampleGet", ret)
+}
+
+func (x *Device) GpmSetStreamingEnabled(state uint32) error {
+	ret := x.Device.GpmSetStreamingEnabled(state)
+	return x.fromReturn("DeviceGpmSetStreamingEnabled", ret)
+}
+
+func (x *Device) IsMigDeviceHandle() (bool, error) {
+	r0, ret := x.Device.IsMigDeviceHandle()
+	return r0, x.fromReturn("DeviceIsMigDeviceHandle", ret)
+}
+
+func (x *Device) OnSameBoard(device2 *Device) (int, error) {
+	r0, ret := x.Device.OnSameBoard(unwrapDevice(device2))
+	return r0, x.fromReturn("DeviceOnSameBoard", ret)
+}
+
+func (x *Device) PowerSmoothingActivatePresetProfile(profile *nvml.PowerSmoothingProfile) error {
+	ret := x.Device.PowerSmoothingActivatePresetProfile(profile)
+	return x.fromReturn("DevicePowerSmoothingActivatePresetProfile", ret)
+}
+
+func (x *Device) PowerSmoothingSetState(state *nvml.PowerSmoothingState) error {
+	ret := x.Device.PowerSmoothingSetState(state)
+	return x.fromReturn("DevicePowerSmoothingSetState", ret)
+}
+
+func (x *Device) PowerSmoothingUpdatePresetProfileParam(profile *nvml.PowerSmoothingProfile) error {
+	ret := x.Device.PowerSmoothingUpdatePresetProfileParam(profile)
+	return x.fromReturn("DevicePowerSmoothingUpdatePresetProfileParam", ret)
+}
+
+func (x *Device) ReadPRMCounters_v1(prmCounters []nvml.PRMCounterId, localPort int) ([]nvml.PRMCounter_v1, error) {
+	r0, ret := x.Device.ReadPRMCounters_v1(prmCounters, localPort)
+	return r0, x.fromReturn("DeviceReadPRMCounters_v1", ret)
+}
+
+func (x *Device) ReadWritePRM_v1(buffer *nvml.PRMTLV_v1) error {
+	ret := x.Device.ReadWritePRM_v1(buffer)
+	return x.fromReturn("DeviceReadWritePRM_v1", ret)
+}
+
+func (x *Device) RegisterEvents(eventTypes uint64, set *EventSet) error {
+	ret := x.Device.RegisterEvents(eventTypes, unwrapEventSet(set))
+	return x.fromReturn("DeviceRegisterEvents", ret)
+}
+
+func (x *Device) ResetApplicationsClocks() error {
+	ret := x.Device.ResetApplicationsClocks()
+	return x.fromReturn("DeviceResetApplicationsClocks", ret)
+}
+
+func (x *Device) ResetGpuLockedClocks() error {
+	ret := x.Device.ResetGpuLockedClocks()
+	return x.fromReturn("DeviceResetGpuLockedClocks", ret)
+}
+
+func (x *Device) ResetMemoryLockedClocks() error {
+	ret := x.Device.ResetMemoryLockedClocks()
+	return x.fromReturn("DeviceResetMemoryLockedClocks", ret)
+}
+
+func (x *Device) ResetNvLinkErrorCounters(link int) error {
+	ret := x.Device.ResetNvLinkErrorCounters(link)
+	return x.fromReturn("DeviceResetNvLinkErrorCounters", ret)
+}
+
+func (x *Device) ResetNvLinkUtilizationCounter(link int, counter int) error {
+	ret := x.Device.ResetNvLinkUtilizationCounter(link, counter)
+	return x.fromReturn("DeviceResetNvLinkUtilizationCounter", ret)
+}
+
+func (x *Device) SetAPIRestriction(apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error {
+	ret := x.Device.SetAPIRestriction(apiType, isRestricted)
+	return x.fromReturn("DeviceSetAPIRestriction", ret)
+}
+
+func (x *Device) SetAccountingMode(mode nvml.EnableState) error {
+	ret := x.Device.SetAccountingMode(mode)
+	return x.fromReturn("DeviceSetAccountingMode", ret)
+}
+
+func (x *Device) SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) error {
+	ret := x.Device.SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+	return x.fromReturn("DeviceSetApplicationsClocks", ret)
+}
+
+func (x *Device) SetAutoBoostedClocksEnabled(enabled nvml.EnableState) error {
+	ret := x.Device.SetAutoBoostedClocksEnabled(enabled)
+	return x.fromReturn("DeviceSetAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Device) SetClockOffsets(info nvml.ClockOffset) error {
+	ret := x.Device.SetClockOffsets(info)
+	return x.fromReturn("DeviceSetClockOffsets", ret)
+}
+
+func (x *Device) SetComputeMode(mode nvml.ComputeMode) error {
+	ret := x.Device.SetComputeMode(mode)
+	return x.fromReturn("DeviceSetComputeMode", ret)
+}
+
+func (x *Device) SetConfComputeUnprotectedMemSize(sizeKiB uint64) error {
+	ret := x.Device.SetConfComputeUnprotectedMemSize(sizeKiB)
+	return x.fromReturn("DeviceSetConfComputeUnprotectedMemSize", ret)
+}
+
+func (x *Device) SetCpuAffinity() error {
+	ret := x.Device.SetCpuAffinity()
+	return x.fromReturn("DeviceSetCpuAffinity", ret)
+}
+
+func (x *Device) SetDefaultAutoBoostedClocksEnabled(enabled nvml.EnableState, flags uint32) error {
+	ret := x.Device.SetDefaultAutoBoostedClocksEnabled(enabled, flags)
+	return x.fromReturn("DeviceSetDefaultAutoBoostedClocksEnabled", ret)
+}
+
+func (x *Device) SetDefaultFanSpeed_v2(fan int) error {
+	ret := x.Device.SetDefaultFanSpeed_v2(fan)
+	return x.fromReturn("DeviceSetDefaultFanSpeed_v2", ret)
+}
+
+func (x *Device) SetDramEncryptionMode(dramEncryption *nvml.DramEncryptionInfo) error {
+	ret := x.Device.SetDramEncryptionMode(dramEncryption)
+	return x.fromReturn("DeviceSetDramEncryptionMode", ret)
+}
+
+func (x *Device) SetDriverModel(driverModel nvml.DriverModel, flags uint32) error {
+	ret := x.Device.SetDriverModel(driverModel, flags)
+	return x.fromReturn("DeviceSetDriverModel", ret)
+}
+
+func (x *Device) SetEccMode(ecc nvml.EnableState) error {
+	ret := x.Device.SetEccMode(ecc)
+	return x.fromReturn("DeviceSetEccMode", ret)
+}
+
+func (x *Device) SetFanControlPolicy(fan int, policy nvml.FanControlPolicy) error {
+	ret := x.Device.SetFanControlPolicy(fan, policy)
+	return x.fromReturn("DeviceSetFanControlPolicy", ret)
+}
+
+func (x *Device) SetFanSpeed_v2(fan int, speed int) error {
+	ret := x.Device.SetFanSpeed_v2(fan, speed)
+	return x.fromReturn("DeviceSetFanSpeed_v2", ret)
+}
+
+func (x *Device) SetGpcClkVfOffset(offset int) error {
+	ret := x.Device.SetGpcClkVfOffset(offset)
+	return x.fromReturn("DeviceSetGpcClkVfOffset", ret)
+}
+
+func (x *Device) SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) error {
+	ret := x.Device.SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
+	return x.fromReturn("DeviceSetGpuLockedClocks", ret)
+}
+
+func (x *Device) SetGpuOperationMode(mode nvml.GpuOperationMode) error {
+	ret := x.Device.SetGpuOperationMode(mode)
+	return x.fromReturn("DeviceSetGpuOperationMode", ret)
+}
+
+func (x *Device) SetHostname_v1(hostName string) error {
+	ret := x.Device.SetHostname_v1(hostName)
+	return x.fromReturn("DeviceSetHostname_v1", ret)
+}
+
+func (x *Device) SetMemClkVfOffset(offset int) error {
+	ret := x.Device.SetMemClkVfOffset(offset)
+	return x.fromReturn("DeviceSetMemClkVfOffset", ret)
+}
+
+func (x *Device) SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) error {
+	ret := x.Device.SetMemoryLockedClocks(minMemClockMHz, maxMemClockMHz)
+	return x.fromReturn("DeviceSetMemoryLockedClocks", ret)
+}
+
+func (x *Device) SetMigMode(mode int) (nvml.Return, error) {
+	r0, ret := x.Device.SetMigMode(mode)
+	return r0, x.fromReturn("DeviceSetMigMode", ret)
+}
+
+func (x *Device) SetNvLinkDeviceLowPowerThreshold(info *nvml.NvLinkPowerThres) error {
+	ret := x.Device.SetNvLinkDeviceLowPowerThreshold(info)
+	return x.fromReturn("DeviceSetNvLinkDeviceLowPowerThreshold", ret)
+}
+
+func (x *Device) SetNvLinkUtilizationControl(link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error {
+	ret := x.Device.SetNvLinkUtilizationControl(link, counter, control, reset)
+	return x.fromReturn("DeviceSetNvLinkUtilizationControl", ret)
+}
+
+func (x *Device) SetNvlinkBwMode(setBwMode *nvml.NvlinkSetBwMode) error {
+	ret := x.Device.SetNvlinkBwMode(setBwMode)
+	return x.fromReturn("DeviceSetNvlinkBwMode", ret)
+}
+
+func (x *Device) SetPersistenceMode(mode nvml.EnableState) error {
+	ret := x.Device.SetPersistenceMode(mode)
+	return x.fromReturn("DeviceSetPersistenceMode", ret)
+}
+
+func (x *Device) SetPowerManagementLimit(limit uint32) error {
+	ret := x.Device.SetPowerManagementLimit(limit)
+	return x.fromReturn("DeviceSetPowerManagementLimit", ret)
+}
+
+func (x *Device) SetPowerManagementLimit_v2(powerValue *nvml.PowerValue_v2) error {
+	ret := x.Device.SetPowerManagementLimit_v2(powerValue)
+	return x.fromReturn("DeviceSetPowerManagementLimit_v2", ret)
+}
+
+func (x *Device) SetRusdSettings_v1(settings nvml.RusdSettings_v1) error {
+	ret := x.Device.SetRusdSettings_v1(settings)
+	return x.fromReturn("DeviceSetRusdSettings_v1", ret)
+}
+
+func (x *Device) SetTemperatureThreshold(thresholdType nvml.TemperatureThresholds, temp int) error {
+	ret := x.Device.SetTemperatureThreshold(thresholdType, temp)
+	return x.fromReturn("DeviceSetTemperatureThreshold", ret)
+}
+
+func (x *Device) SetVgpuCapabilities(capability nvml.DeviceVgpuCapability, state nvml.EnableState) error {
+	ret := x.Device.SetVgpuCapabilities(capability, state)
+	return x.fromReturn("DeviceSetVgpuCapabilities", ret)
+}
+
+func (x *Device) SetVgpuHeterogeneousMode(heterogeneousMode nvml.VgpuHeterogeneousMode) error {
+	ret := x.Device.SetVgpuHeterogeneousMode(heterogeneousMode)
+	return x.fromReturn("DeviceSetVgpuHeterogeneousMode", ret)
+}
+
+func (x *Device) SetVgpuSchedulerState(pSchedulerState *nvml.VgpuSchedulerSetState) error {
+	ret := x.Device.SetVgpuSchedulerState(pSchedulerState)
+	return x.fromReturn("DeviceSetVgpuSchedulerState", ret)
+}
+
+func (x *Device) SetVgpuSchedulerState_v2(schedulerState *nvml.VgpuSchedulerState_v2) error {
+	ret := x.Device.SetVgpuSchedulerState_v2(schedulerState)
+	return x.fromReturn("DeviceSetVgpuSchedulerState_v2", ret)
+}
+
+func (x *Device) SetVirtualizationMode(virtualMode nvml.GpuVirtualizationMode) error {
+	ret := x.Device.SetVirtualizationMode(virtualMode)
+	return x.fromReturn("DeviceSetVirtualizationMode", ret)
+}
+
+func (x *Device) ValidateInforom() error {
+	ret := x.Device.ValidateInforom()
+	return x.fromReturn("DeviceValidateInforom", ret)
+}
+
+func (x *Device) VgpuForceGspUnload() error {
+	ret := x.Device.VgpuForceGspUnload()
+	return x.fromReturn("DeviceVgpuForceGspUnload", ret)
+}
+
+func (x *Device) VgpuTypeGetMaxInstances(vgpuTypeId *VgpuTypeId) (int, error) {
+	r0, ret := x.Device.VgpuTypeGetMaxInstances(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, x.fromReturn("DeviceVgpuTypeGetMaxInstances", ret)
+}
+
+func (x *Device) WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := x.Device.WorkloadPowerProfileClearRequestedProfiles(requestedProfiles)
+	return x.fromReturn("DeviceWorkloadPowerProfileClearRequestedProfiles", ret)
+}
+
+func (x *Device) WorkloadPowerProfileGetCurrentProfiles() (nvml.WorkloadPowerProfileCurrentProfiles, error) {
+	r0, ret := x.Device.WorkloadPowerProfileGetCurrentProfiles()
+	return r0, x.fromReturn("DeviceWorkloadPowerProfileGetCurrentProfiles", ret)
+}
+
+func (x *Device) WorkloadPowerProfileGetProfilesInfo() (nvml.WorkloadPowerProfileProfilesInfo, error) {
+	r0, ret := x.Device.WorkloadPowerProfileGetProfilesInfo()
+	return r0, x.fromReturn("DeviceWorkloadPowerProfileGetProfilesInfo", ret)
+}
+
+func (x *Device) WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := x.Device.WorkloadPowerProfileSetRequestedProfiles(requestedProfiles)
+	return x.fromReturn("DeviceWorkloadPowerProfileSetRequestedProfiles", ret)
+}
+
+func (x *Device) WorkloadPowerProfileUpdateProfiles_v1(operation nvml.PowerProfileOperation, profileTypes []nvml.PowerProfileType) error {
+	ret := x.Device.WorkloadPowerProfileUpdateProfiles_v1(operation, profileTypes)
+	return x.fromReturn("DeviceWorkloadPowerProfileUpdateProfiles_v1", ret)
+}
+
+// GpuInstance calls the methods of an nvml.GpuInstance, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.GpuInstance directly.
+type GpuInstance struct {
+	nvml.GpuInstance
+}
+
+// NewGpuInstance returns a GpuInstance that calls the methods of v.
+func NewGpuInstance(v nvml.GpuInstance) *GpuInstance {
+	if v == nil {
+		return nil
+	}
+	return &GpuInstance{GpuInstance: v}
+}
+
+// unwrapGpuInstance returns the nvml.GpuInstance called by v.
+func unwrapGpuInstance(v *GpuInstance) nvml.GpuInstance {
+	if v == nil {
+		return nil
+	}
+	return v.GpuInstance
+}
+
+// newGpuInstances wraps each nvml.GpuInstance of v.
+func newGpuInstances(v []nvml.GpuInstance) []*GpuInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]*GpuInstance, len(v))
+	for i := range v {
+		wrapped[i] = NewGpuInstance(v[i])
+	}
+	return wrapped
+}
+
+func (x *GpuInstance) CreateComputeInstance(info *nvml.ComputeInstanceProfileInfo) (*ComputeInstance, error) {
+	r0, ret := x.GpuInstance.CreateComputeInstance(info)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceCreateComputeInstance", ret)
+}
+
+func (x *GpuInstance) CreateComputeInstanceWithPlacement(info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (*ComputeInstance, error) {
+	r0, ret := x.GpuInstance.CreateComputeInstanceWithPlacement(info, placement)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceCreateComputeInstanceWithPlacement", ret)
+}
+
+func (x *GpuInstance) Destroy() error {
+	ret := x.GpuInstance.Destroy()
+	return FromReturn("GpuInstanceDestroy", ret)
+}
+
+func (x *GpuInstance) GetActiveVgpus() (nvml.ActiveVgpuInstanceInfo, error) {
+	r0, ret := x.GpuInstance.GetActiveVgpus()
+	return r0, FromReturn("GpuInstanceGetActiveVgpus", ret)
+}
+
+func (x *GpuInstance) GetComputeInstanceById(id int) (*ComputeInstance, error) {
+	r0, ret := x.GpuInstance.GetComputeInstanceById(id)
+	return NewComputeInstance(r0), FromReturn("GpuInstanceGetComputeInstanceById", ret)
+}
+
+func (x *GpuInstance) GetComputeInstancePossiblePlacements(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error) {
+	r0, ret := x.GpuInstance.GetComputeInstancePossiblePlacements(info)
+	return r0, FromReturn("GpuInstanceGetComputeInstancePossiblePlacements", ret)
+}
+
+func (x *GpuInstance) GetComputeInstanceProfileInfo(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error) {
+	r0, ret := x.GpuInstance.GetComputeInstanceProfileInfo(profile, engProfile)
+	return r0, FromReturn("GpuInstanceGetComputeInstanceProfileInfo", ret)
+}
+
+func (x *GpuInstance) GetComputeInstanceRemainingCapacity(info *nvml.ComputeInstanceProfileInfo) (int, error) {
+	r0, ret := x.GpuInstance.GetComputeInstanceRemainingCapacity(info)
+	return r0, FromReturn("GpuInstanceGetComputeInstanceRemainingCapacity", ret)
+}
+
+func (x *GpuInstance) GetComputeInstances(info *nvml.ComputeInstanceProfileInfo) ([]*ComputeInstance, error) {
+	r0, ret := x.GpuInstance.GetComputeInstances(info)
+	return newComputeInstances(r0), FromReturn("GpuInstanceGetComputeInstances", ret)
+}
+
+func (x *GpuInstance) GetCreatableVgpus() (nvml.VgpuTypeIdInfo, error) {
+	r0, ret := x.GpuInstance.GetCreatableVgpus()
+	return r0, FromReturn("GpuInstanceGetCreatableVgpus", ret)
+}
+
+func (x *GpuInstance) GetInfo() (nvml.GpuInstanceInfo, error) {
+	r0, ret := x.GpuInstance.GetInfo()
+	return r0, FromReturn("GpuInstanceGetInfo", ret)
+}
+
+func (x *GpuInstance) GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := x.GpuInstance.GetVgpuHeterogeneousMode()
+	return r0, FromReturn("GpuInstanceGetVgpuHeterogeneousMode", ret)
+}
+
+func (x *GpuInstance) GetVgpuSchedulerLog() (nvml.VgpuSchedulerLogInfo, error) {
+	r0, ret := x.GpuInstance.GetVgpuSchedulerLog()
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerLog", ret)
+}
+
+func (x *GpuInstance) GetVgpuSchedulerLog_v2(logInfo nvml.VgpuSchedulerLogInfo_v2) (nvml.VgpuSchedulerLogInfo_v2, error) {
+	r0, ret := x.GpuInstance.GetVgpuSchedulerLog_v2(logInfo)
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerLog_v2", ret)
+}
+
+func (x *GpuInstance) GetVgpuSchedulerState() (nvml.VgpuSchedulerStateInfo, error) {
+	r0, ret := x.GpuInstance.GetVgpuSchedulerState()
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerState", ret)
+}
+
+func (x *GpuInstance) GetVgpuSchedulerState_v2(info nvml.VgpuSchedulerStateInfo_v2) (nvml.VgpuSchedulerStateInfo_v2, error) {
+	r0, ret := x.GpuInstance.GetVgpuSchedulerState_v2(info)
+	return r0, FromReturn("GpuInstanceGetVgpuSchedulerState_v2", ret)
+}
+
+func (x *GpuInstance) GetVgpuTypeCreatablePlacements() (nvml.VgpuCreatablePlacementInfo, error) {
+	r0, ret := x.GpuInstance.GetVgpuTypeCreatablePlacements()
+	return r0, FromReturn("GpuInstanceGetVgpuTypeCreatablePlacements", ret)
+}
+
+func (x *GpuInstance) SetVgpuHeterogeneousMode(heterogeneousMode *nvml.VgpuHeterogeneousMode) error {
+	ret := x.GpuInstance.SetVgpuHeterogeneousMode(heterogeneousMode)
+	return FromReturn("GpuInstanceSetVgpuHeterogeneousMode", ret)
+}
+
+func (x *GpuInstance) SetVgpuSchedulerState(scheduler *nvml.VgpuSchedulerState) error {
+	ret := x.GpuInstance.SetVgpuSchedulerState(scheduler)
+	return FromReturn("GpuInstanceSetVgpuSchedulerState", ret)
+}
+
+func (x *GpuInstance) SetVgpuSchedulerState_v2(schedulerState *nvml.VgpuSchedulerState_v2) error {
+	ret := x.GpuInstance.SetVgpuSchedulerState_v2(schedulerState)
+	return FromReturn("GpuInstanceSetVgpuSchedulerState_v2", ret)
+}
+
+// ComputeInstance calls the methods of an nvml.ComputeInstance, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.ComputeInstance directly.
+type ComputeInstance struct {
+	nvml.ComputeInstance
+}
+
+// NewComputeInstance returns a ComputeInstance that calls the methods of v.
+func NewComputeInstance(v nvml.ComputeInstance) *ComputeInstance {
+	if v == nil {
+		return nil
+	}
+	return &ComputeInstance{ComputeInstance: v}
+}
+
+// unwrapComputeInstance returns the nvml.ComputeInstance called by v.
+func unwrapComputeInstance(v *ComputeInstance) nvml.ComputeInstance {
+	if v == nil {
+		return nil
+	}
+	return v.ComputeInstance
+}
+
+// newComputeInstances wraps each nvml.ComputeInstance of v.
+func newComputeInstances(v []nvml.ComputeInstance) []*ComputeInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]*ComputeInstance, len(v))
+	for i := range v {
+		wrapped[i] = NewComputeInstance(v[i])
+	}
+	return wrapped
+}
+
+func (x *ComputeInstance) Destroy() error {
+	ret := x.ComputeInstance.Destroy()
+	return FromReturn("ComputeInstanceDestroy", ret)
+}
+
+func (x *ComputeInstance) GetInfo() (nvml.ComputeInstanceInfo, error) {
+	r0, ret := x.ComputeInstance.GetInfo()
+	return r0, FromReturn("ComputeInstanceGetInfo", ret)
+}
+
+// EventSet calls the methods of an nvml.EventSet, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.EventSet directly.
+type EventSet struct {
+	nvml.EventSet
+}
+
+// NewEventSet returns a EventSet that calls the methods of v.
+func NewEventSet(v nvml.EventSet) *EventSet {
+	if v == nil {
+		return nil
+	}
+	return &EventSet{EventSet: v}
+}
+
+// unwrapEventSet returns the nvml.EventSet called by v.
+func unwrapEventSet(v *EventSet) nvml.EventSet {
+	if v == nil {
+		return nil
+	}
+	return v.EventSet
+}
+
+func (x *EventSet) Free() error {
+	ret := x.EventSet.Free()
+	return FromReturn("EventSetFree", ret)
+}
+
+func (x *EventSet) Wait(timeoutms uint32) (nvml.EventData, error) {
+	r0, ret := x.EventSet.Wait(timeoutms)
+	return r0, FromReturn("EventSetWait", ret)
+}
+
+// GpmSample calls the methods of an nvml.GpmSample, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.GpmSample directly.
+type GpmSample struct {
+	nvml.GpmSample
+}
+
+// NewGpmSample returns a GpmSample that calls the methods of v.
+func NewGpmSample(v nvml.GpmSample) *GpmSample {
+	if v == nil {
+		return nil
+	}
+	return &GpmSample{GpmSample: v}
+}
+
+// unwrapGpmSample returns the nvml.GpmSample called by v.
+func unwrapGpmSample(v *GpmSample) nvml.GpmSample {
+	if v == nil {
+		return nil
+	}
+	return v.GpmSample
+}
+
+func (x *GpmSample) Free() error {
+	ret := x.GpmSample.Free()
+	return FromReturn("GpmSampleFree", ret)
+}
+
+func (x *GpmSample) Get(device *Device) error {
+	ret := x.GpmSample.Get(unwrapDevice(device))
+	return device.fromReturn("GpmSampleGet", ret)
+}
+
+func (x *GpmSample) MigGet(device *Device, gpuInstanceId int) error {
+	ret := x.GpmSample.MigGet(unwrapDevice(device), gpuInstanceId)
+	return device.fromReturn("GpmSampleMigGet", ret)
+}
+
+// Unit calls the methods of an nvml.Unit, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.Unit directly.
+type Unit struct {
+	nvml.Unit
+}
+
+// NewUnit returns a Unit that calls the methods of v.
+func NewUnit(v nvml.Unit) *Unit {
+	if v == nil {
+		return nil
+	}
+	return &Unit{Unit: v}
+}
+
+// unwrapUnit returns the nvml.Unit called by v.
+func unwrapUnit(v *Unit) nvml.Unit {
+	if v == nil {
+		return nil
+	}
+	return v.Unit
+}
+
+func (x *Unit) GetDevices() ([]*Device, error) {
+	r0, ret := x.Unit.GetDevices()
+	return newDevices(r0), FromReturn("UnitGetDevices", ret)
+}
+
+func (x *Unit) GetFanSpeedInfo() (nvml.UnitFanSpeeds, error) {
+	r0, ret := x.Unit.GetFanSpeedInfo()
+	return r0, FromReturn("UnitGetFanSpeedInfo", ret)
+}
+
+func (x *Unit) GetLedState() (nvml.LedState, error) {
+	r0, ret := x.Unit.GetLedState()
+	return r0, FromReturn("UnitGetLedState", ret)
+}
+
+func (x *Unit) GetPsuInfo() (nvml.PSUInfo, error) {
+	r0, ret := x.Unit.GetPsuInfo()
+	return r0, FromReturn("UnitGetPsuInfo", ret)
+}
+
+func (x *Unit) GetTemperature(ttype int) (uint32, error) {
+	r0, ret := x.Unit.GetTemperature(ttype)
+	return r0, FromReturn("UnitGetTemperature", ret)
+}
+
+func (x *Unit) GetUnitInfo() (nvml.UnitInfo, error) {
+	r0, ret := x.Unit.GetUnitInfo()
+	return r0, FromReturn("UnitGetUnitInfo", ret)
+}
+
+func (x *Unit) SetLedState(color nvml.LedColor) error {
+	ret := x.Unit.SetLedState(color)
+	return FromReturn("UnitSetLedState", ret)
+}
+
+// VgpuInstance calls the methods of an nvml.VgpuInstance, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.VgpuInstance directly.
+type VgpuInstance struct {
+	nvml.VgpuInstance
+}
+
+// NewVgpuInstance returns a VgpuInstance that calls the methods of v.
+func NewVgpuInstance(v nvml.VgpuInstance) *VgpuInstance {
+	if v == nil {
+		return nil
+	}
+	return &VgpuInstance{VgpuInstance: v}
+}
+
+// unwrapVgpuInstance returns the nvml.VgpuInstance called by v.
+func unwrapVgpuInstance(v *VgpuInstance) nvml.VgpuInstance {
+	if v == nil {
+		return nil
+	}
+	return v.VgpuInstance
+}
+
+// newVgpuInstances wraps each nvml.VgpuInstance of v.
+func newVgpuInstances(v []nvml.VgpuInstance) []*VgpuInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]*VgpuInstance, len(v))
+	for i := range v {
+		wrapped[i] = NewVgpuInstance(v[i])
+	}
+	return wrapped
+}
+
+func (x *VgpuInstance) ClearAccountingPids() error {
+	ret := x.VgpuInstance.ClearAccountingPids()
+	return FromReturn("VgpuInstanceClearAccountingPids", ret)
+}
+
+func (x *VgpuInstance) GetAccountingMode() (nvml.EnableState, error) {
+	r0, ret := x.VgpuInstance.GetAccountingMode()
+	return r0, FromReturn("VgpuInstanceGetAccountingMode", ret)
+}
+
+func (x *VgpuInstance) GetAccountingPids() ([]int, error) {
+	r0, ret := x.VgpuInstance.GetAccountingPids()
+	return r0, FromReturn("VgpuInstanceGetAccountingPids", ret)
+}
+
+func (x *VgpuInstance) GetAccountingStats(pid int) (nvml.AccountingStats, error) {
+	r0, ret := x.VgpuInstance.GetAccountingStats(pid)
+	return r0, FromReturn("VgpuInstanceGetAccountingStats", ret)
+}
+
+func (x *VgpuInstance) GetEccMode() (nvml.EnableState, error) {
+	r0, ret := x.VgpuInstance.GetEccMode()
+	return r0, FromReturn("VgpuInstanceGetEccMode", ret)
+}
+
+func (x *VgpuInstance) GetEncoderCapacity() (int, error) {
+	r0, ret := x.VgpuInstance.GetEncoderCapacity()
+	return r0, FromReturn("VgpuInstanceGetEncoderCapacity", ret)
+}
+
+func (x *VgpuInstance) GetEncoderSessions() (int, nvml.EncoderSessionInfo, error) {
+	r0, r1, ret := x.VgpuInstance.GetEncoderSessions()
+	return r0, r1, FromReturn("VgpuInstanceGetEncoderSessions", ret)
+}
+
+func (x *VgpuInstance) GetEncoderStats() (int, uint32, uint32, error) {
+	r0, r1, r2, ret := x.VgpuInstance.GetEncoderStats()
+	return r0, r1, r2, FromReturn("VgpuInstanceGetEncoderStats", ret)
+}
+
+func (x *VgpuInstance) GetFBCSessions() (int, nvml.FBCSessionInfo, error) {
+	r0, r1, ret := x.VgpuInstance.GetFBCSessions()
+	return r0, r1, FromReturn("VgpuInstanceGetFBCSessions", ret)
+}
+
+func (x *VgpuInstance) GetFBCStats() (nvml.FBCStats, error) {
+	r0, ret := x.VgpuInstance.GetFBCStats()
+	return r0, FromReturn("VgpuInstanceGetFBCStats", ret)
+}
+
+func (x *VgpuInstance) GetFbUsage() (uint64, error) {
+	r0, ret := x.VgpuInstance.GetFbUsage()
+	return r0, FromReturn("VgpuInstanceGetFbUsage", ret)
+}
+
+func (x *VgpuInstance) GetFrameRateLimit() (uint32, error) {
+	r0, ret := x.VgpuInstance.GetFrameRateLimit()
+	return r0, FromReturn("VgpuInstanceGetFrameRateLimit", ret)
+}
+
+func (x *VgpuInstance) GetGpuInstanceId() (int, error) {
+	r0, ret := x.VgpuInstance.GetGpuInstanceId()
+	return r0, FromReturn("VgpuInstanceGetGpuInstanceId", ret)
+}
+
+func (x *VgpuInstance) GetGpuPciId() (string, error) {
+	r0, ret := x.VgpuInstance.GetGpuPciId()
+	return r0, FromReturn("VgpuInstanceGetGpuPciId", ret)
+}
+
+func (x *VgpuInstance) GetLicenseInfo() (nvml.VgpuLicenseInfo, error) {
+	r0, ret := x.VgpuInstance.GetLicenseInfo()
+	return r0, FromReturn("VgpuInstanceGetLicenseInfo", ret)
+}
+
+func (x *VgpuInstance) GetLicenseStatus() (int, error) {
+	r0, ret := x.VgpuInstance.GetLicenseStatus()
+	return r0, FromReturn("VgpuInstanceGetLicenseStatus", ret)
+}
+
+func (x *VgpuInstance) GetMdevUUID() (string, error) {
+	r0, ret := x.VgpuInstance.GetMdevUUID()
+	return r0, FromReturn("VgpuInstanceGetMdevUUID", ret)
+}
+
+func (x *VgpuInstance) GetMetadata() (nvml.VgpuMetadata, error) {
+	r0, ret := x.VgpuInstance.GetMetadata()
+	return r0, FromReturn("VgpuInstanceGetMetadata", ret)
+}
+
+func (x *VgpuInstance) GetRuntimeStateSize() (nvml.VgpuRuntimeState, error) {
+	r0, ret := x.VgpuInstance.GetRuntimeStateSize()
+	return r0, FromReturn("VgpuInstanceGetRuntimeStateSize", ret)
+}
+
+func (x *VgpuInstance) GetType() (*VgpuTypeId, error) {
+	r0, ret := x.VgpuInstance.GetType()
+	return NewVgpuTypeId(r0), FromReturn("VgpuInstanceGetType", ret)
+}
+
+func (x *VgpuInstance) GetUUID() (string, error) {
+	r0, ret := x.VgpuInstance.GetUUID()
+	return r0, FromReturn("VgpuInstanceGetUUID", ret)
+}
+
+func (x *VgpuInstance) GetVmDriverVersion() (string, error) {
+	r0, ret := x.VgpuInstance.GetVmDriverVersion()
+	return r0, FromReturn("VgpuInstanceGetVmDriverVersion", ret)
+}
+
+func (x *VgpuInstance) GetVmID() (string, nvml.VgpuVmIdType, error) {
+	r0, r1, ret := x.VgpuInstance.GetVmID()
+	return r0, r1, FromReturn("VgpuInstanceGetVmID", ret)
+}
+
+func (x *VgpuInstance) SetEncoderCapacity(encoderCapacity int) error {
+	ret := x.VgpuInstance.SetEncoderCapacity(encoderCapacity)
+	return FromReturn("VgpuInstanceSetEncoderCapacity", ret)
+}
+
+// VgpuTypeId calls the methods of an nvml.VgpuTypeId, returning an error for every
+// Return other than SUCCESS. Methods that do not return a Return are called
+// on the embedded nvml.VgpuTypeId directly.
+type VgpuTypeId struct {
+	nvml.VgpuTypeId
+}
+
+// NewVgpuTypeId returns a VgpuTypeId that calls the methods of v.
+func NewVgpuTypeId(v nvml.VgpuTypeId) *VgpuTypeId {
+	if v == nil {
+		return nil
+	}
+	return &VgpuTypeId{VgpuTypeId: v}
+}
+
+// unwrapVgpuTypeId returns the nvml.VgpuTypeId called by v.
+func unwrapVgpuTypeId(v *VgpuTypeId) nvml.VgpuTypeId {
+	if v == nil {
+		return nil
+	}
+	return v.VgpuTypeId
+}
+
+// newVgpuTypeIds wraps each nvml.VgpuTypeId of v.
+func newVgpuTypeIds(v []nvml.VgpuTypeId) []*VgpuTypeId {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]*VgpuTypeId, len(v))
+	for i := range v {
+		wrapped[i] = NewVgpuTypeId(v[i])
+	}
+	return wrapped
+}
+
+func (x *VgpuTypeId) GetBAR1Info() (nvml.VgpuTypeBar1Info, error) {
+	r0, ret := x.VgpuTypeId.GetBAR1Info()
+	return r0, FromReturn("VgpuTypeGetBAR1Info", ret)
+}
+
+func (x *VgpuTypeId) GetCapabilities(capability nvml.VgpuCapability) (bool, error) {
+	r0, ret := x.VgpuTypeId.GetCapabilities(capability)
+	return r0, FromReturn("VgpuTypeGetCapabilities", ret)
+}
+
+func (x *VgpuTypeId) GetClass() (string, error) {
+	r0, ret := x.VgpuTypeId.GetClass()
+	return r0, FromReturn("VgpuTypeGetClass", ret)
+}
+
+func (x *VgpuTypeId) GetCreatablePlacements(device *Device) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.VgpuTypeId.GetCreatablePlacements(unwrapDevice(device))
+	return r0, device.fromReturn("VgpuTypeGetCreatablePlacements", ret)
+}
+
+func (x *VgpuTypeId) GetDeviceID() (uint64, uint64, error) {
+	r0, r1, ret := x.VgpuTypeId.GetDeviceID()
+	return r0, r1, FromReturn("VgpuTypeGetDeviceID", ret)
+}
+
+func (x *VgpuTypeId) GetFrameRateLimit() (uint32, error) {
+	r0, ret := x.VgpuTypeId.GetFrameRateLimit()
+	return r0, FromReturn("VgpuTypeGetFrameRateLimit", ret)
+}
+
+func (x *VgpuTypeId) GetFramebufferSize() (uint64, error) {
+	r0, ret := x.VgpuTypeId.GetFramebufferSize()
+	return r0, FromReturn("VgpuTypeGetFramebufferSize", ret)
+}
+
+func (x *VgpuTypeId) GetGpuInstanceProfileId() (uint32, error) {
+	r0, ret := x.VgpuTypeId.GetGpuInstanceProfileId()
+	return r0, FromReturn("VgpuTypeGetGpuInstanceProfileId", ret)
+}
+
+func (x *VgpuTypeId) GetLicense() (string, error) {
+	r0, ret := x.VgpuTypeId.GetLicense()
+	return r0, FromReturn("VgpuTypeGetLicense", ret)
+}
+
+func (x *VgpuTypeId) GetMaxInstances(device *Device) (int, error) {
+	r0, ret := x.VgpuTypeId.GetMaxInstances(unwrapDevice(device))
+	return r0, device.fromReturn("VgpuTypeGetMaxInstances", ret)
+}
+
+func (x *VgpuTypeId) GetMaxInstancesPerVm() (int, error) {
+	r0, ret := x.VgpuTypeId.GetMaxInstancesPerVm()
+	return r0, FromReturn("VgpuTypeGetMaxInstancesPerVm", ret)
+}
+
+func (x *VgpuTypeId) GetName() (string, error) {
+	r0, ret := x.VgpuTypeId.GetName()
+	return r0, FromReturn("VgpuTypeGetName", ret)
+}
+
+func (x *VgpuTypeId) GetNumDisplayHeads() (int, error) {
+	r0, ret := x.VgpuTypeId.GetNumDisplayHeads()
+	return r0, FromReturn("VgpuTypeGetNumDisplayHeads", ret)
+}
+
+func (x *VgpuTypeId) GetResolution(displayIndex int) (uint32, uint32, error) {
+	r0, r1, ret := x.VgpuTypeId.GetResolution(displayIndex)
+	return r0, r1, FromReturn("VgpuTypeGetResolution", ret)
+}
+
+func (x *VgpuTypeId) GetSupportedPlacements(device *Device) (nvml.VgpuPlacementList, error) {
+	r0, ret := x.VgpuTypeId.GetSupportedPlacements(unwrapDevice(device))
+	return r0, device.fromReturn("VgpuTypeGetSupportedPlacements", ret)
+}
@@ -0,0 +1,753 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package xid
+
+// catalog holds the known XIDs, indexed by code
+var catalog = map[uint64]Xid{
+	1: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	2: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	3: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	4: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	6: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	7: {
+		Name:        "Invalid or corrupted push buffer address",
+		Description: "The push buffer sent to the GPU had an invalid address.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	8: {
+		Name:        "GPU stopped processing",
+		Description: "The GPU stopped processing work, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	9: {
+		Name:        "Driver error programming GPU",
+		Description: "The driver failed to program the GPU.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	11: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	12: {
+		Name:        "Driver error handling GPU exception",
+		Description: "The driver failed to handle an exception reported by the GPU.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	13: {
+		Name:        "Graphics Engine Exception",
+		Description: "The GPU reported an exception, typically caused by an application error such as an out of bounds access.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	16: {
+		Name:        "Display engine hung",
+		Description: "The display engine stopped responding.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	18: {
+		Name:        "Bus mastering disabled in PCI Config Space",
+		Description: "Bus mastering was disabled for the GPU in its PCI configuration space.",
+		Severity:    SeverityCritical,
+		Action:      ActionReboot,
+	},
+	19: {
+		Name:        "Display Engine error",
+		Description: "The display engine reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	20: {
+		Name:        "Invalid or corrupted Mpeg push buffer",
+		Description: "The push buffer sent to the MPEG engine was invalid.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	21: {
+		Name:        "Invalid or corrupted Motion Estimation push buffer",
+		Description: "The push buffer sent to the motion estimation engine was invalid.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	22: {
+		Name:        "Invalid or corrupted Video Processor push buffer",
+		Description: "The push buffer sent to the video processor was invalid.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	24: {
+		Name:        "GPU semaphore timeout",
+		Description: "The GPU timed out waiting on a semaphore, typically because of an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	25: {
+		Name:        "Invalid or illegal push buffer stream",
+		Description: "The push buffer stream sent to the GPU was invalid, typically because of an application or driver error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	26: {
+		Name:        "Framebuffer timeout",
+		Description: "The GPU timed out accessing its framebuffer.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	27: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	28: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	29: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	30: {
+		Name:        "GPU semaphore access error",
+		Description: "The GPU failed to access a semaphore, typically because of an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	31: {
+		Name:        "GPU memory page fault",
+		Description: "An application accessed an invalid GPU memory address.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	32: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The push buffer stream sent to the GPU was corrupted, typically because of a PCIe error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	33: {
+		Name:        "Internal micro-controller error",
+		Description: "An internal micro-controller of the GPU reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	34: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	35: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	36: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	37: {
+		Name:        "Driver firmware error",
+		Description: "The driver firmware reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	38: {
+		Name:        "Driver firmware error",
+		Description: "The driver firmware reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	42: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	43: {
+		Name:        "GPU stopped processing",
+		Description: "The GPU stopped processing the work of an application that hit a software induced fault. Other applications are not affected.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	44: {
+		Name:        "Graphics Engine fault during context switch",
+		Description: "The GPU reported a fault while switching between contexts.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	45: {
+		Name:        "Preemptive cleanup, due to previous errors",
+		Description: "The driver terminated the work of an application, either because of an earlier error or because the application was killed.",
+		Severity:    SeverityInfo,
+		Action:      ActionIgnore,
+	},
+	46: {
+		Name:        "GPU stopped processing",
+		Description: "The GPU stopped processing work because of a driver error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	47: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	48: {
+		Name:        "Double Bit ECC Error",
+		Description: "An uncorrectable (double bit) ECC error was detected in GPU memory.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	54: {
+		Name:        "Auxiliary power is not connected to the GPU board",
+		Description: "The auxiliary power cables of the GPU board are not connected.",
+		Severity:    SeverityCritical,
+		Action:      ActionReboot,
+	},
+	56: {
+		Name:        "Display Engine error",
+		Description: "The display engine reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	57: {
+		Name:        "Error programming video memory interface",
+		Description: "The driver failed to program the video memory interface.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	58: {
+		Name:        "Unstable video memory interface detected",
+		Description: "The video memory interface is unstable, typically because of a hardware problem.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	59: {
+		Name:        "Internal micro-controller error",
+		Description: "An internal micro-controller of the GPU reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	60: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	61: {
+		Name:        "Internal micro-controller breakpoint/warning",
+		Description: "An internal micro-controller of the GPU hit a breakpoint.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	62: {
+		Name:        "Internal micro-controller halt",
+		Description: "An internal micro-controller of the GPU halted.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	63: {
+		Name:        "ECC page retirement or row remapping recording event",
+		Description: "A GPU memory page was retired or a row remapped after an ECC error. The remapping takes effect once the GPU is reset.",
+		Severity:    SeverityWarning,
+		Action:      ActionResetGPU,
+	},
+	64: {
+		Name:        "ECC page retirement or row remapper recording failure",
+		Description: "The driver failed to record the retirement of a GPU memory page or the remapping of a row.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	65: {
+		Name:        "Video processor exception",
+		Description: "A video processor engine reported an exception, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	66: {
+		Name:        "Illegal access by driver",
+		Description: "The driver made an illegal access to the GPU.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	67: {
+		Name:        "Illegal access by driver",
+		Description: "The driver made an illegal access to the GPU.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	68: {
+		Name:        "NVDEC0 Exception",
+		Description: "The video decoder engine reported an exception.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	69: {
+		Name:        "Graphics Engine class error",
+		Description: "The GPU reported an illegal operation, typically caused by an application error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	70: {
+		Name:        "CE3: Unknown Error",
+		Description: "Copy engine 3 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	71: {
+		Name:        "CE4: Unknown Error",
+		Description: "Copy engine 4 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	72: {
+		Name:        "CE5: Unknown Error",
+		Description: "Copy engine 5 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	73: {
+		Name:        "NVENC2 Error",
+		Description: "Video encoder engine 2 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	74: {
+		Name:        "NVLINK Error",
+		Description: "An NVLink reported an error, typically because of a hardware problem with the link.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	75: {
+		Name:        "CE6: Unknown Error",
+		Description: "Copy engine 6 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	76: {
+		Name:        "CE7: Unknown Error",
+		Description: "Copy engine 7 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	77: {
+		Name:        "CE8: Unknown Error",
+		Description: "Copy engine 8 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	78: {
+		Name:        "vGPU Start Error",
+		Description: "A vGPU failed to start.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	79: {
+		Name:        "GPU has fallen off the bus",
+		Description: "The GPU is no longer reachable over PCIe.",
+		Severity:    SeverityCritical,
+		Action:      ActionReboot,
+	},
+	80: {
+		Name:        "Corrupted data sent to GPU",
+		Description: "Data sent to the GPU over PCIe was corrupted.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	81: {
+		Name:        "VGA Subsystem Error",
+		Description: "The VGA subsystem of the GPU reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	82: {
+		Name:        "NVJPG0 Error",
+		Description: "JPEG decoder engine 0 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	83: {
+		Name:        "NVDEC1 Error",
+		Description: "Video decoder engine 1 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	84: {
+		Name:        "NVDEC2 Error",
+		Description: "Video decoder engine 2 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	85: {
+		Name:        "CE9: Unknown Error",
+		Description: "Copy engine 9 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	86: {
+		Name:        "OFA Exception",
+		Description: "The optical flow accelerator reported an exception.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	88: {
+		Name:        "NVDEC3 Error",
+		Description: "Video decoder engine 3 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	89: {
+		Name:        "NVDEC4 Error",
+		Description: "Video decoder engine 4 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	92: {
+		Name:        "High single-bit ECC error rate",
+		Description: "The rate of correctable (single bit) ECC errors is high. The errors were corrected.",
+		Severity:    SeverityInfo,
+		Action:      ActionIgnore,
+	},
+	93: {
+		Name:        "Non-fatal violation of provisioned InfoROM wear limit",
+		Description: "The InfoROM of the GPU exceeded its provisioned wear limit.",
+		Severity:    SeverityInfo,
+		Action:      ActionIgnore,
+	},
+	94: {
+		Name:        "Contained ECC error",
+		Description: "An uncorrectable ECC error was contained to the applications using the affected memory, which were terminated.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	95: {
+		Name:        "Uncontained ECC error",
+		Description: "An uncorrectable ECC error could not be contained. All applications on the GPU were affected.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	96: {
+		Name:        "NVDEC5 Error",
+		Description: "Video decoder engine 5 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	97: {
+		Name:        "NVDEC6 Error",
+		Description: "Video decoder engine 6 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	98: {
+		Name:        "NVDEC7 Error",
+		Description: "Video decoder engine 7 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	99: {
+		Name:        "NVJPG1 Error",
+		Description: "JPEG decoder engine 1 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	100: {
+		Name:        "NVJPG2 Error",
+		Description: "JPEG decoder engine 2 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	101: {
+		Name:        "NVJPG3 Error",
+		Description: "JPEG decoder engine 3 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	102: {
+		Name:        "NVJPG4 Error",
+		Description: "JPEG decoder engine 4 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	103: {
+		Name:        "NVJPG5 Error",
+		Description: "JPEG decoder engine 5 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	104: {
+		Name:        "NVJPG6 Error",
+		Description: "JPEG decoder engine 6 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	105: {
+		Name:        "NVJPG7 Error",
+		Description: "JPEG decoder engine 7 reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	106: {
+		Name:        "SMBPBI Test Message",
+		Description: "A test message was sent through the SMBus post-box interface.",
+		Severity:    SeverityInfo,
+		Action:      ActionIgnore,
+	},
+	107: {
+		Name:        "SMBPBI Test Message Silent",
+		Description: "A silent test message was sent through the SMBus post-box interface.",
+		Severity:    SeverityInfo,
+		Action:      ActionIgnore,
+	},
+	109: {
+		Name:        "Context Switch Timeout Error",
+		Description: "The GPU timed out while switching between contexts.",
+		Severity:    SeverityWarning,
+		Action:      ActionRestartApp,
+	},
+	110: {
+		Name:        "Security Fault Error",
+		Description: "The GPU detected a security fault.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	111: {
+		Name:        "Display Bundle Error Event",
+		Description: "The display engine reported a bundle error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	112: {
+		Name:        "Display Supervisor Error",
+		Description: "The display supervisor reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	113: {
+		Name:        "DP Link Training Error",
+		Description: "DisplayPort link training failed.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	114: {
+		Name:        "Display Pipeline Underflow Error",
+		Description: "The display pipeline underflowed.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	115: {
+		Name:        "Display Core Channel Error",
+		Description: "The display core channel reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	116: {
+		Name:        "Display Window Channel Error",
+		Description: "A display window channel reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	117: {
+		Name:        "Display Cursor Channel Error",
+		Description: "A display cursor channel reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	118: {
+		Name:        "Display Pixel Pipeline Error",
+		Description: "The display pixel pipeline reported an error.",
+		Severity:    SeverityWarning,
+		Action:      ActionIgnore,
+	},
+	119: {
+		Name:        "GSP RPC Timeout",
+		Description: "A call to the GPU System Processor (GSP) timed out.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	120: {
+		Name:        "GSP Error",
+		Description: "The GPU System Processor (GSP) reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	121: {
+		Name:        "C2C Link Error",
+		Description: "The chip-to-chip (C2C) link between the GPU and the CPU reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionReboot,
+	},
+	122: {
+		Name:        "SPI PMU RPC Read Failure",
+		Description: "The GPU failed to read from its SPI flash through the PMU.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	123: {
+		Name:        "SPI PMU RPC Write Failure",
+		Description: "The GPU failed to write to its SPI flash through the PMU.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	124: {
+		Name:        "SPI PMU RPC Erase Failure",
+		Description: "The GPU failed to erase its SPI flash through the PMU.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	125: {
+		Name:        "Inforom FS Failure",
+		Description: "The driver failed to access the file system of the InfoROM.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	140: {
+		Name:        "Unrecovered ECC Error",
+		Description: "An uncorrectable ECC error could not be recovered from.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	143: {
+		Name:        "GPU Initialization Failure",
+		Description: "The GPU failed to initialize.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	144: {
+		Name:        "NVLINK: SAW Error",
+		Description: "The NVLink SAW unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	145: {
+		Name:        "NVLINK: RLW Error",
+		Description: "The NVLink RLW unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	146: {
+		Name:        "NVLINK: TLW Error",
+		Description: "The NVLink TLW unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	147: {
+		Name:        "NVLINK: TREX Error",
+		Description: "The NVLink TREX unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	148: {
+		Name:        "NVLINK: NVLPW_CTRL Error",
+		Description: "The NVLink NVLPW_CTRL unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	149: {
+		Name:        "NVLINK: NETIR Error",
+		Description: "The NVLink NETIR unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	150: {
+		Name:        "NVLINK: MSE Error",
+		Description: "The NVLink MSE unit reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	151: {
+		Name:        "Key rotation Error",
+		Description: "The GPU failed to rotate the keys used in confidential computing mode.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	154: {
+		Name:        "GPU Recovery Action Changed",
+		Description: "The action required to recover the GPU changed. The required action is reported by the GPU recovery action of the device.",
+		Severity:    SeverityWarning,
+		Action:      ActionResetGPU,
+	},
+	155: {
+		Name:        "NVLINK: SW Defined Error",
+		Description: "The NVLink software reported an error.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	156: {
+		Name:        "Resource Retirement Event",
+		Description: "A GPU resource was retired. The retirement takes effect once the GPU is reset.",
+		Severity:    SeverityWarning,
+		Action:      ActionResetGPU,
+	},
+	157: {
+		Name:        "Resource Retirement Failure",
+		Description: "The driver failed to retire a GPU resource.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	158: {
+		Name:        "GPU Fatal Timeout",
+		Description: "The GPU timed out in a way it cannot recover from.",
+		Severity:    SeverityCritical,
+		Action:      ActionResetGPU,
+	},
+	159: {
+		Name:        "CHI Non-Data Error",
+		Description: "The coherent hub interface (CHI) reported an error not related to data.",
+		Severity:    SeverityCritical,
+		Action:      ActionReboot,
+	},
+}
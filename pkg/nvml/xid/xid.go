@@ -0,0 +1,141 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package xid decodes the XID errors reported by NVML.
+//
+// An XID is reported as the EventData of an event of type
+// EventTypeXidCriticalError. The catalog in this package maps XIDs to their
+// name, description, severity and the action recommended to recover from
+// them, as documented in https://docs.nvidia.com/deploy/xid-errors/.
+package xid
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Severity is the severity of an XID
+type Severity int
+
+// Severities of XIDs
+const (
+	SeverityUnknown Severity = iota
+	// SeverityInfo is reported for XIDs that do not affect the GPU
+	SeverityInfo
+	// SeverityWarning is reported for XIDs that affect the running applications
+	SeverityWarning
+	// SeverityCritical is reported for XIDs that leave the GPU unusable
+	SeverityCritical
+)
+
+// Action is the action recommended to recover from an XID
+type Action int
+
+// Actions recommended to recover from XIDs
+const (
+	ActionUnknown Action = iota
+	// ActionIgnore means no action is required
+	ActionIgnore
+	// ActionRestartApp means the affected application should be restarted
+	ActionRestartApp
+	// ActionResetGPU means the GPU should be reset once idle
+	ActionResetGPU
+	// ActionReboot means the node should be rebooted
+	ActionReboot
+)
+
+// Xid describes an XID
+type Xid struct {
+	Code        uint64
+	Name        string
+	Description string
+	Severity    Severity
+	Action      Action
+}
+
+// Event is an XID reported by an NVML event
+type Event struct {
+	Xid
+	Device            nvml.Device
+	GpuInstanceId     uint32
+	ComputeInstanceId uint32
+}
+
+// Lookup returns the description of an XID. For XIDs that are not part of the
+// catalog, false is returned together with an Xid with only its code set. Its
+// severity and action are SeverityUnknown and ActionUnknown, leaving it to the
+// caller to decide how to handle the XID.
+func Lookup(code uint64) (Xid, bool) {
+	x, exists := catalog[code]
+	if !exists {
+		return Xid{Code: code}, false
+	}
+	x.Code = code
+	return x, true
+}
+
+// FromEventData decodes the XID reported by an event. False is returned if
+// the event is not of type EventTypeXidCriticalError.
+func FromEventData(e nvml.EventData) (Event, bool) {
+	if e.EventType&nvml.EventTypeXidCriticalError == 0 {
+		return Event{}, false
+	}
+	x, _ := Lookup(e.EventData)
+	event := Event{
+		Xid:               x,
+		Device:            e.Device,
+		GpuInstanceId:     e.GpuInstanceId,
+		ComputeInstanceId: e.ComputeInstanceId,
+	}
+	return event, true
+}
+
+// String returns the string representation of an XID
+func (x Xid) String() string {
+	if x.Name == "" {
+		return fmt.Sprintf("XID %d", x.Code)
+	}
+	return fmt.Sprintf("XID %d (%s)", x.Code, x.Name)
+}
+
+// String returns the string representation of a Severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "Info"
+	case SeverityWarning:
+		return "Warning"
+	case SeverityCritical:
+		return "Critical"
+	}
+	return "Unknown"
+}
+
+// String returns the string representation of an Action
+func (a Action) String() string {
+	switch a {
+	case ActionIgnore:
+		return "Ignore"
+	case ActionRestartApp:
+		return "RestartApp"
+	case ActionResetGPU:
+		return "ResetGPU"
+	case ActionReboot:
+		return "Reboot"
+	}
+	return "Unknown"
+}
@@ -0,0 +1,92 @@
+/**
+# Copyright 2025 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package xid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+func TestLookup(t *testing.T) {
+	x, ok := Lookup(79)
+	require.True(t, ok)
+	require.EqualValues(t, 79, x.Code)
+	require.Equal(t, SeverityCritical, x.Severity)
+	require.Equal(t, ActionReboot, x.Action)
+	require.Equal(t, "XID 79 (GPU has fallen off the bus)", x.String())
+
+	x, ok = Lookup(12345)
+	require.False(t, ok)
+	require.Equal(t, Xid{Code: 12345}, x)
+	require.Equal(t, "XID 12345", x.String())
+
+	// Unknown XIDs have an unknown severity and action
+	for _, code := range []uint64{0, 5, 12345} {
+		x, ok := Lookup(code)
+		require.False(t, ok, "XID %d", code)
+		require.Equal(t, SeverityUnknown, x.Severity, "XID %d", code)
+		require.Equal(t, ActionUnknown, x.Action, "XID %d", code)
+		require.Equal(t, "Unknown", x.Severity.String())
+		require.Equal(t, "Unknown", x.Action.String())
+	}
+
+	// Benign XIDs are not reported as critical
+	x, ok = Lookup(93)
+	require.True(t, ok)
+	require.Equal(t, SeverityInfo, x.Severity)
+	require.Equal(t, ActionIgnore, x.Action)
+
+	x, ok = Lookup(154)
+	require.True(t, ok)
+	require.Equal(t, "GPU Recovery Action Changed", x.Name)
+
+	// All catalog entries are fully described
+	for code, x := range catalog {
+		require.NotEmpty(t, x.Name, "XID %d", code)
+		require.NotEmpty(t, x.Description, "XID %d", code)
+		require.NotEqual(t, SeverityUnknown, x.Severity, "XID %d", code)
+		require.NotEqual(t, ActionUnknown, x.Action, "XID %d", code)
+	}
+}
+
+func TestFromEventData(t *testing.T) {
+	device := &mock.Device{}
+
+	event, ok := FromEventData(nvml.EventData{
+		Device:            device,
+		EventType:         nvml.EventTypeXidCriticalError,
+		EventData:         48,
+		GpuInstanceId:     1,
+		ComputeInstanceId: 0,
+	})
+	require.True(t, ok)
+	require.Equal(t, device, event.Device)
+	require.EqualValues(t, 48, event.Code)
+	require.Equal(t, "Double Bit ECC Error", event.Name)
+	require.Equal(t, ActionResetGPU, event.Action)
+	require.EqualValues(t, 1, event.GpuInstanceId)
+
+	_, ok = FromEventData(nvml.EventData{
+		EventType: nvml.EventTypePState,
+		EventData: 48,
+	})
+	require.False(t, ok)
+}
@@ -0,0 +1,7569 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// interfaceMiddleware passes the calls made to the wrapped Interface through a Middleware.
+type interfaceMiddleware struct {
+	Interface
+	middleware Middleware
+}
+
+// WrapInterface returns a wrapper that passes every call made to wrapped through m.
+func WrapInterface(wrapped Interface, m Middleware) Interface {
+	if wrapped == nil {
+		return nil
+	}
+	return &interfaceMiddleware{wrapped, m}
+}
+
+func (w *interfaceMiddleware) ComputeInstanceDestroy(computeInstance ComputeInstance) Return {
+	results := w.middleware(CallInfo{"Interface", "ComputeInstanceDestroy", w.Interface, []any{computeInstance}}, func() []any {
+		r0 := w.Interface.ComputeInstanceDestroy(unwrapComputeInstance(computeInstance))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) ComputeInstanceGetInfo(computeInstance ComputeInstance) (ComputeInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "ComputeInstanceGetInfo", w.Interface, []any{computeInstance}}, func() []any {
+		r0, r1 := w.Interface.ComputeInstanceGetInfo(unwrapComputeInstance(computeInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstanceInfo)
+	r1, _ := results[1].(Return)
+	return wrapComputeInstanceInfo(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceClearAccountingPids(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceClearAccountingPids", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceClearAccountingPids(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceClearCpuAffinity(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceClearCpuAffinity", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceClearCpuAffinity(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceClearEccErrorCounts(device Device, counterType EccCounterType) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceClearEccErrorCounts", w.Interface, []any{device, counterType}}, func() []any {
+		r0 := w.Interface.DeviceClearEccErrorCounts(unwrapDevice(device), counterType)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceClearFieldValues(device Device, values []FieldValue) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceClearFieldValues", w.Interface, []any{device, values}}, func() []any {
+		r0 := w.Interface.DeviceClearFieldValues(unwrapDevice(device), values)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceCreateGpuInstance(device Device, info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceCreateGpuInstance", w.Interface, []any{device, info}}, func() []any {
+		r0, r1 := w.Interface.DeviceCreateGpuInstance(unwrapDevice(device), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceCreateGpuInstanceWithPlacement(device Device, info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceCreateGpuInstanceWithPlacement", w.Interface, []any{device, info, placement}}, func() []any {
+		r0, r1 := w.Interface.DeviceCreateGpuInstanceWithPlacement(unwrapDevice(device), info, placement)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceDiscoverGpus() (PciInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceDiscoverGpus", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.DeviceDiscoverGpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceFreezeNvLinkUtilizationCounter(device Device, link int, counter int, freeze EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceFreezeNvLinkUtilizationCounter", w.Interface, []any{device, link, counter, freeze}}, func() []any {
+		r0 := w.Interface.DeviceFreezeNvLinkUtilizationCounter(unwrapDevice(device), link, counter, freeze)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetAPIRestriction(device Device, apiType RestrictedAPI) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAPIRestriction", w.Interface, []any{device, apiType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAPIRestriction(unwrapDevice(device), apiType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAccountingBufferSize(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAccountingBufferSize", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAccountingBufferSize(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAccountingMode(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAccountingMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAccountingMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAccountingPids(device Device) ([]int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAccountingPids", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAccountingPids(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAccountingStats(device Device, pid uint32) (AccountingStats, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAccountingStats", w.Interface, []any{device, pid}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAccountingStats(unwrapDevice(device), pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAccountingStats_v2(device Device, pid uint32) (AccountingStats_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAccountingStats_v2", w.Interface, []any{device, pid}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAccountingStats_v2(unwrapDevice(device), pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetActiveVgpus(device Device) ([]VgpuInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetActiveVgpus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetActiveVgpus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuInstance)
+	r1, _ := results[1].(Return)
+	return wrapVgpuInstances(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAdaptiveClockInfoStatus(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAdaptiveClockInfoStatus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAdaptiveClockInfoStatus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAddressingMode(device Device) (DeviceAddressingMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAddressingMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAddressingMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceAddressingMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetApplicationsClock", w.Interface, []any{device, clockType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetApplicationsClock(unwrapDevice(device), clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetArchitecture(device Device) (DeviceArchitecture, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetArchitecture", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetArchitecture(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceArchitecture)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAttributes(device Device) (DeviceAttributes, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAttributes", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetAttributes(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceAttributes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetAutoBoostedClocksEnabled(device Device) (EnableState, EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetAutoBoostedClocksEnabled", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetAutoBoostedClocksEnabled(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(EnableState)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetBAR1MemoryInfo(device Device) (BAR1Memory, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBAR1MemoryInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBAR1MemoryInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BAR1Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBBXTimeData_v1(device Device) (BBXTimeData_v1, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBBXTimeData_v1", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBBXTimeData_v1(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BBXTimeData_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBoardId(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBoardId", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBoardId(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBoardPartNumber(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBoardPartNumber", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBoardPartNumber(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBrand(device Device) (BrandType, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBrand", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBrand(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BrandType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBridgeChipInfo(device Device) (BridgeChipHierarchy, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBridgeChipInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBridgeChipInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BridgeChipHierarchy)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetBusType(device Device) (BusType, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetBusType", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetBusType(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BusType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetC2cModeInfoV(device Device) C2cModeInfoHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetC2cModeInfoV", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceGetC2cModeInfoV(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(C2cModeInfoHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetCapabilities(device Device) (DeviceCapabilities, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCapabilities", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCapabilities(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceCapabilities)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetClkMonStatus(device Device) (ClkMonStatus, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetClkMonStatus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetClkMonStatus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ClkMonStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetClock(device Device, clockType ClockType, clockId ClockId) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetClock", w.Interface, []any{device, clockType, clockId}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetClock(unwrapDevice(device), clockType, clockId)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetClockInfo", w.Interface, []any{device, clockType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetClockInfo(unwrapDevice(device), clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetClockOffsets(device Device) (ClockOffset, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetClockOffsets", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetClockOffsets(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ClockOffset)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetComputeInstanceId(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetComputeInstanceId", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetComputeInstanceId(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetComputeMode(device Device) (ComputeMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetComputeMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetComputeMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetComputeRunningProcesses", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetComputeRunningProcesses(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetConfComputeGpuAttestationReport(device Device, gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetConfComputeGpuAttestationReport", w.Interface, []any{device, gpuAtstReport}}, func() []any {
+		r0 := w.Interface.DeviceGetConfComputeGpuAttestationReport(unwrapDevice(device), gpuAtstReport)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetConfComputeGpuCertificate(device Device) (ConfComputeGpuCertificate, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetConfComputeGpuCertificate", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetConfComputeGpuCertificate(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeGpuCertificate)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetConfComputeMemSizeInfo(device Device) (ConfComputeMemSizeInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetConfComputeMemSizeInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetConfComputeMemSizeInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeMemSizeInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetConfComputeProtectedMemoryUsage(device Device) (Memory, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetConfComputeProtectedMemoryUsage", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetConfComputeProtectedMemoryUsage(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCoolerInfo(device Device) (CoolerInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCoolerInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCoolerInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(CoolerInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCount() (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCount", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCount()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCpuAffinity", w.Interface, []any{device, numCPUs}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCpuAffinity(unwrapDevice(device), numCPUs)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCpuAffinityWithinScope(device Device, numCPUs int, scope AffinityScope) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCpuAffinityWithinScope", w.Interface, []any{device, numCPUs, scope}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCpuAffinityWithinScope(unwrapDevice(device), numCPUs, scope)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCreatableVgpus(device Device) ([]VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCreatableVgpus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCreatableVgpus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return wrapVgpuTypeIds(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCudaComputeCapability(device Device) (int, int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCudaComputeCapability", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetCudaComputeCapability(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetCurrPcieLinkGeneration(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCurrPcieLinkGeneration", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCurrPcieLinkGeneration(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCurrPcieLinkWidth(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCurrPcieLinkWidth", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCurrPcieLinkWidth(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCurrentClockFreqs(device Device) (DeviceCurrentClockFreqs, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCurrentClockFreqs", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCurrentClockFreqs(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceCurrentClockFreqs)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCurrentClocksEventReasons(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCurrentClocksEventReasons", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCurrentClocksEventReasons(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetCurrentClocksThrottleReasons", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetCurrentClocksThrottleReasons(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDecoderUtilization(device Device) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDecoderUtilization", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetDecoderUtilization(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetDefaultApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDefaultApplicationsClock", w.Interface, []any{device, clockType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDefaultApplicationsClock(unwrapDevice(device), clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDefaultEccMode(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDefaultEccMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDefaultEccMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDetailedEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDetailedEccErrors", w.Interface, []any{device, errorType, counterType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDetailedEccErrors(unwrapDevice(device), errorType, counterType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EccErrorCounts)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDeviceHandleFromMigDeviceHandle(migdevice Device) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDeviceHandleFromMigDeviceHandle", w.Interface, []any{migdevice}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDeviceHandleFromMigDeviceHandle(unwrapDevice(migdevice))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDisplayActive(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDisplayActive", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDisplayActive(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDisplayMode(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDisplayMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDisplayMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetDramEncryptionMode(device Device) (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDramEncryptionMode", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetDramEncryptionMode(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DramEncryptionInfo)
+	r1, _ := results[1].(DramEncryptionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetDriverModel(device Device) (DriverModel, DriverModel, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDriverModel", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetDriverModel(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DriverModel)
+	r1, _ := results[1].(DriverModel)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetDriverModel_v2(device Device) (DriverModel, DriverModel, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDriverModel_v2", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetDriverModel_v2(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DriverModel)
+	r1, _ := results[1].(DriverModel)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetDynamicPstatesInfo(device Device) (GpuDynamicPstatesInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetDynamicPstatesInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetDynamicPstatesInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuDynamicPstatesInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetEccMode(device Device) (EnableState, EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEccMode", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetEccMode(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(EnableState)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetEncoderCapacity(device Device, encoderQueryType EncoderType) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEncoderCapacity", w.Interface, []any{device, encoderQueryType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetEncoderCapacity(unwrapDevice(device), encoderQueryType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetEncoderSessions(device Device) ([]EncoderSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEncoderSessions", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetEncoderSessions(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]EncoderSessionInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetEncoderStats(device Device) (int, uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEncoderStats", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2, r3 := w.Interface.DeviceGetEncoderStats(unwrapDevice(device))
+		return []any{r0, r1, r2, r3}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(uint32)
+	r3, _ := results[3].(Return)
+	return r0, r1, r2, r3
+}
+
+func (w *interfaceMiddleware) DeviceGetEncoderUtilization(device Device) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEncoderUtilization", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetEncoderUtilization(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetEnforcedPowerLimit(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetEnforcedPowerLimit", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetEnforcedPowerLimit(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFBCSessions(device Device) ([]FBCSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFBCSessions", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFBCSessions(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]FBCSessionInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFBCStats(device Device) (FBCStats, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFBCStats", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFBCStats(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FBCStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFanControlPolicy_v2(device Device, fan int) (FanControlPolicy, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFanControlPolicy_v2", w.Interface, []any{device, fan}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFanControlPolicy_v2(unwrapDevice(device), fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FanControlPolicy)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFanSpeed(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFanSpeed", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFanSpeed(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFanSpeedRPM(device Device) (FanSpeedInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFanSpeedRPM", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFanSpeedRPM(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FanSpeedInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFanSpeed_v2(device Device, fan int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFanSpeed_v2", w.Interface, []any{device, fan}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetFanSpeed_v2(unwrapDevice(device), fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetFieldValues(device Device, values []FieldValue) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetFieldValues", w.Interface, []any{device, values}}, func() []any {
+		r0 := w.Interface.DeviceGetFieldValues(unwrapDevice(device), values)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpcClkMinMaxVfOffset", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetGpcClkMinMaxVfOffset(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetGpcClkVfOffset(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpcClkVfOffset", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpcClkVfOffset(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuFabricInfo(device Device) (GpuFabricInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuFabricInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuFabricInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuFabricInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuFabricInfoV(device Device) GpuFabricInfoHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuFabricInfoV", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceGetGpuFabricInfoV(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuFabricInfoHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceById", w.Interface, []any{device, id}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstanceById(unwrapDevice(device), id)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceId(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceId", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstanceId(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstancePossiblePlacements(device Device, info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstancePossiblePlacements", w.Interface, []any{device, info}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstancePossiblePlacements(unwrapDevice(device), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]GpuInstancePlacement)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceProfileInfo(device Device, profile int) (GpuInstanceProfileInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceProfileInfo", w.Interface, []any{device, profile}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstanceProfileInfo(unwrapDevice(device), profile)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) GpuInstanceProfileInfoByIdHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceProfileInfoByIdV", w.Interface, []any{device, profileId}}, func() []any {
+		r0 := w.Interface.DeviceGetGpuInstanceProfileInfoByIdV(unwrapDevice(device), profileId)
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfoByIdHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceProfileInfoV(device Device, profile int) GpuInstanceProfileInfoHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceProfileInfoV", w.Interface, []any{device, profile}}, func() []any {
+		r0 := w.Interface.DeviceGetGpuInstanceProfileInfoV(unwrapDevice(device), profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfoHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstanceRemainingCapacity(device Device, info *GpuInstanceProfileInfo) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstanceRemainingCapacity", w.Interface, []any{device, info}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstanceRemainingCapacity(unwrapDevice(device), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuInstances(device Device, info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuInstances", w.Interface, []any{device, info}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuInstances(unwrapDevice(device), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]GpuInstance)
+	r1, _ := results[1].(Return)
+	return wrapGpuInstances(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuMaxPcieLinkGeneration", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGpuMaxPcieLinkGeneration(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGpuOperationMode(device Device) (GpuOperationMode, GpuOperationMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGpuOperationMode", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetGpuOperationMode(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(GpuOperationMode)
+	r1, _ := results[1].(GpuOperationMode)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetGraphicsRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGraphicsRunningProcesses", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGraphicsRunningProcesses(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGridLicensableFeatures(device Device) (GridLicensableFeatures, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGridLicensableFeatures", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGridLicensableFeatures(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GridLicensableFeatures)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetGspFirmwareMode(device Device) (bool, bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGspFirmwareMode", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetGspFirmwareMode(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(bool)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetGspFirmwareVersion(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetGspFirmwareVersion", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetGspFirmwareVersion(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHandleByIndex(index int) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHandleByIndex", w.Interface, []any{index}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHandleByIndex(index)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHandleByPciBusId(pciBusId string) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHandleByPciBusId", w.Interface, []any{pciBusId}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHandleByPciBusId(pciBusId)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHandleBySerial(serial string) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHandleBySerial", w.Interface, []any{serial}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHandleBySerial(serial)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHandleByUUID(uuid string) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHandleByUUID", w.Interface, []any{uuid}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHandleByUUID(uuid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHandleByUUIDV(uuid *UUID) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHandleByUUIDV", w.Interface, []any{uuid}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHandleByUUIDV(uuid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHostVgpuMode(device Device) (HostVgpuMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHostVgpuMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHostVgpuMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(HostVgpuMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetHostname_v1(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetHostname_v1", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetHostname_v1(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetIndex(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetIndex", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetIndex(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetInforomConfigurationChecksum(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetInforomConfigurationChecksum", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetInforomConfigurationChecksum(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetInforomImageVersion(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetInforomImageVersion", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetInforomImageVersion(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetInforomVersion(device Device, object InforomObject) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetInforomVersion", w.Interface, []any{device, object}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetInforomVersion(unwrapDevice(device), object)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetIrqNum(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetIrqNum", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetIrqNum(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetJpgUtilization(device Device) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetJpgUtilization", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetJpgUtilization(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetLastBBXFlushTime(device Device) (uint64, uint, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetLastBBXFlushTime", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetLastBBXFlushTime(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetMPSComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMPSComputeRunningProcesses", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMPSComputeRunningProcesses(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMarginTemperature(device Device) (MarginTemperature, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMarginTemperature", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMarginTemperature(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(MarginTemperature)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMaxClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMaxClockInfo", w.Interface, []any{device, clockType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMaxClockInfo(unwrapDevice(device), clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMaxCustomerBoostClock(device Device, clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMaxCustomerBoostClock", w.Interface, []any{device, clockType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMaxCustomerBoostClock(unwrapDevice(device), clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMaxMigDeviceCount(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMaxMigDeviceCount", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMaxMigDeviceCount(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMaxPcieLinkGeneration(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMaxPcieLinkGeneration", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMaxPcieLinkGeneration(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMaxPcieLinkWidth(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMaxPcieLinkWidth", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMaxPcieLinkWidth(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemClkMinMaxVfOffset", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetMemClkMinMaxVfOffset(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetMemClkVfOffset(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemClkVfOffset", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemClkVfOffset(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemoryAffinity(device Device, numNodes int, scope AffinityScope) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemoryAffinity", w.Interface, []any{device, numNodes, scope}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemoryAffinity(unwrapDevice(device), numNodes, scope)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemoryBusWidth(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemoryBusWidth", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemoryBusWidth(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemoryErrorCounter(device Device, errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemoryErrorCounter", w.Interface, []any{device, errorType, counterType, locationType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemoryErrorCounter(unwrapDevice(device), errorType, counterType, locationType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemoryInfo(device Device) (Memory, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemoryInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemoryInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMemoryInfo_v2(device Device) (Memory_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMemoryInfo_v2", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMemoryInfo_v2(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMigDeviceHandleByIndex(device Device, index int) (Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMigDeviceHandleByIndex", w.Interface, []any{device, index}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMigDeviceHandleByIndex(unwrapDevice(device), index)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMigMode(device Device) (int, int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMigMode", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetMigMode(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetMinMaxClockOfPState(device Device, clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMinMaxClockOfPState", w.Interface, []any{device, clockType, pstate}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetMinMaxClockOfPState(unwrapDevice(device), clockType, pstate)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetMinMaxFanSpeed(device Device) (int, int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMinMaxFanSpeed", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetMinMaxFanSpeed(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetMinorNumber(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMinorNumber", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMinorNumber(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetModuleId(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetModuleId", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetModuleId(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetMultiGpuBoard(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetMultiGpuBoard", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetMultiGpuBoard(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetName(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetName", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetName(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNumFans(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNumFans", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNumFans(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNumGpuCores(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNumGpuCores", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNumGpuCores(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNumaNodeId(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNumaNodeId", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNumaNodeId(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkCapability(device Device, link int, capability NvLinkCapability) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkCapability", w.Interface, []any{device, link, capability}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkCapability(unwrapDevice(device), link, capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkErrorCounter(device Device, link int, counter NvLinkErrorCounter) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkErrorCounter", w.Interface, []any{device, link, counter}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkErrorCounter(unwrapDevice(device), link, counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkInfo(device Device) NvLinkInfoHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkInfo", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceGetNvLinkInfo(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(NvLinkInfoHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkRemoteDeviceType(device Device, link int) (IntNvLinkDeviceType, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkRemoteDeviceType", w.Interface, []any{device, link}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkRemoteDeviceType(unwrapDevice(device), link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(IntNvLinkDeviceType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkRemotePciInfo(device Device, link int) (PciInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkRemotePciInfo", w.Interface, []any{device, link}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkRemotePciInfo(unwrapDevice(device), link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkState(device Device, link int) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkState", w.Interface, []any{device, link}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkState(unwrapDevice(device), link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkUtilizationControl(device Device, link int, counter int) (NvLinkUtilizationControl, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkUtilizationControl", w.Interface, []any{device, link, counter}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkUtilizationControl(unwrapDevice(device), link, counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvLinkUtilizationControl)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkUtilizationCounter(device Device, link int, counter int) (uint64, uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkUtilizationCounter", w.Interface, []any{device, link, counter}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetNvLinkVersion(device Device, link int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvLinkVersion", w.Interface, []any{device, link}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvLinkVersion(unwrapDevice(device), link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvlinkBwMode(device Device) (NvlinkGetBwMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvlinkBwMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvlinkBwMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvlinkGetBwMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetNvlinkSupportedBwModes(device Device) (NvlinkSupportedBwModes, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetNvlinkSupportedBwModes", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetNvlinkSupportedBwModes(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvlinkSupportedBwModes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetOfaUtilization(device Device) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetOfaUtilization", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetOfaUtilization(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetP2PStatus(device1 Device, device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetP2PStatus", w.Interface, []any{device1, device2, p2pIndex}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetP2PStatus(unwrapDevice(device1), unwrapDevice(device2), p2pIndex)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuP2PStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPciInfo(device Device) (PciInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPciInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPciInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPciInfoExt(device Device) (PciInfoExt, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPciInfoExt", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPciInfoExt(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfoExt)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPcieLinkMaxSpeed(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPcieLinkMaxSpeed", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPcieLinkMaxSpeed(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPcieReplayCounter(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPcieReplayCounter", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPcieReplayCounter(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPcieSpeed(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPcieSpeed", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPcieSpeed(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPcieThroughput(device Device, counter PcieUtilCounter) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPcieThroughput", w.Interface, []any{device, counter}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPcieThroughput(unwrapDevice(device), counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPdi(device Device) (Pdi, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPdi", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPdi(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pdi)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPerformanceModes(device Device) (DevicePerfModes, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPerformanceModes", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPerformanceModes(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DevicePerfModes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPerformanceState(device Device) (Pstates, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPerformanceState", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPerformanceState(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPersistenceMode(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPersistenceMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPersistenceMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPgpuMetadataString(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPgpuMetadataString", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPgpuMetadataString(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPlatformInfo(device Device) (PlatformInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPlatformInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPlatformInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PlatformInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerManagementDefaultLimit(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerManagementDefaultLimit", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerManagementDefaultLimit(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerManagementLimit(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerManagementLimit", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerManagementLimit(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerManagementLimitConstraints(device Device) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerManagementLimitConstraints", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetPowerManagementLimitConstraints(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerManagementMode(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerManagementMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerManagementMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerMizerMode_v1(device Device) (DevicePowerMizerModes_v1, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerMizerMode_v1", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerMizerMode_v1(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DevicePowerMizerModes_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerSource(device Device) (PowerSource, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerSource", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerSource(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PowerSource)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerState(device Device) (Pstates, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerState", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerState(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetPowerUsage(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetPowerUsage", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetPowerUsage(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetProcessUtilization(device Device, lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetProcessUtilization", w.Interface, []any{device, lastSeenTimestamp}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessUtilizationSample)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetProcessesUtilizationInfo(device Device) (ProcessesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetProcessesUtilizationInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetProcessesUtilizationInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ProcessesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRemappedRows(device Device) (int, int, bool, bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRemappedRows", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2, r3, r4 := w.Interface.DeviceGetRemappedRows(unwrapDevice(device))
+		return []any{r0, r1, r2, r3, r4}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(bool)
+	r3, _ := results[3].(bool)
+	r4, _ := results[4].(Return)
+	return r0, r1, r2, r3, r4
+}
+
+func (w *interfaceMiddleware) DeviceGetRemappedRows_v2(device Device) (RemappedRowsInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRemappedRows_v2", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRemappedRows_v2(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RemappedRowsInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRepairStatus(device Device) (RepairStatus, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRepairStatus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRepairStatus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RepairStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRetiredPages(device Device, cause PageRetirementCause) ([]uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRetiredPages", w.Interface, []any{device, cause}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRetiredPages(unwrapDevice(device), cause)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRetiredPagesPendingStatus(device Device) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRetiredPagesPendingStatus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRetiredPagesPendingStatus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRetiredPages_v2(device Device, cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRetiredPages_v2", w.Interface, []any{device, cause}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetRetiredPages_v2(unwrapDevice(device), cause)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].([]uint64)
+	r1, _ := results[1].([]uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetRowRemapperHistogram(device Device) (RowRemapperHistogramValues, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRowRemapperHistogram", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRowRemapperHistogram(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RowRemapperHistogramValues)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetRunningProcessDetailList(device Device) (ProcessDetailList, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetRunningProcessDetailList", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetRunningProcessDetailList(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ProcessDetailList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSamples(device Device, samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSamples", w.Interface, []any{device, samplingType, lastSeenTimestamp}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetSamples(unwrapDevice(device), samplingType, lastSeenTimestamp)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(ValueType)
+	r1, _ := results[1].([]Sample)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetSerial(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSerial", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSerial(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSramEccErrorStatus(device Device) (EccSramErrorStatus, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSramEccErrorStatus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSramEccErrorStatus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EccSramErrorStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSramUniqueUncorrectedEccErrorCounts(device Device, errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSramUniqueUncorrectedEccErrorCounts", w.Interface, []any{device, errorCounts}}, func() []any {
+		r0 := w.Interface.DeviceGetSramUniqueUncorrectedEccErrorCounts(unwrapDevice(device), errorCounts)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedClocksEventReasons(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedClocksEventReasons", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSupportedClocksEventReasons(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedClocksThrottleReasons", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSupportedClocksThrottleReasons(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedEventTypes(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedEventTypes", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSupportedEventTypes(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedGraphicsClocks", w.Interface, []any{device, memoryClockMHz}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetSupportedGraphicsClocks(unwrapDevice(device), memoryClockMHz)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedMemoryClocks(device Device) (int, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedMemoryClocks", w.Interface, []any{device}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetSupportedMemoryClocks(unwrapDevice(device))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedPerformanceStates(device Device) ([]Pstates, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedPerformanceStates", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSupportedPerformanceStates(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetSupportedVgpus(device Device) ([]VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetSupportedVgpus", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetSupportedVgpus(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return wrapVgpuTypeIds(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTargetFanSpeed(device Device, fan int) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTargetFanSpeed", w.Interface, []any{device, fan}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTargetFanSpeed(unwrapDevice(device), fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTemperature(device Device, sensorType TemperatureSensors) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTemperature", w.Interface, []any{device, sensorType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTemperature(unwrapDevice(device), sensorType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTemperatureThreshold(device Device, thresholdType TemperatureThresholds) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTemperatureThreshold", w.Interface, []any{device, thresholdType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTemperatureThreshold(unwrapDevice(device), thresholdType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTemperatureV(device Device) TemperatureHandler {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTemperatureV", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceGetTemperatureV(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(TemperatureHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceGetThermalSettings(device Device, sensorIndex uint32) (GpuThermalSettings, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetThermalSettings", w.Interface, []any{device, sensorIndex}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetThermalSettings(unwrapDevice(device), sensorIndex)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuThermalSettings)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTopologyCommonAncestor(device1 Device, device2 Device) (GpuTopologyLevel, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTopologyCommonAncestor", w.Interface, []any{device1, device2}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTopologyCommonAncestor(unwrapDevice(device1), unwrapDevice(device2))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuTopologyLevel)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTopologyNearestGpus(device Device, level GpuTopologyLevel) ([]Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTopologyNearestGpus", w.Interface, []any{device, level}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTopologyNearestGpus(unwrapDevice(device), level)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Device)
+	r1, _ := results[1].(Return)
+	return wrapDevices(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTotalEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTotalEccErrors", w.Interface, []any{device, errorType, counterType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTotalEccErrors(unwrapDevice(device), errorType, counterType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetTotalEnergyConsumption(device Device) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetTotalEnergyConsumption", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetTotalEnergyConsumption(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetUUID(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetUUID", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetUUID(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetUnrepairableMemoryFlag_v1(device Device) (UnrepairableMemoryStatus_v1, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetUnrepairableMemoryFlag_v1", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetUnrepairableMemoryFlag_v1(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnrepairableMemoryStatus_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetUtilizationRates(device Device) (Utilization, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetUtilizationRates", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetUtilizationRates(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Utilization)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVbiosVersion(device Device) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVbiosVersion", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVbiosVersion(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuCapabilities(device Device, capability DeviceVgpuCapability) (bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuCapabilities", w.Interface, []any{device, capability}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuCapabilities(unwrapDevice(device), capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuHeterogeneousMode(device Device) (VgpuHeterogeneousMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuHeterogeneousMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuHeterogeneousMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuHeterogeneousMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuInstancesUtilizationInfo(device Device) (VgpuInstancesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuInstancesUtilizationInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuInstancesUtilizationInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuInstancesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuMetadata(device Device) (VgpuPgpuMetadata, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuMetadata", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuMetadata(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPgpuMetadata)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuProcessUtilization(device Device, lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuProcessUtilization", w.Interface, []any{device, lastSeenTimestamp}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuProcessUtilizationSample)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuProcessesUtilizationInfo(device Device) (VgpuProcessesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuProcessesUtilizationInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuProcessesUtilizationInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuProcessesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuSchedulerCapabilities(device Device) (VgpuSchedulerCapabilities, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuSchedulerCapabilities", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuSchedulerCapabilities(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerCapabilities)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuSchedulerLog(device Device) (VgpuSchedulerLog, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuSchedulerLog", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuSchedulerLog(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLog)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuSchedulerLog_v2(device Device, logInfo VgpuSchedulerLogInfo_v2) (VgpuSchedulerLogInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuSchedulerLog_v2", w.Interface, []any{device, logInfo}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuSchedulerLog_v2(unwrapDevice(device), logInfo)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuSchedulerState(device Device) (VgpuSchedulerGetState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuSchedulerState", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuSchedulerState(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerGetState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuSchedulerState_v2(device Device, info VgpuSchedulerStateInfo_v2) (VgpuSchedulerStateInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuSchedulerState_v2", w.Interface, []any{device, info}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuSchedulerState_v2(unwrapDevice(device), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuTypeCreatablePlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuTypeCreatablePlacements", w.Interface, []any{device, vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuTypeCreatablePlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuTypeSupportedPlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuTypeSupportedPlacements", w.Interface, []any{device, vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVgpuTypeSupportedPlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVgpuUtilization", w.Interface, []any{device, lastSeenTimestamp}}, func() []any {
+		r0, r1, r2 := w.Interface.DeviceGetVgpuUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(ValueType)
+	r1, _ := results[1].([]VgpuInstanceUtilizationSample)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) DeviceGetViolationStatus(device Device, perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetViolationStatus", w.Interface, []any{device, perfPolicyType}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetViolationStatus(unwrapDevice(device), perfPolicyType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ViolationTime)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceGetVirtualizationMode(device Device) (GpuVirtualizationMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceGetVirtualizationMode", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceGetVirtualizationMode(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuVirtualizationMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceIsMigDeviceHandle(device Device) (bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceIsMigDeviceHandle", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceIsMigDeviceHandle(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceModifyDrainState(pciInfo *PciInfo, newState EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceModifyDrainState", w.Interface, []any{pciInfo, newState}}, func() []any {
+		r0 := w.Interface.DeviceModifyDrainState(pciInfo, newState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceOnSameBoard(device1 Device, device2 Device) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceOnSameBoard", w.Interface, []any{device1, device2}}, func() []any {
+		r0, r1 := w.Interface.DeviceOnSameBoard(unwrapDevice(device1), unwrapDevice(device2))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DevicePowerSmoothingActivatePresetProfile(device Device, profile *PowerSmoothingProfile) Return {
+	results := w.middleware(CallInfo{"Interface", "DevicePowerSmoothingActivatePresetProfile", w.Interface, []any{device, profile}}, func() []any {
+		r0 := w.Interface.DevicePowerSmoothingActivatePresetProfile(unwrapDevice(device), profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DevicePowerSmoothingSetState(device Device, state *PowerSmoothingState) Return {
+	results := w.middleware(CallInfo{"Interface", "DevicePowerSmoothingSetState", w.Interface, []any{device, state}}, func() []any {
+		r0 := w.Interface.DevicePowerSmoothingSetState(unwrapDevice(device), state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DevicePowerSmoothingUpdatePresetProfileParam(device Device, profile *PowerSmoothingProfile) Return {
+	results := w.middleware(CallInfo{"Interface", "DevicePowerSmoothingUpdatePresetProfileParam", w.Interface, []any{device, profile}}, func() []any {
+		r0 := w.Interface.DevicePowerSmoothingUpdatePresetProfileParam(unwrapDevice(device), profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceQueryDrainState(pciInfo *PciInfo) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceQueryDrainState", w.Interface, []any{pciInfo}}, func() []any {
+		r0, r1 := w.Interface.DeviceQueryDrainState(pciInfo)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceReadPRMCounters_v1(device Device, prmCounters []PRMCounterId, localPort int) ([]PRMCounter_v1, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceReadPRMCounters_v1", w.Interface, []any{device, prmCounters, localPort}}, func() []any {
+		r0, r1 := w.Interface.DeviceReadPRMCounters_v1(unwrapDevice(device), prmCounters, localPort)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]PRMCounter_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceReadWritePRM_v1(device Device, buffer *PRMTLV_v1) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceReadWritePRM_v1", w.Interface, []any{device, buffer}}, func() []any {
+		r0 := w.Interface.DeviceReadWritePRM_v1(unwrapDevice(device), buffer)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceRegisterEvents(device Device, eventTypes uint64, set EventSet) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceRegisterEvents", w.Interface, []any{device, eventTypes, set}}, func() []any {
+		r0 := w.Interface.DeviceRegisterEvents(unwrapDevice(device), eventTypes, unwrapEventSet(set))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceRemoveGpu(pciInfo *PciInfo) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceRemoveGpu", w.Interface, []any{pciInfo}}, func() []any {
+		r0 := w.Interface.DeviceRemoveGpu(pciInfo)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceRemoveGpu_v2(pciInfo *PciInfo, gpuState DetachGpuState, linkState PcieLinkState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceRemoveGpu_v2", w.Interface, []any{pciInfo, gpuState, linkState}}, func() []any {
+		r0 := w.Interface.DeviceRemoveGpu_v2(pciInfo, gpuState, linkState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceResetApplicationsClocks(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceResetApplicationsClocks", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceResetApplicationsClocks(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceResetGpuLockedClocks(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceResetGpuLockedClocks", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceResetGpuLockedClocks(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceResetMemoryLockedClocks(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceResetMemoryLockedClocks", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceResetMemoryLockedClocks(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceResetNvLinkErrorCounters(device Device, link int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceResetNvLinkErrorCounters", w.Interface, []any{device, link}}, func() []any {
+		r0 := w.Interface.DeviceResetNvLinkErrorCounters(unwrapDevice(device), link)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceResetNvLinkUtilizationCounter(device Device, link int, counter int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceResetNvLinkUtilizationCounter", w.Interface, []any{device, link, counter}}, func() []any {
+		r0 := w.Interface.DeviceResetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetAPIRestriction(device Device, apiType RestrictedAPI, isRestricted EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetAPIRestriction", w.Interface, []any{device, apiType, isRestricted}}, func() []any {
+		r0 := w.Interface.DeviceSetAPIRestriction(unwrapDevice(device), apiType, isRestricted)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetAccountingMode(device Device, mode EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetAccountingMode", w.Interface, []any{device, mode}}, func() []any {
+		r0 := w.Interface.DeviceSetAccountingMode(unwrapDevice(device), mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetApplicationsClocks(device Device, memClockMHz uint32, graphicsClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetApplicationsClocks", w.Interface, []any{device, memClockMHz, graphicsClockMHz}}, func() []any {
+		r0 := w.Interface.DeviceSetApplicationsClocks(unwrapDevice(device), memClockMHz, graphicsClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetAutoBoostedClocksEnabled(device Device, enabled EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetAutoBoostedClocksEnabled", w.Interface, []any{device, enabled}}, func() []any {
+		r0 := w.Interface.DeviceSetAutoBoostedClocksEnabled(unwrapDevice(device), enabled)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetClockOffsets(device Device, info ClockOffset) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetClockOffsets", w.Interface, []any{device, info}}, func() []any {
+		r0 := w.Interface.DeviceSetClockOffsets(unwrapDevice(device), info)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetComputeMode(device Device, mode ComputeMode) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetComputeMode", w.Interface, []any{device, mode}}, func() []any {
+		r0 := w.Interface.DeviceSetComputeMode(unwrapDevice(device), mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetConfComputeUnprotectedMemSize(device Device, sizeKiB uint64) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetConfComputeUnprotectedMemSize", w.Interface, []any{device, sizeKiB}}, func() []any {
+		r0 := w.Interface.DeviceSetConfComputeUnprotectedMemSize(unwrapDevice(device), sizeKiB)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetCpuAffinity(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetCpuAffinity", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceSetCpuAffinity(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetDefaultAutoBoostedClocksEnabled(device Device, enabled EnableState, flags uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetDefaultAutoBoostedClocksEnabled", w.Interface, []any{device, enabled, flags}}, func() []any {
+		r0 := w.Interface.DeviceSetDefaultAutoBoostedClocksEnabled(unwrapDevice(device), enabled, flags)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetDefaultFanSpeed_v2(device Device, fan int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetDefaultFanSpeed_v2", w.Interface, []any{device, fan}}, func() []any {
+		r0 := w.Interface.DeviceSetDefaultFanSpeed_v2(unwrapDevice(device), fan)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetDramEncryptionMode(device Device, dramEncryption *DramEncryptionInfo) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetDramEncryptionMode", w.Interface, []any{device, dramEncryption}}, func() []any {
+		r0 := w.Interface.DeviceSetDramEncryptionMode(unwrapDevice(device), dramEncryption)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetDriverModel(device Device, driverModel DriverModel, flags uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetDriverModel", w.Interface, []any{device, driverModel, flags}}, func() []any {
+		r0 := w.Interface.DeviceSetDriverModel(unwrapDevice(device), driverModel, flags)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetEccMode(device Device, ecc EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetEccMode", w.Interface, []any{device, ecc}}, func() []any {
+		r0 := w.Interface.DeviceSetEccMode(unwrapDevice(device), ecc)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetFanControlPolicy(device Device, fan int, policy FanControlPolicy) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetFanControlPolicy", w.Interface, []any{device, fan, policy}}, func() []any {
+		r0 := w.Interface.DeviceSetFanControlPolicy(unwrapDevice(device), fan, policy)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetFanSpeed_v2(device Device, fan int, speed int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetFanSpeed_v2", w.Interface, []any{device, fan, speed}}, func() []any {
+		r0 := w.Interface.DeviceSetFanSpeed_v2(unwrapDevice(device), fan, speed)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetGpcClkVfOffset(device Device, offset int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetGpcClkVfOffset", w.Interface, []any{device, offset}}, func() []any {
+		r0 := w.Interface.DeviceSetGpcClkVfOffset(unwrapDevice(device), offset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetGpuLockedClocks", w.Interface, []any{device, minGpuClockMHz, maxGpuClockMHz}}, func() []any {
+		r0 := w.Interface.DeviceSetGpuLockedClocks(unwrapDevice(device), minGpuClockMHz, maxGpuClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetGpuOperationMode(device Device, mode GpuOperationMode) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetGpuOperationMode", w.Interface, []any{device, mode}}, func() []any {
+		r0 := w.Interface.DeviceSetGpuOperationMode(unwrapDevice(device), mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetHostname_v1(device Device, hostName string) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetHostname_v1", w.Interface, []any{device, hostName}}, func() []any {
+		r0 := w.Interface.DeviceSetHostname_v1(unwrapDevice(device), hostName)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetMemClkVfOffset(device Device, offset int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetMemClkVfOffset", w.Interface, []any{device, offset}}, func() []any {
+		r0 := w.Interface.DeviceSetMemClkVfOffset(unwrapDevice(device), offset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetMemoryLockedClocks(device Device, minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetMemoryLockedClocks", w.Interface, []any{device, minMemClockMHz, maxMemClockMHz}}, func() []any {
+		r0 := w.Interface.DeviceSetMemoryLockedClocks(unwrapDevice(device), minMemClockMHz, maxMemClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetMigMode(device Device, mode int) (Return, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetMigMode", w.Interface, []any{device, mode}}, func() []any {
+		r0, r1 := w.Interface.DeviceSetMigMode(unwrapDevice(device), mode)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Return)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceSetNvLinkDeviceLowPowerThreshold(device Device, info *NvLinkPowerThres) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetNvLinkDeviceLowPowerThreshold", w.Interface, []any{device, info}}, func() []any {
+		r0 := w.Interface.DeviceSetNvLinkDeviceLowPowerThreshold(unwrapDevice(device), info)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetNvLinkUtilizationControl(device Device, link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetNvLinkUtilizationControl", w.Interface, []any{device, link, counter, control, reset}}, func() []any {
+		r0 := w.Interface.DeviceSetNvLinkUtilizationControl(unwrapDevice(device), link, counter, control, reset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetNvlinkBwMode(device Device, setBwMode *NvlinkSetBwMode) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetNvlinkBwMode", w.Interface, []any{device, setBwMode}}, func() []any {
+		r0 := w.Interface.DeviceSetNvlinkBwMode(unwrapDevice(device), setBwMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetPersistenceMode(device Device, mode EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetPersistenceMode", w.Interface, []any{device, mode}}, func() []any {
+		r0 := w.Interface.DeviceSetPersistenceMode(unwrapDevice(device), mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetPowerManagementLimit(device Device, limit uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetPowerManagementLimit", w.Interface, []any{device, limit}}, func() []any {
+		r0 := w.Interface.DeviceSetPowerManagementLimit(unwrapDevice(device), limit)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetPowerManagementLimit_v2(device Device, powerValue *PowerValue_v2) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetPowerManagementLimit_v2", w.Interface, []any{device, powerValue}}, func() []any {
+		r0 := w.Interface.DeviceSetPowerManagementLimit_v2(unwrapDevice(device), powerValue)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetRusdSettings_v1(device Device, settings RusdSettings_v1) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetRusdSettings_v1", w.Interface, []any{device, settings}}, func() []any {
+		r0 := w.Interface.DeviceSetRusdSettings_v1(unwrapDevice(device), settings)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetTemperatureThreshold(device Device, thresholdType TemperatureThresholds, temp int) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetTemperatureThreshold", w.Interface, []any{device, thresholdType, temp}}, func() []any {
+		r0 := w.Interface.DeviceSetTemperatureThreshold(unwrapDevice(device), thresholdType, temp)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetVgpuCapabilities(device Device, capability DeviceVgpuCapability, state EnableState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetVgpuCapabilities", w.Interface, []any{device, capability, state}}, func() []any {
+		r0 := w.Interface.DeviceSetVgpuCapabilities(unwrapDevice(device), capability, state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetVgpuHeterogeneousMode(device Device, heterogeneousMode VgpuHeterogeneousMode) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetVgpuHeterogeneousMode", w.Interface, []any{device, heterogeneousMode}}, func() []any {
+		r0 := w.Interface.DeviceSetVgpuHeterogeneousMode(unwrapDevice(device), heterogeneousMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetVgpuSchedulerState(device Device, pSchedulerState *VgpuSchedulerSetState) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetVgpuSchedulerState", w.Interface, []any{device, pSchedulerState}}, func() []any {
+		r0 := w.Interface.DeviceSetVgpuSchedulerState(unwrapDevice(device), pSchedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetVgpuSchedulerState_v2(device Device, pSchedulerState *VgpuSchedulerState_v2) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetVgpuSchedulerState_v2", w.Interface, []any{device, pSchedulerState}}, func() []any {
+		r0 := w.Interface.DeviceSetVgpuSchedulerState_v2(unwrapDevice(device), pSchedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceSetVirtualizationMode(device Device, virtualMode GpuVirtualizationMode) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceSetVirtualizationMode", w.Interface, []any{device, virtualMode}}, func() []any {
+		r0 := w.Interface.DeviceSetVirtualizationMode(unwrapDevice(device), virtualMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceValidateInforom(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceValidateInforom", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceValidateInforom(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceVgpuForceGspUnload(device Device) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceVgpuForceGspUnload", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.DeviceVgpuForceGspUnload(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceWorkloadPowerProfileClearRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceWorkloadPowerProfileClearRequestedProfiles", w.Interface, []any{device, requestedProfiles}}, func() []any {
+		r0 := w.Interface.DeviceWorkloadPowerProfileClearRequestedProfiles(unwrapDevice(device), requestedProfiles)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (WorkloadPowerProfileCurrentProfiles, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceWorkloadPowerProfileGetCurrentProfiles", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceWorkloadPowerProfileGetCurrentProfiles(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(WorkloadPowerProfileCurrentProfiles)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (WorkloadPowerProfileProfilesInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "DeviceWorkloadPowerProfileGetProfilesInfo", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.DeviceWorkloadPowerProfileGetProfilesInfo(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(WorkloadPowerProfileProfilesInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) DeviceWorkloadPowerProfileSetRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceWorkloadPowerProfileSetRequestedProfiles", w.Interface, []any{device, requestedProfiles}}, func() []any {
+		r0 := w.Interface.DeviceWorkloadPowerProfileSetRequestedProfiles(unwrapDevice(device), requestedProfiles)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) DeviceWorkloadPowerProfileUpdateProfiles_v1(device Device, operation PowerProfileOperation, profileTypes []PowerProfileType) Return {
+	results := w.middleware(CallInfo{"Interface", "DeviceWorkloadPowerProfileUpdateProfiles_v1", w.Interface, []any{device, operation, profileTypes}}, func() []any {
+		r0 := w.Interface.DeviceWorkloadPowerProfileUpdateProfiles_v1(unwrapDevice(device), operation, profileTypes)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) ErrorString(r Return) string {
+	results := w.middleware(CallInfo{"Interface", "ErrorString", w.Interface, []any{r}}, func() []any {
+		r0 := w.Interface.ErrorString(r)
+		return []any{r0}
+	})
+	r0, _ := results[0].(string)
+	return r0
+}
+
+func (w *interfaceMiddleware) EventSetCreate() (EventSet, Return) {
+	results := w.middleware(CallInfo{"Interface", "EventSetCreate", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.EventSetCreate()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EventSet)
+	r1, _ := results[1].(Return)
+	return WrapEventSet(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) EventSetFree(set EventSet) Return {
+	results := w.middleware(CallInfo{"Interface", "EventSetFree", w.Interface, []any{set}}, func() []any {
+		r0 := w.Interface.EventSetFree(unwrapEventSet(set))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) EventSetWait(set EventSet, timeoutms uint32) (EventData, Return) {
+	results := w.middleware(CallInfo{"Interface", "EventSetWait", w.Interface, []any{set, timeoutms}}, func() []any {
+		r0, r1 := w.Interface.EventSetWait(unwrapEventSet(set), timeoutms)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EventData)
+	r1, _ := results[1].(Return)
+	return wrapEventData(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) Extensions() ExtendedInterface {
+	results := w.middleware(CallInfo{"Interface", "Extensions", w.Interface, []any{}}, func() []any {
+		r0 := w.Interface.Extensions()
+		return []any{r0}
+	})
+	r0, _ := results[0].(ExtendedInterface)
+	return r0
+}
+
+func (w *interfaceMiddleware) GetExcludedDeviceCount() (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "GetExcludedDeviceCount", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.GetExcludedDeviceCount()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GetExcludedDeviceInfoByIndex(index int) (ExcludedDeviceInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GetExcludedDeviceInfoByIndex", w.Interface, []any{index}}, func() []any {
+		r0, r1 := w.Interface.GetExcludedDeviceInfoByIndex(index)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ExcludedDeviceInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GetVgpuCompatibility(vgpuMetadata *VgpuMetadata, pgpuMetadata *VgpuPgpuMetadata) (VgpuPgpuCompatibility, Return) {
+	results := w.middleware(CallInfo{"Interface", "GetVgpuCompatibility", w.Interface, []any{vgpuMetadata, pgpuMetadata}}, func() []any {
+		r0, r1 := w.Interface.GetVgpuCompatibility(vgpuMetadata, pgpuMetadata)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPgpuCompatibility)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GetVgpuDriverCapabilities(capability VgpuDriverCapability) (bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "GetVgpuDriverCapabilities", w.Interface, []any{capability}}, func() []any {
+		r0, r1 := w.Interface.GetVgpuDriverCapabilities(capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GetVgpuVersion() (VgpuVersion, VgpuVersion, Return) {
+	results := w.middleware(CallInfo{"Interface", "GetVgpuVersion", w.Interface, []any{}}, func() []any {
+		r0, r1, r2 := w.Interface.GetVgpuVersion()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(VgpuVersion)
+	r1, _ := results[1].(VgpuVersion)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) GpmMetricsGet(metricsGet *GpmMetricsGetType) Return {
+	results := w.middleware(CallInfo{"Interface", "GpmMetricsGet", w.Interface, []any{metricsGet}}, func() []any {
+		r0 := w.Interface.GpmMetricsGet(metricsGet)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmMetricsGetV(metricsGet *GpmMetricsGetType) GpmMetricsGetVType {
+	results := w.middleware(CallInfo{"Interface", "GpmMetricsGetV", w.Interface, []any{metricsGet}}, func() []any {
+		r0 := w.Interface.GpmMetricsGetV(metricsGet)
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpmMetricsGetVType)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmMigSampleGet(device Device, gpuInstanceId int, gpmSample GpmSample) Return {
+	results := w.middleware(CallInfo{"Interface", "GpmMigSampleGet", w.Interface, []any{device, gpuInstanceId, gpmSample}}, func() []any {
+		r0 := w.Interface.GpmMigSampleGet(unwrapDevice(device), gpuInstanceId, unwrapGpmSample(gpmSample))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmQueryDeviceSupport(device Device) (GpmSupport, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpmQueryDeviceSupport", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.GpmQueryDeviceSupport(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpmSupport)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpmQueryDeviceSupportV(device Device) GpmSupportV {
+	results := w.middleware(CallInfo{"Interface", "GpmQueryDeviceSupportV", w.Interface, []any{device}}, func() []any {
+		r0 := w.Interface.GpmQueryDeviceSupportV(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpmSupportV)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmQueryIfStreamingEnabled(device Device) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpmQueryIfStreamingEnabled", w.Interface, []any{device}}, func() []any {
+		r0, r1 := w.Interface.GpmQueryIfStreamingEnabled(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpmSampleAlloc() (GpmSample, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpmSampleAlloc", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.GpmSampleAlloc()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpmSample)
+	r1, _ := results[1].(Return)
+	return WrapGpmSample(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpmSampleFree(gpmSample GpmSample) Return {
+	results := w.middleware(CallInfo{"Interface", "GpmSampleFree", w.Interface, []any{gpmSample}}, func() []any {
+		r0 := w.Interface.GpmSampleFree(unwrapGpmSample(gpmSample))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmSampleGet(device Device, gpmSample GpmSample) Return {
+	results := w.middleware(CallInfo{"Interface", "GpmSampleGet", w.Interface, []any{device, gpmSample}}, func() []any {
+		r0 := w.Interface.GpmSampleGet(unwrapDevice(device), unwrapGpmSample(gpmSample))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpmSetStreamingEnabled(device Device, state uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "GpmSetStreamingEnabled", w.Interface, []any{device, state}}, func() []any {
+		r0 := w.Interface.GpmSetStreamingEnabled(unwrapDevice(device), state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpuInstanceCreateComputeInstance(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceCreateComputeInstance", w.Interface, []any{gpuInstance, info}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceCreateComputeInstance(unwrapGpuInstance(gpuInstance), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo, placement *ComputeInstancePlacement) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceCreateComputeInstanceWithPlacement", w.Interface, []any{gpuInstance, info, placement}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceCreateComputeInstanceWithPlacement(unwrapGpuInstance(gpuInstance), info, placement)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceDestroy(gpuInstance GpuInstance) Return {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceDestroy", w.Interface, []any{gpuInstance}}, func() []any {
+		r0 := w.Interface.GpuInstanceDestroy(unwrapGpuInstance(gpuInstance))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (ActiveVgpuInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetActiveVgpus", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetActiveVgpus(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ActiveVgpuInstanceInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstanceById", w.Interface, []any{gpuInstance, id}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetComputeInstanceById(unwrapGpuInstance(gpuInstance), id)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstancePossiblePlacements", w.Interface, []any{gpuInstance, info}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetComputeInstancePossiblePlacements(unwrapGpuInstance(gpuInstance), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ComputeInstancePlacement)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstance, profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstanceProfileInfo", w.Interface, []any{gpuInstance, profile, engProfile}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetComputeInstanceProfileInfo(unwrapGpuInstance(gpuInstance), profile, engProfile)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstanceProfileInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) ComputeInstanceProfileInfoHandler {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstanceProfileInfoV", w.Interface, []any{gpuInstance, profile, engProfile}}, func() []any {
+		r0 := w.Interface.GpuInstanceGetComputeInstanceProfileInfoV(unwrapGpuInstance(gpuInstance), profile, engProfile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(ComputeInstanceProfileInfoHandler)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstanceRemainingCapacity", w.Interface, []any{gpuInstance, info}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetComputeInstanceRemainingCapacity(unwrapGpuInstance(gpuInstance), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetComputeInstances(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetComputeInstances", w.Interface, []any{gpuInstance, info}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetComputeInstances(unwrapGpuInstance(gpuInstance), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ComputeInstance)
+	r1, _ := results[1].(Return)
+	return wrapComputeInstances(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (VgpuTypeIdInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetCreatableVgpus", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetCreatableVgpus(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeIdInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetInfo(gpuInstance GpuInstance) (GpuInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetInfo", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetInfo(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstanceInfo)
+	r1, _ := results[1].(Return)
+	return wrapGpuInstanceInfo(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (VgpuHeterogeneousMode, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuHeterogeneousMode", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuHeterogeneousMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (VgpuSchedulerLogInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuSchedulerLog", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuSchedulerLog(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuSchedulerLog_v2(gpuInstance GpuInstance, logInfo VgpuSchedulerLogInfo_v2) (VgpuSchedulerLogInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuSchedulerLog_v2", w.Interface, []any{gpuInstance, logInfo}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuSchedulerLog_v2(unwrapGpuInstance(gpuInstance), logInfo)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (VgpuSchedulerStateInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuSchedulerState", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuSchedulerState(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuSchedulerState_v2(gpuInstance GpuInstance, info VgpuSchedulerStateInfo_v2) (VgpuSchedulerStateInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuSchedulerState_v2", w.Interface, []any{gpuInstance, info}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuSchedulerState_v2(unwrapGpuInstance(gpuInstance), info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInstance) (VgpuCreatablePlacementInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceGetVgpuTypeCreatablePlacements", w.Interface, []any{gpuInstance}}, func() []any {
+		r0, r1 := w.Interface.GpuInstanceGetVgpuTypeCreatablePlacements(unwrapGpuInstance(gpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuCreatablePlacementInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) GpuInstanceSetVgpuHeterogeneousMode(gpuInstance GpuInstance, heterogeneousMode *VgpuHeterogeneousMode) Return {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceSetVgpuHeterogeneousMode", w.Interface, []any{gpuInstance, heterogeneousMode}}, func() []any {
+		r0 := w.Interface.GpuInstanceSetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance), heterogeneousMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpuInstanceSetVgpuSchedulerState(gpuInstance GpuInstance, scheduler *VgpuSchedulerState) Return {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceSetVgpuSchedulerState", w.Interface, []any{gpuInstance, scheduler}}, func() []any {
+		r0 := w.Interface.GpuInstanceSetVgpuSchedulerState(unwrapGpuInstance(gpuInstance), scheduler)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) GpuInstanceSetVgpuSchedulerState_v2(gpuInstance GpuInstance, schedulerState *VgpuSchedulerState_v2) Return {
+	results := w.middleware(CallInfo{"Interface", "GpuInstanceSetVgpuSchedulerState_v2", w.Interface, []any{gpuInstance, schedulerState}}, func() []any {
+		r0 := w.Interface.GpuInstanceSetVgpuSchedulerState_v2(unwrapGpuInstance(gpuInstance), schedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) Init() Return {
+	results := w.middleware(CallInfo{"Interface", "Init", w.Interface, []any{}}, func() []any {
+		r0 := w.Interface.Init()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) InitWithFlags(flags uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "InitWithFlags", w.Interface, []any{flags}}, func() []any {
+		r0 := w.Interface.InitWithFlags(flags)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SetVgpuVersion(vgpuVersion *VgpuVersion) Return {
+	results := w.middleware(CallInfo{"Interface", "SetVgpuVersion", w.Interface, []any{vgpuVersion}}, func() []any {
+		r0 := w.Interface.SetVgpuVersion(vgpuVersion)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) Shutdown() Return {
+	results := w.middleware(CallInfo{"Interface", "Shutdown", w.Interface, []any{}}, func() []any {
+		r0 := w.Interface.Shutdown()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemEventSetCreate(request *SystemEventSetCreateRequest) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemEventSetCreate", w.Interface, []any{request}}, func() []any {
+		r0 := w.Interface.SystemEventSetCreate(request)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemEventSetFree(request *SystemEventSetFreeRequest) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemEventSetFree", w.Interface, []any{request}}, func() []any {
+		r0 := w.Interface.SystemEventSetFree(request)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemEventSetWait(request *SystemEventSetWaitRequest) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemEventSetWait", w.Interface, []any{request}}, func() []any {
+		r0 := w.Interface.SystemEventSetWait(request)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemGetCPER_v1(cper *GetCPER_v1) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemGetCPER_v1", w.Interface, []any{cper}}, func() []any {
+		r0 := w.Interface.SystemGetCPER_v1(cper)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemGetConfComputeCapabilities() (ConfComputeSystemCaps, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetConfComputeCapabilities", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetConfComputeCapabilities()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeSystemCaps)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetConfComputeGpusReadyState() (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetConfComputeGpusReadyState", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetConfComputeGpusReadyState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetConfComputeKeyRotationThresholdInfo", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetConfComputeKeyRotationThresholdInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeGetKeyRotationThresholdInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetConfComputeSettings() (SystemConfComputeSettings, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetConfComputeSettings", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetConfComputeSettings()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(SystemConfComputeSettings)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetConfComputeState() (ConfComputeSystemState, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetConfComputeState", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetConfComputeState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeSystemState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetCudaDriverVersion() (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetCudaDriverVersion", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetCudaDriverVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetCudaDriverVersion_v2() (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetCudaDriverVersion_v2", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetCudaDriverVersion_v2()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetDriverBranch() (SystemDriverBranchInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetDriverBranch", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetDriverBranch()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(SystemDriverBranchInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetDriverVersion() (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetDriverVersion", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetDriverVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetHicVersion() ([]HwbcEntry, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetHicVersion", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetHicVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]HwbcEntry)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetNVMLVersion() (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetNVMLVersion", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetNVMLVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetNvlinkBwMode() (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetNvlinkBwMode", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.SystemGetNvlinkBwMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetProcessName(pid int) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetProcessName", w.Interface, []any{pid}}, func() []any {
+		r0, r1 := w.Interface.SystemGetProcessName(pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "SystemGetTopologyGpuSet", w.Interface, []any{cpuNumber}}, func() []any {
+		r0, r1 := w.Interface.SystemGetTopologyGpuSet(cpuNumber)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Device)
+	r1, _ := results[1].(Return)
+	return wrapDevices(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) SystemRegisterEvents(request *SystemRegisterEventRequest) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemRegisterEvents", w.Interface, []any{request}}, func() []any {
+		r0 := w.Interface.SystemRegisterEvents(request)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemSetConfComputeGpusReadyState", w.Interface, []any{isAcceptingWork}}, func() []any {
+		r0 := w.Interface.SystemSetConfComputeGpusReadyState(isAcceptingWork)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo ConfComputeSetKeyRotationThresholdInfo) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemSetConfComputeKeyRotationThresholdInfo", w.Interface, []any{keyRotationThresholdInfo}}, func() []any {
+		r0 := w.Interface.SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) SystemSetNvlinkBwMode(nvlinkBwMode uint32) Return {
+	results := w.middleware(CallInfo{"Interface", "SystemSetNvlinkBwMode", w.Interface, []any{nvlinkBwMode}}, func() []any {
+		r0 := w.Interface.SystemSetNvlinkBwMode(nvlinkBwMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) UnitGetCount() (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetCount", w.Interface, []any{}}, func() []any {
+		r0, r1 := w.Interface.UnitGetCount()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitGetDevices(unit Unit) ([]Device, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetDevices", w.Interface, []any{unit}}, func() []any {
+		r0, r1 := w.Interface.UnitGetDevices(unwrapUnit(unit))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Device)
+	r1, _ := results[1].(Return)
+	return wrapDevices(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) UnitGetFanSpeedInfo(unit Unit) (UnitFanSpeeds, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetFanSpeedInfo", w.Interface, []any{unit}}, func() []any {
+		r0, r1 := w.Interface.UnitGetFanSpeedInfo(unwrapUnit(unit))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnitFanSpeeds)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitGetHandleByIndex(index int) (Unit, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetHandleByIndex", w.Interface, []any{index}}, func() []any {
+		r0, r1 := w.Interface.UnitGetHandleByIndex(index)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Unit)
+	r1, _ := results[1].(Return)
+	return WrapUnit(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) UnitGetLedState(unit Unit) (LedState, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetLedState", w.Interface, []any{unit}}, func() []any {
+		r0, r1 := w.Interface.UnitGetLedState(unwrapUnit(unit))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(LedState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitGetPsuInfo(unit Unit) (PSUInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetPsuInfo", w.Interface, []any{unit}}, func() []any {
+		r0, r1 := w.Interface.UnitGetPsuInfo(unwrapUnit(unit))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PSUInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitGetTemperature(unit Unit, ttype int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetTemperature", w.Interface, []any{unit, ttype}}, func() []any {
+		r0, r1 := w.Interface.UnitGetTemperature(unwrapUnit(unit), ttype)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitGetUnitInfo(unit Unit) (UnitInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "UnitGetUnitInfo", w.Interface, []any{unit}}, func() []any {
+		r0, r1 := w.Interface.UnitGetUnitInfo(unwrapUnit(unit))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnitInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) UnitSetLedState(unit Unit, color LedColor) Return {
+	results := w.middleware(CallInfo{"Interface", "UnitSetLedState", w.Interface, []any{unit, color}}, func() []any {
+		r0 := w.Interface.UnitSetLedState(unwrapUnit(unit), color)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) VgpuInstanceClearAccountingPids(vgpuInstance VgpuInstance) Return {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceClearAccountingPids", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0 := w.Interface.VgpuInstanceClearAccountingPids(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetAccountingMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetAccountingMode", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetAccountingMode(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetAccountingPids", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetAccountingPids(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (AccountingStats, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetAccountingStats", w.Interface, []any{vgpuInstance, pid}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetAccountingStats(unwrapVgpuInstance(vgpuInstance), pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetEccMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetEccMode", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetEccMode(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetEncoderCapacity(vgpuInstance VgpuInstance) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetEncoderCapacity", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetEncoderCapacity(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetEncoderSessions(vgpuInstance VgpuInstance) (int, EncoderSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetEncoderSessions", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1, r2 := w.Interface.VgpuInstanceGetEncoderSessions(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(EncoderSessionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetEncoderStats(vgpuInstance VgpuInstance) (int, uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetEncoderStats", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1, r2, r3 := w.Interface.VgpuInstanceGetEncoderStats(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1, r2, r3}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(uint32)
+	r3, _ := results[3].(Return)
+	return r0, r1, r2, r3
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetFBCSessions(vgpuInstance VgpuInstance) (int, FBCSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetFBCSessions", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1, r2 := w.Interface.VgpuInstanceGetFBCSessions(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(FBCSessionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetFBCStats(vgpuInstance VgpuInstance) (FBCStats, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetFBCStats", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetFBCStats(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FBCStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetFbUsage(vgpuInstance VgpuInstance) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetFbUsage", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetFbUsage(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetFrameRateLimit(vgpuInstance VgpuInstance) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetFrameRateLimit", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetFrameRateLimit(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetGpuInstanceId(vgpuInstance VgpuInstance) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetGpuInstanceId", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetGpuInstanceId(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetGpuPciId(vgpuInstance VgpuInstance) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetGpuPciId", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetGpuPciId(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (VgpuLicenseInfo, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetLicenseInfo", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetLicenseInfo(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuLicenseInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetLicenseStatus(vgpuInstance VgpuInstance) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetLicenseStatus", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetLicenseStatus(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetMdevUUID(vgpuInstance VgpuInstance) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetMdevUUID", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetMdevUUID(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetMetadata(vgpuInstance VgpuInstance) (VgpuMetadata, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetMetadata", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetMetadata(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuMetadata)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (VgpuRuntimeState, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetRuntimeStateSize", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetRuntimeStateSize(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuRuntimeState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetType(vgpuInstance VgpuInstance) (VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetType", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetType(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return WrapVgpuTypeId(r0, w.middleware), r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetUUID(vgpuInstance VgpuInstance) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetUUID", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetUUID(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetVmDriverVersion(vgpuInstance VgpuInstance) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetVmDriverVersion", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1 := w.Interface.VgpuInstanceGetVmDriverVersion(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, VgpuVmIdType, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceGetVmID", w.Interface, []any{vgpuInstance}}, func() []any {
+		r0, r1, r2 := w.Interface.VgpuInstanceGetVmID(unwrapVgpuInstance(vgpuInstance))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(VgpuVmIdType)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) VgpuInstanceSetEncoderCapacity(vgpuInstance VgpuInstance, encoderCapacity int) Return {
+	results := w.middleware(CallInfo{"Interface", "VgpuInstanceSetEncoderCapacity", w.Interface, []any{vgpuInstance, encoderCapacity}}, func() []any {
+		r0 := w.Interface.VgpuInstanceSetEncoderCapacity(unwrapVgpuInstance(vgpuInstance), encoderCapacity)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (VgpuTypeBar1Info, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetBAR1Info", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetBAR1Info(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeBar1Info)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetCapabilities(vgpuTypeId VgpuTypeId, capability VgpuCapability) (bool, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetCapabilities", w.Interface, []any{vgpuTypeId, capability}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetCapabilities(unwrapVgpuTypeId(vgpuTypeId), capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetClass(vgpuTypeId VgpuTypeId) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetClass", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetClass(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetDeviceID(vgpuTypeId VgpuTypeId) (uint64, uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetDeviceID", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1, r2 := w.Interface.VgpuTypeGetDeviceID(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetFrameRateLimit(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetFrameRateLimit", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetFrameRateLimit(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetFramebufferSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetFramebufferSize", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetFramebufferSize(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetGpuInstanceProfileId(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetGpuInstanceProfileId", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetGpuInstanceProfileId(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetLicense(vgpuTypeId VgpuTypeId) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetLicense", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetLicense(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetMaxInstances(device Device, vgpuTypeId VgpuTypeId) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetMaxInstances", w.Interface, []any{device, vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetMaxInstances(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *VgpuTypeMaxInstance) Return {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetMaxInstancesPerGpuInstance", w.Interface, []any{maxInstance}}, func() []any {
+		r0 := w.Interface.VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetMaxInstancesPerVm", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetMaxInstancesPerVm(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetName(vgpuTypeId VgpuTypeId) (string, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetName", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetName(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetNumDisplayHeads(vgpuTypeId VgpuTypeId) (int, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetNumDisplayHeads", w.Interface, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Interface.VgpuTypeGetNumDisplayHeads(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *interfaceMiddleware) VgpuTypeGetResolution(vgpuTypeId VgpuTypeId, displayIndex int) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Interface", "VgpuTypeGetResolution", w.Interface, []any{vgpuTypeId, displayIndex}}, func() []any {
+		r0, r1, r2 := w.Interface.VgpuTypeGetResolution(unwrapVgpuTypeId(vgpuTypeId), displayIndex)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+// deviceMiddleware passes the calls made to the wrapped Device through a Middleware.
+type deviceMiddleware struct {
+	Device
+	middleware Middleware
+}
+
+// WrapDevice returns a wrapper that passes every call made to wrapped through m.
+func WrapDevice(wrapped Device, m Middleware) Device {
+	if wrapped == nil {
+		return nil
+	}
+	return &deviceMiddleware{wrapped, m}
+}
+
+// unwrapDevice removes all layers of middleware from v.
+func unwrapDevice(v Device) Device {
+	for {
+		w, ok := v.(*deviceMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.Device
+	}
+}
+
+// wrapDevices wraps each Device of v with m.
+func wrapDevices(v []Device, m Middleware) []Device {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]Device, len(v))
+	for i := range v {
+		wrapped[i] = WrapDevice(v[i], m)
+	}
+	return wrapped
+}
+
+func (w *deviceMiddleware) ClearAccountingPids() Return {
+	results := w.middleware(CallInfo{"Device", "ClearAccountingPids", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ClearAccountingPids()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ClearCpuAffinity() Return {
+	results := w.middleware(CallInfo{"Device", "ClearCpuAffinity", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ClearCpuAffinity()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ClearEccErrorCounts(counterType EccCounterType) Return {
+	results := w.middleware(CallInfo{"Device", "ClearEccErrorCounts", w.Device, []any{counterType}}, func() []any {
+		r0 := w.Device.ClearEccErrorCounts(counterType)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ClearFieldValues(values []FieldValue) Return {
+	results := w.middleware(CallInfo{"Device", "ClearFieldValues", w.Device, []any{values}}, func() []any {
+		r0 := w.Device.ClearFieldValues(values)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) CreateGpuInstance(info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Device", "CreateGpuInstance", w.Device, []any{info}}, func() []any {
+		r0, r1 := w.Device.CreateGpuInstance(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) CreateGpuInstanceWithPlacement(info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Device", "CreateGpuInstanceWithPlacement", w.Device, []any{info, placement}}, func() []any {
+		r0, r1 := w.Device.CreateGpuInstanceWithPlacement(info, placement)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) FreezeNvLinkUtilizationCounter(link int, counter int, freeze EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "FreezeNvLinkUtilizationCounter", w.Device, []any{link, counter, freeze}}, func() []any {
+		r0 := w.Device.FreezeNvLinkUtilizationCounter(link, counter, freeze)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GetAPIRestriction(apiType RestrictedAPI) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAPIRestriction", w.Device, []any{apiType}}, func() []any {
+		r0, r1 := w.Device.GetAPIRestriction(apiType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAccountingBufferSize() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAccountingBufferSize", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAccountingBufferSize()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAccountingMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAccountingMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAccountingMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAccountingPids() ([]int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAccountingPids", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAccountingPids()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAccountingStats(pid uint32) (AccountingStats, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAccountingStats", w.Device, []any{pid}}, func() []any {
+		r0, r1 := w.Device.GetAccountingStats(pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAccountingStats_v2(pid uint32) (AccountingStats_v2, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAccountingStats_v2", w.Device, []any{pid}}, func() []any {
+		r0, r1 := w.Device.GetAccountingStats_v2(pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetActiveVgpus() ([]VgpuInstance, Return) {
+	results := w.middleware(CallInfo{"Device", "GetActiveVgpus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetActiveVgpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuInstance)
+	r1, _ := results[1].(Return)
+	return wrapVgpuInstances(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetAdaptiveClockInfoStatus() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAdaptiveClockInfoStatus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAdaptiveClockInfoStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAddressingMode() (DeviceAddressingMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAddressingMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAddressingMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceAddressingMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetApplicationsClock(clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetApplicationsClock", w.Device, []any{clockType}}, func() []any {
+		r0, r1 := w.Device.GetApplicationsClock(clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetArchitecture() (DeviceArchitecture, Return) {
+	results := w.middleware(CallInfo{"Device", "GetArchitecture", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetArchitecture()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceArchitecture)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAttributes() (DeviceAttributes, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAttributes", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetAttributes()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceAttributes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetAutoBoostedClocksEnabled() (EnableState, EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetAutoBoostedClocksEnabled", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetAutoBoostedClocksEnabled()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(EnableState)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetBAR1MemoryInfo() (BAR1Memory, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBAR1MemoryInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBAR1MemoryInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BAR1Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBBXTimeData_v1() (BBXTimeData_v1, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBBXTimeData_v1", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBBXTimeData_v1()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BBXTimeData_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBoardId() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBoardId", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBoardId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBoardPartNumber() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBoardPartNumber", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBoardPartNumber()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBrand() (BrandType, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBrand", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBrand()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BrandType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBridgeChipInfo() (BridgeChipHierarchy, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBridgeChipInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBridgeChipInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BridgeChipHierarchy)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetBusType() (BusType, Return) {
+	results := w.middleware(CallInfo{"Device", "GetBusType", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetBusType()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(BusType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetC2cModeInfoV() C2cModeInfoHandler {
+	results := w.middleware(CallInfo{"Device", "GetC2cModeInfoV", w.Device, []any{}}, func() []any {
+		r0 := w.Device.GetC2cModeInfoV()
+		return []any{r0}
+	})
+	r0, _ := results[0].(C2cModeInfoHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetCapabilities() (DeviceCapabilities, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCapabilities", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCapabilities()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceCapabilities)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetClkMonStatus() (ClkMonStatus, Return) {
+	results := w.middleware(CallInfo{"Device", "GetClkMonStatus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetClkMonStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ClkMonStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetClock(clockType ClockType, clockId ClockId) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetClock", w.Device, []any{clockType, clockId}}, func() []any {
+		r0, r1 := w.Device.GetClock(clockType, clockId)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetClockInfo(clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetClockInfo", w.Device, []any{clockType}}, func() []any {
+		r0, r1 := w.Device.GetClockInfo(clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetClockOffsets() (ClockOffset, Return) {
+	results := w.middleware(CallInfo{"Device", "GetClockOffsets", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetClockOffsets()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ClockOffset)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetComputeInstanceId() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetComputeInstanceId", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetComputeInstanceId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetComputeMode() (ComputeMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetComputeMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetComputeMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetComputeRunningProcesses() ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetComputeRunningProcesses", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetComputeRunningProcesses()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetConfComputeGpuAttestationReport(gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	results := w.middleware(CallInfo{"Device", "GetConfComputeGpuAttestationReport", w.Device, []any{gpuAtstReport}}, func() []any {
+		r0 := w.Device.GetConfComputeGpuAttestationReport(gpuAtstReport)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GetConfComputeGpuCertificate() (ConfComputeGpuCertificate, Return) {
+	results := w.middleware(CallInfo{"Device", "GetConfComputeGpuCertificate", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetConfComputeGpuCertificate()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeGpuCertificate)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetConfComputeMemSizeInfo() (ConfComputeMemSizeInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetConfComputeMemSizeInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetConfComputeMemSizeInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ConfComputeMemSizeInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetConfComputeProtectedMemoryUsage() (Memory, Return) {
+	results := w.middleware(CallInfo{"Device", "GetConfComputeProtectedMemoryUsage", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetConfComputeProtectedMemoryUsage()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCoolerInfo() (CoolerInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCoolerInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCoolerInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(CoolerInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCpuAffinity(numCPUs int) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCpuAffinity", w.Device, []any{numCPUs}}, func() []any {
+		r0, r1 := w.Device.GetCpuAffinity(numCPUs)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCpuAffinityWithinScope(numCPUs int, scope AffinityScope) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCpuAffinityWithinScope", w.Device, []any{numCPUs, scope}}, func() []any {
+		r0, r1 := w.Device.GetCpuAffinityWithinScope(numCPUs, scope)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCreatableVgpus() ([]VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCreatableVgpus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCreatableVgpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return wrapVgpuTypeIds(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetCudaComputeCapability() (int, int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCudaComputeCapability", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetCudaComputeCapability()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetCurrPcieLinkGeneration() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCurrPcieLinkGeneration", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCurrPcieLinkGeneration()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCurrPcieLinkWidth() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCurrPcieLinkWidth", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCurrPcieLinkWidth()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCurrentClockFreqs() (DeviceCurrentClockFreqs, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCurrentClockFreqs", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCurrentClockFreqs()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DeviceCurrentClockFreqs)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCurrentClocksEventReasons() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCurrentClocksEventReasons", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCurrentClocksEventReasons()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetCurrentClocksThrottleReasons() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetCurrentClocksThrottleReasons", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetCurrentClocksThrottleReasons()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDecoderUtilization() (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDecoderUtilization", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetDecoderUtilization()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetDefaultApplicationsClock(clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDefaultApplicationsClock", w.Device, []any{clockType}}, func() []any {
+		r0, r1 := w.Device.GetDefaultApplicationsClock(clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDefaultEccMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDefaultEccMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetDefaultEccMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDetailedEccErrors(errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDetailedEccErrors", w.Device, []any{errorType, counterType}}, func() []any {
+		r0, r1 := w.Device.GetDetailedEccErrors(errorType, counterType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EccErrorCounts)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDeviceHandleFromMigDeviceHandle() (Device, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDeviceHandleFromMigDeviceHandle", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetDeviceHandleFromMigDeviceHandle()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetDisplayActive() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDisplayActive", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetDisplayActive()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDisplayMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDisplayMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetDisplayMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetDramEncryptionMode() (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDramEncryptionMode", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetDramEncryptionMode()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DramEncryptionInfo)
+	r1, _ := results[1].(DramEncryptionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetDriverModel() (DriverModel, DriverModel, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDriverModel", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetDriverModel()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DriverModel)
+	r1, _ := results[1].(DriverModel)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetDriverModel_v2() (DriverModel, DriverModel, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDriverModel_v2", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetDriverModel_v2()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(DriverModel)
+	r1, _ := results[1].(DriverModel)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetDynamicPstatesInfo() (GpuDynamicPstatesInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetDynamicPstatesInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetDynamicPstatesInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuDynamicPstatesInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetEccMode() (EnableState, EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEccMode", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetEccMode()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(EnableState)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetEncoderCapacity(encoderQueryType EncoderType) (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEncoderCapacity", w.Device, []any{encoderQueryType}}, func() []any {
+		r0, r1 := w.Device.GetEncoderCapacity(encoderQueryType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetEncoderSessions() ([]EncoderSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEncoderSessions", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetEncoderSessions()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]EncoderSessionInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetEncoderStats() (int, uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEncoderStats", w.Device, []any{}}, func() []any {
+		r0, r1, r2, r3 := w.Device.GetEncoderStats()
+		return []any{r0, r1, r2, r3}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(uint32)
+	r3, _ := results[3].(Return)
+	return r0, r1, r2, r3
+}
+
+func (w *deviceMiddleware) GetEncoderUtilization() (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEncoderUtilization", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetEncoderUtilization()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetEnforcedPowerLimit() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetEnforcedPowerLimit", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetEnforcedPowerLimit()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFBCSessions() ([]FBCSessionInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFBCSessions", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetFBCSessions()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]FBCSessionInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFBCStats() (FBCStats, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFBCStats", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetFBCStats()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FBCStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFanControlPolicy_v2(fan int) (FanControlPolicy, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFanControlPolicy_v2", w.Device, []any{fan}}, func() []any {
+		r0, r1 := w.Device.GetFanControlPolicy_v2(fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FanControlPolicy)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFanSpeed() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFanSpeed", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetFanSpeed()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFanSpeedRPM() (FanSpeedInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFanSpeedRPM", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetFanSpeedRPM()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FanSpeedInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFanSpeed_v2(fan int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetFanSpeed_v2", w.Device, []any{fan}}, func() []any {
+		r0, r1 := w.Device.GetFanSpeed_v2(fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetFieldValues(values []FieldValue) Return {
+	results := w.middleware(CallInfo{"Device", "GetFieldValues", w.Device, []any{values}}, func() []any {
+		r0 := w.Device.GetFieldValues(values)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GetGpcClkMinMaxVfOffset() (int, int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpcClkMinMaxVfOffset", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetGpcClkMinMaxVfOffset()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetGpcClkVfOffset() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpcClkVfOffset", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGpcClkVfOffset()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuFabricInfo() (GpuFabricInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuFabricInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGpuFabricInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuFabricInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuFabricInfoV() GpuFabricInfoHandler {
+	results := w.middleware(CallInfo{"Device", "GetGpuFabricInfoV", w.Device, []any{}}, func() []any {
+		r0 := w.Device.GetGpuFabricInfoV()
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuFabricInfoHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetGpuInstanceById(id int) (GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceById", w.Device, []any{id}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstanceById(id)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstance)
+	r1, _ := results[1].(Return)
+	return WrapGpuInstance(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetGpuInstanceId() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceId", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstanceId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuInstancePossiblePlacements(info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstancePossiblePlacements", w.Device, []any{info}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstancePossiblePlacements(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]GpuInstancePlacement)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuInstanceProfileInfo(profile int) (GpuInstanceProfileInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceProfileInfo", w.Device, []any{profile}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstanceProfileInfo(profile)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuInstanceProfileInfoByIdV(profileId int) GpuInstanceProfileInfoByIdHandler {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceProfileInfoByIdV", w.Device, []any{profileId}}, func() []any {
+		r0 := w.Device.GetGpuInstanceProfileInfoByIdV(profileId)
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfoByIdHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetGpuInstanceProfileInfoV(profile int) GpuInstanceProfileInfoHandler {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceProfileInfoV", w.Device, []any{profile}}, func() []any {
+		r0 := w.Device.GetGpuInstanceProfileInfoV(profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpuInstanceProfileInfoHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetGpuInstanceRemainingCapacity(info *GpuInstanceProfileInfo) (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstanceRemainingCapacity", w.Device, []any{info}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstanceRemainingCapacity(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuInstances(info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuInstances", w.Device, []any{info}}, func() []any {
+		r0, r1 := w.Device.GetGpuInstances(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]GpuInstance)
+	r1, _ := results[1].(Return)
+	return wrapGpuInstances(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetGpuMaxPcieLinkGeneration() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuMaxPcieLinkGeneration", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGpuMaxPcieLinkGeneration()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGpuOperationMode() (GpuOperationMode, GpuOperationMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGpuOperationMode", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetGpuOperationMode()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(GpuOperationMode)
+	r1, _ := results[1].(GpuOperationMode)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetGraphicsRunningProcesses() ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGraphicsRunningProcesses", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGraphicsRunningProcesses()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGridLicensableFeatures() (GridLicensableFeatures, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGridLicensableFeatures", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGridLicensableFeatures()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GridLicensableFeatures)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetGspFirmwareMode() (bool, bool, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGspFirmwareMode", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetGspFirmwareMode()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(bool)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetGspFirmwareVersion() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetGspFirmwareVersion", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetGspFirmwareVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetHostVgpuMode() (HostVgpuMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetHostVgpuMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetHostVgpuMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(HostVgpuMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetHostname_v1() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetHostname_v1", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetHostname_v1()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetIndex() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetIndex", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetIndex()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetInforomConfigurationChecksum() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetInforomConfigurationChecksum", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetInforomConfigurationChecksum()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetInforomImageVersion() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetInforomImageVersion", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetInforomImageVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetInforomVersion(object InforomObject) (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetInforomVersion", w.Device, []any{object}}, func() []any {
+		r0, r1 := w.Device.GetInforomVersion(object)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetIrqNum() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetIrqNum", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetIrqNum()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetJpgUtilization() (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetJpgUtilization", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetJpgUtilization()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetLastBBXFlushTime() (uint64, uint, Return) {
+	results := w.middleware(CallInfo{"Device", "GetLastBBXFlushTime", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetLastBBXFlushTime()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetMPSComputeRunningProcesses() ([]ProcessInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMPSComputeRunningProcesses", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMPSComputeRunningProcesses()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMarginTemperature() (MarginTemperature, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMarginTemperature", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMarginTemperature()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(MarginTemperature)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMaxClockInfo(clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMaxClockInfo", w.Device, []any{clockType}}, func() []any {
+		r0, r1 := w.Device.GetMaxClockInfo(clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMaxCustomerBoostClock(clockType ClockType) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMaxCustomerBoostClock", w.Device, []any{clockType}}, func() []any {
+		r0, r1 := w.Device.GetMaxCustomerBoostClock(clockType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMaxMigDeviceCount() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMaxMigDeviceCount", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMaxMigDeviceCount()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMaxPcieLinkGeneration() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMaxPcieLinkGeneration", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMaxPcieLinkGeneration()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMaxPcieLinkWidth() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMaxPcieLinkWidth", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMaxPcieLinkWidth()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemClkMinMaxVfOffset() (int, int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemClkMinMaxVfOffset", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetMemClkMinMaxVfOffset()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetMemClkVfOffset() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemClkVfOffset", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMemClkVfOffset()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemoryAffinity(numNodes int, scope AffinityScope) ([]uint, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemoryAffinity", w.Device, []any{numNodes, scope}}, func() []any {
+		r0, r1 := w.Device.GetMemoryAffinity(numNodes, scope)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemoryBusWidth() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemoryBusWidth", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMemoryBusWidth()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemoryErrorCounter(errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemoryErrorCounter", w.Device, []any{errorType, counterType, locationType}}, func() []any {
+		r0, r1 := w.Device.GetMemoryErrorCounter(errorType, counterType, locationType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemoryInfo() (Memory, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemoryInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMemoryInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMemoryInfo_v2() (Memory_v2, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMemoryInfo_v2", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMemoryInfo_v2()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Memory_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMigDeviceHandleByIndex(index int) (Device, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMigDeviceHandleByIndex", w.Device, []any{index}}, func() []any {
+		r0, r1 := w.Device.GetMigDeviceHandleByIndex(index)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Device)
+	r1, _ := results[1].(Return)
+	return WrapDevice(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetMigMode() (int, int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMigMode", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetMigMode()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetMinMaxClockOfPState(clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMinMaxClockOfPState", w.Device, []any{clockType, pstate}}, func() []any {
+		r0, r1, r2 := w.Device.GetMinMaxClockOfPState(clockType, pstate)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetMinMaxFanSpeed() (int, int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMinMaxFanSpeed", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetMinMaxFanSpeed()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetMinorNumber() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMinorNumber", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMinorNumber()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetModuleId() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetModuleId", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetModuleId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetMultiGpuBoard() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetMultiGpuBoard", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetMultiGpuBoard()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetName() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetName", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetName()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNumFans() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNumFans", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetNumFans()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNumGpuCores() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNumGpuCores", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetNumGpuCores()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNumaNodeId() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNumaNodeId", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetNumaNodeId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkCapability(link int, capability NvLinkCapability) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkCapability", w.Device, []any{link, capability}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkCapability(link, capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkErrorCounter(link int, counter NvLinkErrorCounter) (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkErrorCounter", w.Device, []any{link, counter}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkErrorCounter(link, counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkInfo() NvLinkInfoHandler {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkInfo", w.Device, []any{}}, func() []any {
+		r0 := w.Device.GetNvLinkInfo()
+		return []any{r0}
+	})
+	r0, _ := results[0].(NvLinkInfoHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetNvLinkRemoteDeviceType(link int) (IntNvLinkDeviceType, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkRemoteDeviceType", w.Device, []any{link}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkRemoteDeviceType(link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(IntNvLinkDeviceType)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkRemotePciInfo(link int) (PciInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkRemotePciInfo", w.Device, []any{link}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkRemotePciInfo(link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkState(link int) (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkState", w.Device, []any{link}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkState(link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkUtilizationControl(link int, counter int) (NvLinkUtilizationControl, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkUtilizationControl", w.Device, []any{link, counter}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkUtilizationControl(link, counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvLinkUtilizationControl)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkUtilizationCounter", w.Device, []any{link, counter}}, func() []any {
+		r0, r1, r2 := w.Device.GetNvLinkUtilizationCounter(link, counter)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetNvLinkVersion(link int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvLinkVersion", w.Device, []any{link}}, func() []any {
+		r0, r1 := w.Device.GetNvLinkVersion(link)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvlinkBwMode() (NvlinkGetBwMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvlinkBwMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetNvlinkBwMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvlinkGetBwMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetNvlinkSupportedBwModes() (NvlinkSupportedBwModes, Return) {
+	results := w.middleware(CallInfo{"Device", "GetNvlinkSupportedBwModes", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetNvlinkSupportedBwModes()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(NvlinkSupportedBwModes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetOfaUtilization() (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetOfaUtilization", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetOfaUtilization()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetP2PStatus(device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	results := w.middleware(CallInfo{"Device", "GetP2PStatus", w.Device, []any{device2, p2pIndex}}, func() []any {
+		r0, r1 := w.Device.GetP2PStatus(unwrapDevice(device2), p2pIndex)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuP2PStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPciInfo() (PciInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPciInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPciInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPciInfoExt() (PciInfoExt, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPciInfoExt", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPciInfoExt()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PciInfoExt)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPcieLinkMaxSpeed() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPcieLinkMaxSpeed", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPcieLinkMaxSpeed()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPcieReplayCounter() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPcieReplayCounter", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPcieReplayCounter()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPcieSpeed() (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPcieSpeed", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPcieSpeed()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPcieThroughput(counter PcieUtilCounter) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPcieThroughput", w.Device, []any{counter}}, func() []any {
+		r0, r1 := w.Device.GetPcieThroughput(counter)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPdi() (Pdi, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPdi", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPdi()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pdi)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPerformanceModes() (DevicePerfModes, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPerformanceModes", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPerformanceModes()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DevicePerfModes)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPerformanceState() (Pstates, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPerformanceState", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPerformanceState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPersistenceMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPersistenceMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPersistenceMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPgpuMetadataString() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPgpuMetadataString", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPgpuMetadataString()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPlatformInfo() (PlatformInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPlatformInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPlatformInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PlatformInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerManagementDefaultLimit() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerManagementDefaultLimit", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerManagementDefaultLimit()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerManagementLimit() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerManagementLimit", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerManagementLimit()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerManagementLimitConstraints() (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerManagementLimitConstraints", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetPowerManagementLimitConstraints()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetPowerManagementMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerManagementMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerManagementMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerMizerMode_v1() (DevicePowerMizerModes_v1, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerMizerMode_v1", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerMizerMode_v1()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(DevicePowerMizerModes_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerSource() (PowerSource, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerSource", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerSource()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PowerSource)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerState() (Pstates, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerState", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetPowerUsage() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetPowerUsage", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetPowerUsage()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetProcessUtilization(lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Device", "GetProcessUtilization", w.Device, []any{lastSeenTimestamp}}, func() []any {
+		r0, r1 := w.Device.GetProcessUtilization(lastSeenTimestamp)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ProcessUtilizationSample)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetProcessesUtilizationInfo() (ProcessesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetProcessesUtilizationInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetProcessesUtilizationInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ProcessesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRemappedRows() (int, int, bool, bool, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRemappedRows", w.Device, []any{}}, func() []any {
+		r0, r1, r2, r3, r4 := w.Device.GetRemappedRows()
+		return []any{r0, r1, r2, r3, r4}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(int)
+	r2, _ := results[2].(bool)
+	r3, _ := results[3].(bool)
+	r4, _ := results[4].(Return)
+	return r0, r1, r2, r3, r4
+}
+
+func (w *deviceMiddleware) GetRemappedRows_v2() (RemappedRowsInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRemappedRows_v2", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetRemappedRows_v2()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RemappedRowsInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRepairStatus() (RepairStatus, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRepairStatus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetRepairStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RepairStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRetiredPages(cause PageRetirementCause) ([]uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRetiredPages", w.Device, []any{cause}}, func() []any {
+		r0, r1 := w.Device.GetRetiredPages(cause)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRetiredPagesPendingStatus() (EnableState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRetiredPagesPendingStatus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetRetiredPagesPendingStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRetiredPages_v2(cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRetiredPages_v2", w.Device, []any{cause}}, func() []any {
+		r0, r1, r2 := w.Device.GetRetiredPages_v2(cause)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].([]uint64)
+	r1, _ := results[1].([]uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetRowRemapperHistogram() (RowRemapperHistogramValues, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRowRemapperHistogram", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetRowRemapperHistogram()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(RowRemapperHistogramValues)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetRunningProcessDetailList() (ProcessDetailList, Return) {
+	results := w.middleware(CallInfo{"Device", "GetRunningProcessDetailList", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetRunningProcessDetailList()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ProcessDetailList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSamples(samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSamples", w.Device, []any{samplingType, lastSeenTimestamp}}, func() []any {
+		r0, r1, r2 := w.Device.GetSamples(samplingType, lastSeenTimestamp)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(ValueType)
+	r1, _ := results[1].([]Sample)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetSerial() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSerial", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSerial()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSramEccErrorStatus() (EccSramErrorStatus, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSramEccErrorStatus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSramEccErrorStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EccSramErrorStatus)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSramUniqueUncorrectedEccErrorCounts(errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	results := w.middleware(CallInfo{"Device", "GetSramUniqueUncorrectedEccErrorCounts", w.Device, []any{errorCounts}}, func() []any {
+		r0 := w.Device.GetSramUniqueUncorrectedEccErrorCounts(errorCounts)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GetSupportedClocksEventReasons() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedClocksEventReasons", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSupportedClocksEventReasons()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSupportedClocksThrottleReasons() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedClocksThrottleReasons", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSupportedClocksThrottleReasons()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSupportedEventTypes() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedEventTypes", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSupportedEventTypes()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedGraphicsClocks", w.Device, []any{memoryClockMHz}}, func() []any {
+		r0, r1, r2 := w.Device.GetSupportedGraphicsClocks(memoryClockMHz)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetSupportedMemoryClocks() (int, uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedMemoryClocks", w.Device, []any{}}, func() []any {
+		r0, r1, r2 := w.Device.GetSupportedMemoryClocks()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetSupportedPerformanceStates() ([]Pstates, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedPerformanceStates", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSupportedPerformanceStates()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Pstates)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetSupportedVgpus() ([]VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"Device", "GetSupportedVgpus", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetSupportedVgpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return wrapVgpuTypeIds(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetTargetFanSpeed(fan int) (int, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTargetFanSpeed", w.Device, []any{fan}}, func() []any {
+		r0, r1 := w.Device.GetTargetFanSpeed(fan)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTemperature(sensorType TemperatureSensors) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTemperature", w.Device, []any{sensorType}}, func() []any {
+		r0, r1 := w.Device.GetTemperature(sensorType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTemperatureThreshold(thresholdType TemperatureThresholds) (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTemperatureThreshold", w.Device, []any{thresholdType}}, func() []any {
+		r0, r1 := w.Device.GetTemperatureThreshold(thresholdType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTemperatureV() TemperatureHandler {
+	results := w.middleware(CallInfo{"Device", "GetTemperatureV", w.Device, []any{}}, func() []any {
+		r0 := w.Device.GetTemperatureV()
+		return []any{r0}
+	})
+	r0, _ := results[0].(TemperatureHandler)
+	return r0
+}
+
+func (w *deviceMiddleware) GetThermalSettings(sensorIndex uint32) (GpuThermalSettings, Return) {
+	results := w.middleware(CallInfo{"Device", "GetThermalSettings", w.Device, []any{sensorIndex}}, func() []any {
+		r0, r1 := w.Device.GetThermalSettings(sensorIndex)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuThermalSettings)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTopologyCommonAncestor(device2 Device) (GpuTopologyLevel, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTopologyCommonAncestor", w.Device, []any{device2}}, func() []any {
+		r0, r1 := w.Device.GetTopologyCommonAncestor(unwrapDevice(device2))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuTopologyLevel)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTopologyNearestGpus(level GpuTopologyLevel) ([]Device, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTopologyNearestGpus", w.Device, []any{level}}, func() []any {
+		r0, r1 := w.Device.GetTopologyNearestGpus(level)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Device)
+	r1, _ := results[1].(Return)
+	return wrapDevices(r0, w.middleware), r1
+}
+
+func (w *deviceMiddleware) GetTotalEccErrors(errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTotalEccErrors", w.Device, []any{errorType, counterType}}, func() []any {
+		r0, r1 := w.Device.GetTotalEccErrors(errorType, counterType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetTotalEnergyConsumption() (uint64, Return) {
+	results := w.middleware(CallInfo{"Device", "GetTotalEnergyConsumption", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetTotalEnergyConsumption()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetUUID() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetUUID", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetUUID()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetUnrepairableMemoryFlag_v1() (UnrepairableMemoryStatus_v1, Return) {
+	results := w.middleware(CallInfo{"Device", "GetUnrepairableMemoryFlag_v1", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetUnrepairableMemoryFlag_v1()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnrepairableMemoryStatus_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetUtilizationRates() (Utilization, Return) {
+	results := w.middleware(CallInfo{"Device", "GetUtilizationRates", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetUtilizationRates()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Utilization)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVbiosVersion() (string, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVbiosVersion", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVbiosVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuCapabilities(capability DeviceVgpuCapability) (bool, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuCapabilities", w.Device, []any{capability}}, func() []any {
+		r0, r1 := w.Device.GetVgpuCapabilities(capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuHeterogeneousMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuHeterogeneousMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuHeterogeneousMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuInstancesUtilizationInfo() (VgpuInstancesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuInstancesUtilizationInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuInstancesUtilizationInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuInstancesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuMetadata() (VgpuPgpuMetadata, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuMetadata", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuMetadata()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPgpuMetadata)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuProcessUtilization", w.Device, []any{lastSeenTimestamp}}, func() []any {
+		r0, r1 := w.Device.GetVgpuProcessUtilization(lastSeenTimestamp)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]VgpuProcessUtilizationSample)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuProcessesUtilizationInfo() (VgpuProcessesUtilizationInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuProcessesUtilizationInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuProcessesUtilizationInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuProcessesUtilizationInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuSchedulerCapabilities() (VgpuSchedulerCapabilities, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuSchedulerCapabilities", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuSchedulerCapabilities()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerCapabilities)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuSchedulerLog() (VgpuSchedulerLog, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuSchedulerLog", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuSchedulerLog()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLog)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuSchedulerLog_v2(logInfo VgpuSchedulerLogInfo_v2) (VgpuSchedulerLogInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuSchedulerLog_v2", w.Device, []any{logInfo}}, func() []any {
+		r0, r1 := w.Device.GetVgpuSchedulerLog_v2(logInfo)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuSchedulerState() (VgpuSchedulerGetState, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuSchedulerState", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVgpuSchedulerState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerGetState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuSchedulerState_v2(info VgpuSchedulerStateInfo_v2) (VgpuSchedulerStateInfo_v2, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuSchedulerState_v2", w.Device, []any{info}}, func() []any {
+		r0, r1 := w.Device.GetVgpuSchedulerState_v2(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuTypeCreatablePlacements", w.Device, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Device.GetVgpuTypeCreatablePlacements(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuTypeSupportedPlacements", w.Device, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Device.GetVgpuTypeSupportedPlacements(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVgpuUtilization(lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVgpuUtilization", w.Device, []any{lastSeenTimestamp}}, func() []any {
+		r0, r1, r2 := w.Device.GetVgpuUtilization(lastSeenTimestamp)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(ValueType)
+	r1, _ := results[1].([]VgpuInstanceUtilizationSample)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *deviceMiddleware) GetViolationStatus(perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	results := w.middleware(CallInfo{"Device", "GetViolationStatus", w.Device, []any{perfPolicyType}}, func() []any {
+		r0, r1 := w.Device.GetViolationStatus(perfPolicyType)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ViolationTime)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GetVirtualizationMode() (GpuVirtualizationMode, Return) {
+	results := w.middleware(CallInfo{"Device", "GetVirtualizationMode", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GetVirtualizationMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuVirtualizationMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GpmMigSampleGet(gpuInstanceId int, gpmSample GpmSample) Return {
+	results := w.middleware(CallInfo{"Device", "GpmMigSampleGet", w.Device, []any{gpuInstanceId, gpmSample}}, func() []any {
+		r0 := w.Device.GpmMigSampleGet(gpuInstanceId, unwrapGpmSample(gpmSample))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GpmQueryDeviceSupport() (GpmSupport, Return) {
+	results := w.middleware(CallInfo{"Device", "GpmQueryDeviceSupport", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GpmQueryDeviceSupport()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpmSupport)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GpmQueryDeviceSupportV() GpmSupportV {
+	results := w.middleware(CallInfo{"Device", "GpmQueryDeviceSupportV", w.Device, []any{}}, func() []any {
+		r0 := w.Device.GpmQueryDeviceSupportV()
+		return []any{r0}
+	})
+	r0, _ := results[0].(GpmSupportV)
+	return r0
+}
+
+func (w *deviceMiddleware) GpmQueryIfStreamingEnabled() (uint32, Return) {
+	results := w.middleware(CallInfo{"Device", "GpmQueryIfStreamingEnabled", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.GpmQueryIfStreamingEnabled()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) GpmSampleGet(gpmSample GpmSample) Return {
+	results := w.middleware(CallInfo{"Device", "GpmSampleGet", w.Device, []any{gpmSample}}, func() []any {
+		r0 := w.Device.GpmSampleGet(unwrapGpmSample(gpmSample))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) GpmSetStreamingEnabled(state uint32) Return {
+	results := w.middleware(CallInfo{"Device", "GpmSetStreamingEnabled", w.Device, []any{state}}, func() []any {
+		r0 := w.Device.GpmSetStreamingEnabled(state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) IsMigDeviceHandle() (bool, Return) {
+	results := w.middleware(CallInfo{"Device", "IsMigDeviceHandle", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.IsMigDeviceHandle()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) OnSameBoard(device2 Device) (int, Return) {
+	results := w.middleware(CallInfo{"Device", "OnSameBoard", w.Device, []any{device2}}, func() []any {
+		r0, r1 := w.Device.OnSameBoard(unwrapDevice(device2))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) PowerSmoothingActivatePresetProfile(profile *PowerSmoothingProfile) Return {
+	results := w.middleware(CallInfo{"Device", "PowerSmoothingActivatePresetProfile", w.Device, []any{profile}}, func() []any {
+		r0 := w.Device.PowerSmoothingActivatePresetProfile(profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) PowerSmoothingSetState(state *PowerSmoothingState) Return {
+	results := w.middleware(CallInfo{"Device", "PowerSmoothingSetState", w.Device, []any{state}}, func() []any {
+		r0 := w.Device.PowerSmoothingSetState(state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) PowerSmoothingUpdatePresetProfileParam(profile *PowerSmoothingProfile) Return {
+	results := w.middleware(CallInfo{"Device", "PowerSmoothingUpdatePresetProfileParam", w.Device, []any{profile}}, func() []any {
+		r0 := w.Device.PowerSmoothingUpdatePresetProfileParam(profile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ReadPRMCounters_v1(prmCounters []PRMCounterId, localPort int) ([]PRMCounter_v1, Return) {
+	results := w.middleware(CallInfo{"Device", "ReadPRMCounters_v1", w.Device, []any{prmCounters, localPort}}, func() []any {
+		r0, r1 := w.Device.ReadPRMCounters_v1(prmCounters, localPort)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]PRMCounter_v1)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) ReadWritePRM_v1(buffer *PRMTLV_v1) Return {
+	results := w.middleware(CallInfo{"Device", "ReadWritePRM_v1", w.Device, []any{buffer}}, func() []any {
+		r0 := w.Device.ReadWritePRM_v1(buffer)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) RegisterEvents(eventTypes uint64, set EventSet) Return {
+	results := w.middleware(CallInfo{"Device", "RegisterEvents", w.Device, []any{eventTypes, set}}, func() []any {
+		r0 := w.Device.RegisterEvents(eventTypes, unwrapEventSet(set))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ResetApplicationsClocks() Return {
+	results := w.middleware(CallInfo{"Device", "ResetApplicationsClocks", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ResetApplicationsClocks()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ResetGpuLockedClocks() Return {
+	results := w.middleware(CallInfo{"Device", "ResetGpuLockedClocks", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ResetGpuLockedClocks()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ResetMemoryLockedClocks() Return {
+	results := w.middleware(CallInfo{"Device", "ResetMemoryLockedClocks", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ResetMemoryLockedClocks()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ResetNvLinkErrorCounters(link int) Return {
+	results := w.middleware(CallInfo{"Device", "ResetNvLinkErrorCounters", w.Device, []any{link}}, func() []any {
+		r0 := w.Device.ResetNvLinkErrorCounters(link)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ResetNvLinkUtilizationCounter(link int, counter int) Return {
+	results := w.middleware(CallInfo{"Device", "ResetNvLinkUtilizationCounter", w.Device, []any{link, counter}}, func() []any {
+		r0 := w.Device.ResetNvLinkUtilizationCounter(link, counter)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetAPIRestriction(apiType RestrictedAPI, isRestricted EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetAPIRestriction", w.Device, []any{apiType, isRestricted}}, func() []any {
+		r0 := w.Device.SetAPIRestriction(apiType, isRestricted)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetAccountingMode(mode EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetAccountingMode", w.Device, []any{mode}}, func() []any {
+		r0 := w.Device.SetAccountingMode(mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetApplicationsClocks", w.Device, []any{memClockMHz, graphicsClockMHz}}, func() []any {
+		r0 := w.Device.SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetAutoBoostedClocksEnabled(enabled EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetAutoBoostedClocksEnabled", w.Device, []any{enabled}}, func() []any {
+		r0 := w.Device.SetAutoBoostedClocksEnabled(enabled)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetClockOffsets(info ClockOffset) Return {
+	results := w.middleware(CallInfo{"Device", "SetClockOffsets", w.Device, []any{info}}, func() []any {
+		r0 := w.Device.SetClockOffsets(info)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetComputeMode(mode ComputeMode) Return {
+	results := w.middleware(CallInfo{"Device", "SetComputeMode", w.Device, []any{mode}}, func() []any {
+		r0 := w.Device.SetComputeMode(mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetConfComputeUnprotectedMemSize(sizeKiB uint64) Return {
+	results := w.middleware(CallInfo{"Device", "SetConfComputeUnprotectedMemSize", w.Device, []any{sizeKiB}}, func() []any {
+		r0 := w.Device.SetConfComputeUnprotectedMemSize(sizeKiB)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetCpuAffinity() Return {
+	results := w.middleware(CallInfo{"Device", "SetCpuAffinity", w.Device, []any{}}, func() []any {
+		r0 := w.Device.SetCpuAffinity()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetDefaultAutoBoostedClocksEnabled(enabled EnableState, flags uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetDefaultAutoBoostedClocksEnabled", w.Device, []any{enabled, flags}}, func() []any {
+		r0 := w.Device.SetDefaultAutoBoostedClocksEnabled(enabled, flags)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetDefaultFanSpeed_v2(fan int) Return {
+	results := w.middleware(CallInfo{"Device", "SetDefaultFanSpeed_v2", w.Device, []any{fan}}, func() []any {
+		r0 := w.Device.SetDefaultFanSpeed_v2(fan)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetDramEncryptionMode(dramEncryption *DramEncryptionInfo) Return {
+	results := w.middleware(CallInfo{"Device", "SetDramEncryptionMode", w.Device, []any{dramEncryption}}, func() []any {
+		r0 := w.Device.SetDramEncryptionMode(dramEncryption)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetDriverModel(driverModel DriverModel, flags uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetDriverModel", w.Device, []any{driverModel, flags}}, func() []any {
+		r0 := w.Device.SetDriverModel(driverModel, flags)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetEccMode(ecc EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetEccMode", w.Device, []any{ecc}}, func() []any {
+		r0 := w.Device.SetEccMode(ecc)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetFanControlPolicy(fan int, policy FanControlPolicy) Return {
+	results := w.middleware(CallInfo{"Device", "SetFanControlPolicy", w.Device, []any{fan, policy}}, func() []any {
+		r0 := w.Device.SetFanControlPolicy(fan, policy)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetFanSpeed_v2(fan int, speed int) Return {
+	results := w.middleware(CallInfo{"Device", "SetFanSpeed_v2", w.Device, []any{fan, speed}}, func() []any {
+		r0 := w.Device.SetFanSpeed_v2(fan, speed)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetGpcClkVfOffset(offset int) Return {
+	results := w.middleware(CallInfo{"Device", "SetGpcClkVfOffset", w.Device, []any{offset}}, func() []any {
+		r0 := w.Device.SetGpcClkVfOffset(offset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetGpuLockedClocks", w.Device, []any{minGpuClockMHz, maxGpuClockMHz}}, func() []any {
+		r0 := w.Device.SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetGpuOperationMode(mode GpuOperationMode) Return {
+	results := w.middleware(CallInfo{"Device", "SetGpuOperationMode", w.Device, []any{mode}}, func() []any {
+		r0 := w.Device.SetGpuOperationMode(mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetHostname_v1(hostName string) Return {
+	results := w.middleware(CallInfo{"Device", "SetHostname_v1", w.Device, []any{hostName}}, func() []any {
+		r0 := w.Device.SetHostname_v1(hostName)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetMemClkVfOffset(offset int) Return {
+	results := w.middleware(CallInfo{"Device", "SetMemClkVfOffset", w.Device, []any{offset}}, func() []any {
+		r0 := w.Device.SetMemClkVfOffset(offset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetMemoryLockedClocks", w.Device, []any{minMemClockMHz, maxMemClockMHz}}, func() []any {
+		r0 := w.Device.SetMemoryLockedClocks(minMemClockMHz, maxMemClockMHz)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetMigMode(mode int) (Return, Return) {
+	results := w.middleware(CallInfo{"Device", "SetMigMode", w.Device, []any{mode}}, func() []any {
+		r0, r1 := w.Device.SetMigMode(mode)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(Return)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) SetNvLinkDeviceLowPowerThreshold(info *NvLinkPowerThres) Return {
+	results := w.middleware(CallInfo{"Device", "SetNvLinkDeviceLowPowerThreshold", w.Device, []any{info}}, func() []any {
+		r0 := w.Device.SetNvLinkDeviceLowPowerThreshold(info)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetNvLinkUtilizationControl(link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	results := w.middleware(CallInfo{"Device", "SetNvLinkUtilizationControl", w.Device, []any{link, counter, control, reset}}, func() []any {
+		r0 := w.Device.SetNvLinkUtilizationControl(link, counter, control, reset)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetNvlinkBwMode(setBwMode *NvlinkSetBwMode) Return {
+	results := w.middleware(CallInfo{"Device", "SetNvlinkBwMode", w.Device, []any{setBwMode}}, func() []any {
+		r0 := w.Device.SetNvlinkBwMode(setBwMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetPersistenceMode(mode EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetPersistenceMode", w.Device, []any{mode}}, func() []any {
+		r0 := w.Device.SetPersistenceMode(mode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetPowerManagementLimit(limit uint32) Return {
+	results := w.middleware(CallInfo{"Device", "SetPowerManagementLimit", w.Device, []any{limit}}, func() []any {
+		r0 := w.Device.SetPowerManagementLimit(limit)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetPowerManagementLimit_v2(powerValue *PowerValue_v2) Return {
+	results := w.middleware(CallInfo{"Device", "SetPowerManagementLimit_v2", w.Device, []any{powerValue}}, func() []any {
+		r0 := w.Device.SetPowerManagementLimit_v2(powerValue)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetRusdSettings_v1(settings RusdSettings_v1) Return {
+	results := w.middleware(CallInfo{"Device", "SetRusdSettings_v1", w.Device, []any{settings}}, func() []any {
+		r0 := w.Device.SetRusdSettings_v1(settings)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetTemperatureThreshold(thresholdType TemperatureThresholds, temp int) Return {
+	results := w.middleware(CallInfo{"Device", "SetTemperatureThreshold", w.Device, []any{thresholdType, temp}}, func() []any {
+		r0 := w.Device.SetTemperatureThreshold(thresholdType, temp)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetVgpuCapabilities(capability DeviceVgpuCapability, state EnableState) Return {
+	results := w.middleware(CallInfo{"Device", "SetVgpuCapabilities", w.Device, []any{capability, state}}, func() []any {
+		r0 := w.Device.SetVgpuCapabilities(capability, state)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetVgpuHeterogeneousMode(heterogeneousMode VgpuHeterogeneousMode) Return {
+	results := w.middleware(CallInfo{"Device", "SetVgpuHeterogeneousMode", w.Device, []any{heterogeneousMode}}, func() []any {
+		r0 := w.Device.SetVgpuHeterogeneousMode(heterogeneousMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetVgpuSchedulerState(pSchedulerState *VgpuSchedulerSetState) Return {
+	results := w.middleware(CallInfo{"Device", "SetVgpuSchedulerState", w.Device, []any{pSchedulerState}}, func() []any {
+		r0 := w.Device.SetVgpuSchedulerState(pSchedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetVgpuSchedulerState_v2(schedulerState *VgpuSchedulerState_v2) Return {
+	results := w.middleware(CallInfo{"Device", "SetVgpuSchedulerState_v2", w.Device, []any{schedulerState}}, func() []any {
+		r0 := w.Device.SetVgpuSchedulerState_v2(schedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) SetVirtualizationMode(virtualMode GpuVirtualizationMode) Return {
+	results := w.middleware(CallInfo{"Device", "SetVirtualizationMode", w.Device, []any{virtualMode}}, func() []any {
+		r0 := w.Device.SetVirtualizationMode(virtualMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) ValidateInforom() Return {
+	results := w.middleware(CallInfo{"Device", "ValidateInforom", w.Device, []any{}}, func() []any {
+		r0 := w.Device.ValidateInforom()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) VgpuForceGspUnload() Return {
+	results := w.middleware(CallInfo{"Device", "VgpuForceGspUnload", w.Device, []any{}}, func() []any {
+		r0 := w.Device.VgpuForceGspUnload()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) VgpuTypeGetMaxInstances(vgpuTypeId VgpuTypeId) (int, Return) {
+	results := w.middleware(CallInfo{"Device", "VgpuTypeGetMaxInstances", w.Device, []any{vgpuTypeId}}, func() []any {
+		r0, r1 := w.Device.VgpuTypeGetMaxInstances(unwrapVgpuTypeId(vgpuTypeId))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	results := w.middleware(CallInfo{"Device", "WorkloadPowerProfileClearRequestedProfiles", w.Device, []any{requestedProfiles}}, func() []any {
+		r0 := w.Device.WorkloadPowerProfileClearRequestedProfiles(requestedProfiles)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) WorkloadPowerProfileGetCurrentProfiles() (WorkloadPowerProfileCurrentProfiles, Return) {
+	results := w.middleware(CallInfo{"Device", "WorkloadPowerProfileGetCurrentProfiles", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.WorkloadPowerProfileGetCurrentProfiles()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(WorkloadPowerProfileCurrentProfiles)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) WorkloadPowerProfileGetProfilesInfo() (WorkloadPowerProfileProfilesInfo, Return) {
+	results := w.middleware(CallInfo{"Device", "WorkloadPowerProfileGetProfilesInfo", w.Device, []any{}}, func() []any {
+		r0, r1 := w.Device.WorkloadPowerProfileGetProfilesInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(WorkloadPowerProfileProfilesInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *deviceMiddleware) WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	results := w.middleware(CallInfo{"Device", "WorkloadPowerProfileSetRequestedProfiles", w.Device, []any{requestedProfiles}}, func() []any {
+		r0 := w.Device.WorkloadPowerProfileSetRequestedProfiles(requestedProfiles)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *deviceMiddleware) WorkloadPowerProfileUpdateProfiles_v1(operation PowerProfileOperation, profileTypes []PowerProfileType) Return {
+	results := w.middleware(CallInfo{"Device", "WorkloadPowerProfileUpdateProfiles_v1", w.Device, []any{operation, profileTypes}}, func() []any {
+		r0 := w.Device.WorkloadPowerProfileUpdateProfiles_v1(operation, profileTypes)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+// gpuInstanceMiddleware passes the calls made to the wrapped GpuInstance through a Middleware.
+type gpuInstanceMiddleware struct {
+	GpuInstance
+	middleware Middleware
+}
+
+// WrapGpuInstance returns a wrapper that passes every call made to wrapped through m.
+func WrapGpuInstance(wrapped GpuInstance, m Middleware) GpuInstance {
+	if wrapped == nil {
+		return nil
+	}
+	return &gpuInstanceMiddleware{wrapped, m}
+}
+
+// unwrapGpuInstance removes all layers of middleware from v.
+func unwrapGpuInstance(v GpuInstance) GpuInstance {
+	for {
+		w, ok := v.(*gpuInstanceMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.GpuInstance
+	}
+}
+
+// wrapGpuInstances wraps each GpuInstance of v with m.
+func wrapGpuInstances(v []GpuInstance, m Middleware) []GpuInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]GpuInstance, len(v))
+	for i := range v {
+		wrapped[i] = WrapGpuInstance(v[i], m)
+	}
+	return wrapped
+}
+
+func (w *gpuInstanceMiddleware) CreateComputeInstance(info *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "CreateComputeInstance", w.GpuInstance, []any{info}}, func() []any {
+		r0, r1 := w.GpuInstance.CreateComputeInstance(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *gpuInstanceMiddleware) CreateComputeInstanceWithPlacement(info *ComputeInstanceProfileInfo, placement *ComputeInstancePlacement) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "CreateComputeInstanceWithPlacement", w.GpuInstance, []any{info, placement}}, func() []any {
+		r0, r1 := w.GpuInstance.CreateComputeInstanceWithPlacement(info, placement)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *gpuInstanceMiddleware) Destroy() Return {
+	results := w.middleware(CallInfo{"GpuInstance", "Destroy", w.GpuInstance, []any{}}, func() []any {
+		r0 := w.GpuInstance.Destroy()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *gpuInstanceMiddleware) GetActiveVgpus() (ActiveVgpuInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetActiveVgpus", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetActiveVgpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ActiveVgpuInstanceInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstanceById(id int) (ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstanceById", w.GpuInstance, []any{id}}, func() []any {
+		r0, r1 := w.GpuInstance.GetComputeInstanceById(id)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstance)
+	r1, _ := results[1].(Return)
+	return WrapComputeInstance(r0, w.middleware), r1
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstancePossiblePlacements(info *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstancePossiblePlacements", w.GpuInstance, []any{info}}, func() []any {
+		r0, r1 := w.GpuInstance.GetComputeInstancePossiblePlacements(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ComputeInstancePlacement)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstanceProfileInfo(profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstanceProfileInfo", w.GpuInstance, []any{profile, engProfile}}, func() []any {
+		r0, r1 := w.GpuInstance.GetComputeInstanceProfileInfo(profile, engProfile)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstanceProfileInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstanceProfileInfoV(profile int, engProfile int) ComputeInstanceProfileInfoHandler {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstanceProfileInfoV", w.GpuInstance, []any{profile, engProfile}}, func() []any {
+		r0 := w.GpuInstance.GetComputeInstanceProfileInfoV(profile, engProfile)
+		return []any{r0}
+	})
+	r0, _ := results[0].(ComputeInstanceProfileInfoHandler)
+	return r0
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstanceRemainingCapacity(info *ComputeInstanceProfileInfo) (int, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstanceRemainingCapacity", w.GpuInstance, []any{info}}, func() []any {
+		r0, r1 := w.GpuInstance.GetComputeInstanceRemainingCapacity(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetComputeInstances(info *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetComputeInstances", w.GpuInstance, []any{info}}, func() []any {
+		r0, r1 := w.GpuInstance.GetComputeInstances(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]ComputeInstance)
+	r1, _ := results[1].(Return)
+	return wrapComputeInstances(r0, w.middleware), r1
+}
+
+func (w *gpuInstanceMiddleware) GetCreatableVgpus() (VgpuTypeIdInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetCreatableVgpus", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetCreatableVgpus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeIdInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetInfo() (GpuInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetInfo", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(GpuInstanceInfo)
+	r1, _ := results[1].(Return)
+	return wrapGpuInstanceInfo(r0, w.middleware), r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuHeterogeneousMode", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuHeterogeneousMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuHeterogeneousMode)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuSchedulerLog() (VgpuSchedulerLogInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuSchedulerLog", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuSchedulerLog()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuSchedulerLog_v2(logInfo VgpuSchedulerLogInfo_v2) (VgpuSchedulerLogInfo_v2, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuSchedulerLog_v2", w.GpuInstance, []any{logInfo}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuSchedulerLog_v2(logInfo)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerLogInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuSchedulerState() (VgpuSchedulerStateInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuSchedulerState", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuSchedulerState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuSchedulerState_v2(info VgpuSchedulerStateInfo_v2) (VgpuSchedulerStateInfo_v2, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuSchedulerState_v2", w.GpuInstance, []any{info}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuSchedulerState_v2(info)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuSchedulerStateInfo_v2)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) GetVgpuTypeCreatablePlacements() (VgpuCreatablePlacementInfo, Return) {
+	results := w.middleware(CallInfo{"GpuInstance", "GetVgpuTypeCreatablePlacements", w.GpuInstance, []any{}}, func() []any {
+		r0, r1 := w.GpuInstance.GetVgpuTypeCreatablePlacements()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuCreatablePlacementInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *gpuInstanceMiddleware) SetVgpuHeterogeneousMode(heterogeneousMode *VgpuHeterogeneousMode) Return {
+	results := w.middleware(CallInfo{"GpuInstance", "SetVgpuHeterogeneousMode", w.GpuInstance, []any{heterogeneousMode}}, func() []any {
+		r0 := w.GpuInstance.SetVgpuHeterogeneousMode(heterogeneousMode)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *gpuInstanceMiddleware) SetVgpuSchedulerState(scheduler *VgpuSchedulerState) Return {
+	results := w.middleware(CallInfo{"GpuInstance", "SetVgpuSchedulerState", w.GpuInstance, []any{scheduler}}, func() []any {
+		r0 := w.GpuInstance.SetVgpuSchedulerState(scheduler)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *gpuInstanceMiddleware) SetVgpuSchedulerState_v2(schedulerState *VgpuSchedulerState_v2) Return {
+	results := w.middleware(CallInfo{"GpuInstance", "SetVgpuSchedulerState_v2", w.GpuInstance, []any{schedulerState}}, func() []any {
+		r0 := w.GpuInstance.SetVgpuSchedulerState_v2(schedulerState)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+// computeInstanceMiddleware passes the calls made to the wrapped ComputeInstance through a Middleware.
+type computeInstanceMiddleware struct {
+	ComputeInstance
+	middleware Middleware
+}
+
+// WrapComputeInstance returns a wrapper that passes every call made to wrapped through m.
+func WrapComputeInstance(wrapped ComputeInstance, m Middleware) ComputeInstance {
+	if wrapped == nil {
+		return nil
+	}
+	return &computeInstanceMiddleware{wrapped, m}
+}
+
+// unwrapComputeInstance removes all layers of middleware from v.
+func unwrapComputeInstance(v ComputeInstance) ComputeInstance {
+	for {
+		w, ok := v.(*computeInstanceMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.ComputeInstance
+	}
+}
+
+// wrapComputeInstances wraps each ComputeInstance of v with m.
+func wrapComputeInstances(v []ComputeInstance, m Middleware) []ComputeInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]ComputeInstance, len(v))
+	for i := range v {
+		wrapped[i] = WrapComputeInstance(v[i], m)
+	}
+	return wrapped
+}
+
+func (w *computeInstanceMiddleware) Destroy() Return {
+	results := w.middleware(CallInfo{"ComputeInstance", "Destroy", w.ComputeInstance, []any{}}, func() []any {
+		r0 := w.ComputeInstance.Destroy()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *computeInstanceMiddleware) GetInfo() (ComputeInstanceInfo, Return) {
+	results := w.middleware(CallInfo{"ComputeInstance", "GetInfo", w.ComputeInstance, []any{}}, func() []any {
+		r0, r1 := w.ComputeInstance.GetInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(ComputeInstanceInfo)
+	r1, _ := results[1].(Return)
+	return wrapComputeInstanceInfo(r0, w.middleware), r1
+}
+
+// eventSetMiddleware passes the calls made to the wrapped EventSet through a Middleware.
+type eventSetMiddleware struct {
+	EventSet
+	middleware Middleware
+}
+
+// WrapEventSet returns a wrapper that passes every call made to wrapped through m.
+func WrapEventSet(wrapped EventSet, m Middleware) EventSet {
+	if wrapped == nil {
+		return nil
+	}
+	return &eventSetMiddleware{wrapped, m}
+}
+
+// unwrapEventSet removes all layers of middleware from v.
+func unwrapEventSet(v EventSet) EventSet {
+	for {
+		w, ok := v.(*eventSetMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.EventSet
+	}
+}
+
+func (w *eventSetMiddleware) Free() Return {
+	results := w.middleware(CallInfo{"EventSet", "Free", w.EventSet, []any{}}, func() []any {
+		r0 := w.EventSet.Free()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *eventSetMiddleware) Wait(timeoutms uint32) (EventData, Return) {
+	results := w.middleware(CallInfo{"EventSet", "Wait", w.EventSet, []any{timeoutms}}, func() []any {
+		r0, r1 := w.EventSet.Wait(timeoutms)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EventData)
+	r1, _ := results[1].(Return)
+	return wrapEventData(r0, w.middleware), r1
+}
+
+// gpmSampleMiddleware passes the calls made to the wrapped GpmSample through a Middleware.
+type gpmSampleMiddleware struct {
+	GpmSample
+	middleware Middleware
+}
+
+// WrapGpmSample returns a wrapper that passes every call made to wrapped through m.
+func WrapGpmSample(wrapped GpmSample, m Middleware) GpmSample {
+	if wrapped == nil {
+		return nil
+	}
+	return &gpmSampleMiddleware{wrapped, m}
+}
+
+// unwrapGpmSample removes all layers of middleware from v.
+func unwrapGpmSample(v GpmSample) GpmSample {
+	for {
+		w, ok := v.(*gpmSampleMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.GpmSample
+	}
+}
+
+func (w *gpmSampleMiddleware) Free() Return {
+	results := w.middleware(CallInfo{"GpmSample", "Free", w.GpmSample, []any{}}, func() []any {
+		r0 := w.GpmSample.Free()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *gpmSampleMiddleware) Get(device Device) Return {
+	results := w.middleware(CallInfo{"GpmSample", "Get", w.GpmSample, []any{device}}, func() []any {
+		r0 := w.GpmSample.Get(unwrapDevice(device))
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *gpmSampleMiddleware) MigGet(device Device, gpuInstanceId int) Return {
+	results := w.middleware(CallInfo{"GpmSample", "MigGet", w.GpmSample, []any{device, gpuInstanceId}}, func() []any {
+		r0 := w.GpmSample.MigGet(unwrapDevice(device), gpuInstanceId)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+// unitMiddleware passes the calls made to the wrapped Unit through a Middleware.
+type unitMiddleware struct {
+	Unit
+	middleware Middleware
+}
+
+// WrapUnit returns a wrapper that passes every call made to wrapped through m.
+func WrapUnit(wrapped Unit, m Middleware) Unit {
+	if wrapped == nil {
+		return nil
+	}
+	return &unitMiddleware{wrapped, m}
+}
+
+// unwrapUnit removes all layers of middleware from v.
+func unwrapUnit(v Unit) Unit {
+	for {
+		w, ok := v.(*unitMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.Unit
+	}
+}
+
+func (w *unitMiddleware) GetDevices() ([]Device, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetDevices", w.Unit, []any{}}, func() []any {
+		r0, r1 := w.Unit.GetDevices()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]Device)
+	r1, _ := results[1].(Return)
+	return wrapDevices(r0, w.middleware), r1
+}
+
+func (w *unitMiddleware) GetFanSpeedInfo() (UnitFanSpeeds, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetFanSpeedInfo", w.Unit, []any{}}, func() []any {
+		r0, r1 := w.Unit.GetFanSpeedInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnitFanSpeeds)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *unitMiddleware) GetLedState() (LedState, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetLedState", w.Unit, []any{}}, func() []any {
+		r0, r1 := w.Unit.GetLedState()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(LedState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *unitMiddleware) GetPsuInfo() (PSUInfo, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetPsuInfo", w.Unit, []any{}}, func() []any {
+		r0, r1 := w.Unit.GetPsuInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(PSUInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *unitMiddleware) GetTemperature(ttype int) (uint32, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetTemperature", w.Unit, []any{ttype}}, func() []any {
+		r0, r1 := w.Unit.GetTemperature(ttype)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *unitMiddleware) GetUnitInfo() (UnitInfo, Return) {
+	results := w.middleware(CallInfo{"Unit", "GetUnitInfo", w.Unit, []any{}}, func() []any {
+		r0, r1 := w.Unit.GetUnitInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(UnitInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *unitMiddleware) SetLedState(color LedColor) Return {
+	results := w.middleware(CallInfo{"Unit", "SetLedState", w.Unit, []any{color}}, func() []any {
+		r0 := w.Unit.SetLedState(color)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+// vgpuInstanceMiddleware passes the calls made to the wrapped VgpuInstance through a Middleware.
+type vgpuInstanceMiddleware struct {
+	VgpuInstance
+	middleware Middleware
+}
+
+// WrapVgpuInstance returns a wrapper that passes every call made to wrapped through m.
+func WrapVgpuInstance(wrapped VgpuInstance, m Middleware) VgpuInstance {
+	if wrapped == nil {
+		return nil
+	}
+	return &vgpuInstanceMiddleware{wrapped, m}
+}
+
+// unwrapVgpuInstance removes all layers of middleware from v.
+func unwrapVgpuInstance(v VgpuInstance) VgpuInstance {
+	for {
+		w, ok := v.(*vgpuInstanceMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.VgpuInstance
+	}
+}
+
+// wrapVgpuInstances wraps each VgpuInstance of v with m.
+func wrapVgpuInstances(v []VgpuInstance, m Middleware) []VgpuInstance {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]VgpuInstance, len(v))
+	for i := range v {
+		wrapped[i] = WrapVgpuInstance(v[i], m)
+	}
+	return wrapped
+}
+
+func (w *vgpuInstanceMiddleware) ClearAccountingPids() Return {
+	results := w.middleware(CallInfo{"VgpuInstance", "ClearAccountingPids", w.VgpuInstance, []any{}}, func() []any {
+		r0 := w.VgpuInstance.ClearAccountingPids()
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+func (w *vgpuInstanceMiddleware) GetAccountingMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetAccountingMode", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetAccountingMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetAccountingPids() ([]int, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetAccountingPids", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetAccountingPids()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].([]int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetAccountingStats(pid int) (AccountingStats, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetAccountingStats", w.VgpuInstance, []any{pid}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetAccountingStats(pid)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(AccountingStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetEccMode() (EnableState, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetEccMode", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetEccMode()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(EnableState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetEncoderCapacity() (int, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetEncoderCapacity", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetEncoderCapacity()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetEncoderSessions() (int, EncoderSessionInfo, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetEncoderSessions", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1, r2 := w.VgpuInstance.GetEncoderSessions()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(EncoderSessionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *vgpuInstanceMiddleware) GetEncoderStats() (int, uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetEncoderStats", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1, r2, r3 := w.VgpuInstance.GetEncoderStats()
+		return []any{r0, r1, r2, r3}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(uint32)
+	r3, _ := results[3].(Return)
+	return r0, r1, r2, r3
+}
+
+func (w *vgpuInstanceMiddleware) GetFBCSessions() (int, FBCSessionInfo, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetFBCSessions", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1, r2 := w.VgpuInstance.GetFBCSessions()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(FBCSessionInfo)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *vgpuInstanceMiddleware) GetFBCStats() (FBCStats, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetFBCStats", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetFBCStats()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(FBCStats)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetFbUsage() (uint64, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetFbUsage", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetFbUsage()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetFrameRateLimit() (uint32, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetFrameRateLimit", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetFrameRateLimit()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetGpuInstanceId() (int, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetGpuInstanceId", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetGpuInstanceId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetGpuPciId() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetGpuPciId", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetGpuPciId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetLicenseInfo() (VgpuLicenseInfo, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetLicenseInfo", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetLicenseInfo()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuLicenseInfo)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetLicenseStatus() (int, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetLicenseStatus", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetLicenseStatus()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetMdevUUID() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetMdevUUID", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetMdevUUID()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetMetadata() (VgpuMetadata, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetMetadata", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetMetadata()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuMetadata)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetRuntimeStateSize() (VgpuRuntimeState, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetRuntimeStateSize", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetRuntimeStateSize()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuRuntimeState)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetType() (VgpuTypeId, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetType", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetType()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeId)
+	r1, _ := results[1].(Return)
+	return WrapVgpuTypeId(r0, w.middleware), r1
+}
+
+func (w *vgpuInstanceMiddleware) GetUUID() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetUUID", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetUUID()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetVmDriverVersion() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetVmDriverVersion", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1 := w.VgpuInstance.GetVmDriverVersion()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuInstanceMiddleware) GetVmID() (string, VgpuVmIdType, Return) {
+	results := w.middleware(CallInfo{"VgpuInstance", "GetVmID", w.VgpuInstance, []any{}}, func() []any {
+		r0, r1, r2 := w.VgpuInstance.GetVmID()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(VgpuVmIdType)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *vgpuInstanceMiddleware) SetEncoderCapacity(encoderCapacity int) Return {
+	results := w.middleware(CallInfo{"VgpuInstance", "SetEncoderCapacity", w.VgpuInstance, []any{encoderCapacity}}, func() []any {
+		r0 := w.VgpuInstance.SetEncoderCapacity(encoderCapacity)
+		return []any{r0}
+	})
+	r0, _ := results[0].(Return)
+	return r0
+}
+
+// vgpuTypeIdMiddleware passes the calls made to the wrapped VgpuTypeId through a Middleware.
+type vgpuTypeIdMiddleware struct {
+	VgpuTypeId
+	middleware Middleware
+}
+
+// WrapVgpuTypeId returns a wrapper that passes every call made to wrapped through m.
+func WrapVgpuTypeId(wrapped VgpuTypeId, m Middleware) VgpuTypeId {
+	if wrapped == nil {
+		return nil
+	}
+	return &vgpuTypeIdMiddleware{wrapped, m}
+}
+
+// unwrapVgpuTypeId removes all layers of middleware from v.
+func unwrapVgpuTypeId(v VgpuTypeId) VgpuTypeId {
+	for {
+		w, ok := v.(*vgpuTypeIdMiddleware)
+		if !ok {
+			return v
+		}
+		v = w.VgpuTypeId
+	}
+}
+
+// wrapVgpuTypeIds wraps each VgpuTypeId of v with m.
+func wrapVgpuTypeIds(v []VgpuTypeId, m Middleware) []VgpuTypeId {
+	if v == nil {
+		return nil
+	}
+	wrapped := make([]VgpuTypeId, len(v))
+	for i := range v {
+		wrapped[i] = WrapVgpuTypeId(v[i], m)
+	}
+	return wrapped
+}
+
+func (w *vgpuTypeIdMiddleware) GetBAR1Info() (VgpuTypeBar1Info, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetBAR1Info", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetBAR1Info()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuTypeBar1Info)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetCapabilities(capability VgpuCapability) (bool, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetCapabilities", w.VgpuTypeId, []any{capability}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetCapabilities(capability)
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(bool)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetClass() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetClass", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetClass()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetCreatablePlacements(device Device) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetCreatablePlacements", w.VgpuTypeId, []any{device}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetCreatablePlacements(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetDeviceID() (uint64, uint64, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetDeviceID", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1, r2 := w.VgpuTypeId.GetDeviceID()
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(uint64)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *vgpuTypeIdMiddleware) GetFrameRateLimit() (uint32, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetFrameRateLimit", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetFrameRateLimit()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetFramebufferSize() (uint64, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetFramebufferSize", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetFramebufferSize()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint64)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetGpuInstanceProfileId() (uint32, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetGpuInstanceProfileId", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetGpuInstanceProfileId()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetLicense() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetLicense", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetLicense()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetMaxInstances(device Device) (int, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetMaxInstances", w.VgpuTypeId, []any{device}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetMaxInstances(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetMaxInstancesPerVm() (int, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetMaxInstancesPerVm", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetMaxInstancesPerVm()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetName() (string, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetName", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetName()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetNumDisplayHeads() (int, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetNumDisplayHeads", w.VgpuTypeId, []any{}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetNumDisplayHeads()
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+func (w *vgpuTypeIdMiddleware) GetResolution(displayIndex int) (uint32, uint32, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetResolution", w.VgpuTypeId, []any{displayIndex}}, func() []any {
+		r0, r1, r2 := w.VgpuTypeId.GetResolution(displayIndex)
+		return []any{r0, r1, r2}
+	})
+	r0, _ := results[0].(uint32)
+	r1, _ := results[1].(uint32)
+	r2, _ := results[2].(Return)
+	return r0, r1, r2
+}
+
+func (w *vgpuTypeIdMiddleware) GetSupportedPlacements(device Device) (VgpuPlacementList, Return) {
+	results := w.middleware(CallInfo{"VgpuTypeId", "GetSupportedPlacements", w.VgpuTypeId, []any{device}}, func() []any {
+		r0, r1 := w.VgpuTypeId.GetSupportedPlacements(unwrapDevice(device))
+		return []any{r0, r1}
+	})
+	r0, _ := results[0].(VgpuPlacementList)
+	r1, _ := results[1].(Return)
+	return r0, r1
+}
+
+// wrapComputeInstanceInfo wraps the handles held by v with m.
+func wrapComputeInstanceInfo(v ComputeInstanceInfo, m Middleware) ComputeInstanceInfo {
+	v.Device = WrapDevice(v.Device, m)
+	v.GpuInstance = WrapGpuInstance(v.GpuInstance, m)
+	return v
+}
+
+// wrapEventData wraps the handles held by v with m.
+func wrapEventData(v EventData, m Middleware) EventData {
+	v.Device = WrapDevice(v.Device, m)
+	return v
+}
+
+// wrapGpuInstanceInfo wraps the handles held by v with m.
+func wrapGpuInstanceInfo(v GpuInstanceInfo, m Middleware) GpuInstanceInfo {
+	v.Device = WrapDevice(v.Device, m)
+	return v
+}
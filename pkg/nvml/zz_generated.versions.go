@@ -0,0 +1,202 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// The variables below dispatch the functions with versioned symbols in nvml.h.
+// They default to v1 and are updated when the library is loaded.
+var (
+	nvmlComputeInstanceGetInfo                 = nvmlComputeInstanceGetInfo_v1
+	nvmlDeviceGetAttributes                    = nvmlDeviceGetAttributes_v1
+	deviceGetComputeRunningProcesses           = deviceGetComputeRunningProcesses_v1
+	nvmlDeviceGetCount                         = nvmlDeviceGetCount_v1
+	nvmlDeviceGetDriverModel                   = nvmlDeviceGetDriverModel_v1
+	nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v1
+	deviceGetGraphicsRunningProcesses          = deviceGetGraphicsRunningProcesses_v1
+	nvmlDeviceGetGridLicensableFeatures        = nvmlDeviceGetGridLicensableFeatures_v1
+	nvmlDeviceGetHandleByIndex                 = nvmlDeviceGetHandleByIndex_v1
+	nvmlDeviceGetHandleByPciBusId              = nvmlDeviceGetHandleByPciBusId_v1
+	deviceGetMPSComputeRunningProcesses        = deviceGetMPSComputeRunningProcesses_v1
+	nvmlDeviceGetNvLinkRemotePciInfo           = nvmlDeviceGetNvLinkRemotePciInfo_v1
+	nvmlDeviceGetPciInfo                       = nvmlDeviceGetPciInfo_v1
+	nvmlEventSetWait                           = nvmlEventSetWait_v1
+	nvmlInit                                   = nvmlInit_v1
+	nvmlVgpuInstanceGetLicenseInfo             = nvmlVgpuInstanceGetLicenseInfo_v1
+)
+
+// versionedFunctions lists the versions of the functions with versioned
+// symbols in nvml.h from the newest to v1.
+var versionedFunctions = []versionedFunction{
+	{
+		symbol: "nvmlComputeInstanceGetInfo",
+		versions: []versionedSymbol{
+			{"nvmlComputeInstanceGetInfo_v2", func() { nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v2 }},
+			{"nvmlComputeInstanceGetInfo", func() { nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetAttributes",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetAttributes_v2", func() { nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v2 }},
+			{"nvmlDeviceGetAttributes", func() { nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetComputeRunningProcesses",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetComputeRunningProcesses_v3", func() { deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v3 }},
+			{"nvmlDeviceGetComputeRunningProcesses_v2", func() { deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v2 }},
+			{"nvmlDeviceGetComputeRunningProcesses", func() { deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetCount",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetCount_v2", func() { nvmlDeviceGetCount = nvmlDeviceGetCount_v2 }},
+			{"nvmlDeviceGetCount", func() { nvmlDeviceGetCount = nvmlDeviceGetCount_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetDriverModel",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetDriverModel_v2", func() { nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v2 }},
+			{"nvmlDeviceGetDriverModel", func() { nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetGpuInstancePossiblePlacements",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetGpuInstancePossiblePlacements_v2", func() { nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v2 }},
+			{"nvmlDeviceGetGpuInstancePossiblePlacements", func() { nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetGraphicsRunningProcesses",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetGraphicsRunningProcesses_v3", func() { deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v3 }},
+			{"nvmlDeviceGetGraphicsRunningProcesses_v2", func() { deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v2 }},
+			{"nvmlDeviceGetGraphicsRunningProcesses", func() { deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetGridLicensableFeatures",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetGridLicensableFeatures_v4", func() { nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v4 }},
+			{"nvmlDeviceGetGridLicensableFeatures_v3", func() { nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v3 }},
+			{"nvmlDeviceGetGridLicensableFeatures_v2", func() { nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v2 }},
+			{"nvmlDeviceGetGridLicensableFeatures", func() { nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetHandleByIndex",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetHandleByIndex_v2", func() { nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v2 }},
+			{"nvmlDeviceGetHandleByIndex", func() { nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetHandleByPciBusId",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetHandleByPciBusId_v2", func() { nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v2 }},
+			{"nvmlDeviceGetHandleByPciBusId", func() { nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetMPSComputeRunningProcesses",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetMPSComputeRunningProcesses_v3", func() { deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v3 }},
+			{"nvmlDeviceGetMPSComputeRunningProcesses_v2", func() { deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v2 }},
+			{"nvmlDeviceGetMPSComputeRunningProcesses", func() { deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetNvLinkRemotePciInfo",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetNvLinkRemotePciInfo_v2", func() { nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v2 }},
+			{"nvmlDeviceGetNvLinkRemotePciInfo", func() { nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlDeviceGetPciInfo",
+		versions: []versionedSymbol{
+			{"nvmlDeviceGetPciInfo_v3", func() { nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v3 }},
+			{"nvmlDeviceGetPciInfo_v2", func() { nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v2 }},
+			{"nvmlDeviceGetPciInfo", func() { nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlEventSetWait",
+		versions: []versionedSymbol{
+			{"nvmlEventSetWait_v2", func() { nvmlEventSetWait = nvmlEventSetWait_v2 }},
+			{"nvmlEventSetWait", func() { nvmlEventSetWait = nvmlEventSetWait_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlInit",
+		versions: []versionedSymbol{
+			{"nvmlInit_v2", func() { nvmlInit = nvmlInit_v2 }},
+			{"nvmlInit", func() { nvmlInit = nvmlInit_v1 }},
+		},
+	},
+	{
+		symbol: "nvmlVgpuInstanceGetLicenseInfo",
+		versions: []versionedSymbol{
+			{"nvmlVgpuInstanceGetLicenseInfo_v2", func() { nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v2 }},
+			{"nvmlVgpuInstanceGetLicenseInfo", func() { nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v1 }},
+		},
+	},
+}
+
+// saveVersionedSymbols returns a function restoring the variables above to
+// their current values.
+func saveVersionedSymbols() func() {
+	savedNvmlComputeInstanceGetInfo := nvmlComputeInstanceGetInfo
+	savedNvmlDeviceGetAttributes := nvmlDeviceGetAttributes
+	savedDeviceGetComputeRunningProcesses := deviceGetComputeRunningProcesses
+	savedNvmlDeviceGetCount := nvmlDeviceGetCount
+	savedNvmlDeviceGetDriverModel := nvmlDeviceGetDriverModel
+	savedNvmlDeviceGetGpuInstancePossiblePlacements := nvmlDeviceGetGpuInstancePossiblePlacements
+	savedDeviceGetGraphicsRunningProcesses := deviceGetGraphicsRunningProcesses
+	savedNvmlDeviceGetGridLicensableFeatures := nvmlDeviceGetGridLicensableFeatures
+	savedNvmlDeviceGetHandleByIndex := nvmlDeviceGetHandleByIndex
+	savedNvmlDeviceGetHandleByPciBusId := nvmlDeviceGetHandleByPciBusId
+	savedDeviceGetMPSComputeRunningProcesses := deviceGetMPSComputeRunningProcesses
+	savedNvmlDeviceGetNvLinkRemotePciInfo := nvmlDeviceGetNvLinkRemotePciInfo
+	savedNvmlDeviceGetPciInfo := nvmlDeviceGetPciInfo
+	savedNvmlEventSetWait := nvmlEventSetWait
+	savedNvmlInit := nvmlInit
+	savedNvmlVgpuInstanceGetLicenseInfo := nvmlVgpuInstanceGetLicenseInfo
+	return func() {
+		nvmlComputeInstanceGetInfo = savedNvmlComputeInstanceGetInfo
+		nvmlDeviceGetAttributes = savedNvmlDeviceGetAttributes
+		deviceGetComputeRunningProcesses = savedDeviceGetComputeRunningProcesses
+		nvmlDeviceGetCount = savedNvmlDeviceGetCount
+		nvmlDeviceGetDriverModel = savedNvmlDeviceGetDriverModel
+		nvmlDeviceGetGpuInstancePossiblePlacements = savedNvmlDeviceGetGpuInstancePossiblePlacements
+		deviceGetGraphicsRunningProcesses = savedDeviceGetGraphicsRunningProcesses
+		nvmlDeviceGetGridLicensableFeatures = savedNvmlDeviceGetGridLicensableFeatures
+		nvmlDeviceGetHandleByIndex = savedNvmlDeviceGetHandleByIndex
+		nvmlDeviceGetHandleByPciBusId = savedNvmlDeviceGetHandleByPciBusId
+		deviceGetMPSComputeRunningProcesses = savedDeviceGetMPSComputeRunningProcesses
+		nvmlDeviceGetNvLinkRemotePciInfo = savedNvmlDeviceGetNvLinkRemotePciInfo
+		nvmlDeviceGetPciInfo = savedNvmlDeviceGetPciInfo
+		nvmlEventSetWait = savedNvmlEventSetWait
+		nvmlInit = savedNvmlInit
+		nvmlVgpuInstanceGetLicenseInfo = savedNvmlVgpuInstanceGetLicenseInfo
+	}
+}